@@ -123,8 +123,11 @@ func processReference(model *v3.Document, pr *processRef, cf *handleIndexConfig)
 		// make sure the sequence ref and pr ref have the same full definition.
 		pr.ref.FullDefinition = pr.seqRef.FullDefinition
 		// this is a root document reference, there is no way to get the location from the fragment.
-		// first, lets try to determine the type of the import, if we can.
-		if importType, ok := DetectOpenAPIComponentType(pr.ref.Node); ok {
+		// media types look just like headers or schemas, so check where the reference is used first (3.2+)
+		if isMediaTypeReference(pr.seqRef) {
+			location = handleFileImport(pr, v3low.MediaTypesLabel, delim, components.MediaTypes)
+		} else if importType, ok := DetectOpenAPIComponentType(pr.ref.Node); ok {
+			// lets try to determine the type of the import, if we can.
 			// cool, using the filename as the reference name, check if we have any collisions.
 			switch importType {
 			case v3low.SchemasLabel:
@@ -244,6 +247,15 @@ func processReference(model *v3.Document, pr *processRef, cf *handleIndexConfig)
 								pr, idx, components.PathItems, buildPathItem)
 						}
 					}
+
+				case v3low.MediaTypesLabel:
+					if len(location) > 2 {
+						mediaType := location[2]
+						if components.MediaTypes != nil {
+							return checkReferenceAndBubbleUp(mediaType, cf.compositionConfig.Delimiter,
+								pr, idx, components.MediaTypes, buildMediaType)
+						}
+					}
 				}
 			}
 		} else {
@@ -754,3 +754,80 @@ oneOf:
 
 	runtime.GC()
 }
+
+func TestBundleBytesComposed_MediaTypes(t *testing.T) {
+	spec := `openapi: 3.2.0
+info:
+  title: Test API
+  version: 1.0.0
+paths:
+  /events:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            text/event-stream:
+              $ref: './event-stream.yaml'
+            application/jsonl:
+              $ref: './shared.yaml#/components/mediaTypes/JsonLines'`
+
+	stream := `itemSchema:
+  type: object
+  properties:
+    data:
+      type: string`
+
+	shared := `components:
+  mediaTypes:
+    JsonLines:
+      itemSchema:
+        type: string`
+
+	tmp := t.TempDir()
+	write := func(name, src string) {
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, name), []byte(src), 0644))
+	}
+	write("main.yaml", spec)
+	write("event-stream.yaml", stream)
+	write("shared.yaml", shared)
+
+	mainBytes, _ := os.ReadFile(filepath.Join(tmp, "main.yaml"))
+	cfg := &datamodel.DocumentConfiguration{
+		BasePath:            tmp,
+		AllowFileReferences: true,
+	}
+
+	out, err := BundleBytesComposed(mainBytes, cfg, nil)
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, yaml.Unmarshal(out, &doc))
+
+	mediaTypes := doc["components"].(map[string]any)["mediaTypes"].(map[string]any)
+	assert.Len(t, mediaTypes, 2)
+	assert.Contains(t, mediaTypes, "event-stream")
+	assert.Contains(t, mediaTypes, "JsonLines")
+
+	content := doc["paths"].(map[string]any)["/events"].(map[string]any)["get"].(map[string]any)["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+	assert.Equal(t, "#/components/mediaTypes/event-stream", content["text/event-stream"].(map[string]any)["$ref"])
+	assert.Equal(t, "#/components/mediaTypes/JsonLines", content["application/jsonl"].(map[string]any)["$ref"])
+}
+
+func TestIsMediaTypeReference(t *testing.T) {
+	var n yaml.Node
+	_ = yaml.Unmarshal([]byte(`application/json:
+  $ref: a.yaml
+/pets:
+  $ref: b.yaml
+name:
+  $ref: c.yaml`), &n)
+	parent := n.Content[0]
+
+	assert.True(t, isMediaTypeReference(&index.Reference{ParentNode: parent, Node: parent.Content[1]}))
+	assert.False(t, isMediaTypeReference(&index.Reference{ParentNode: parent, Node: parent.Content[3]}))
+	assert.False(t, isMediaTypeReference(&index.Reference{ParentNode: parent, Node: parent.Content[5]}))
+	assert.False(t, isMediaTypeReference(&index.Reference{ParentNode: parent, Node: &yaml.Node{}}))
+	assert.False(t, isMediaTypeReference(&index.Reference{}))
+	assert.False(t, isMediaTypeReference(nil))
+}
@@ -304,3 +304,11 @@ func buildPathItem(node *yaml.Node, idx *index.SpecIndex) (*v3.PathItem, error)
 	err := pathItem.Build(ctx, &yaml.Node{}, node, idx)
 	return v3.NewPathItem(&pathItem), err
 }
+
+func buildMediaType(node *yaml.Node, idx *index.SpecIndex) (*v3.MediaType, error) {
+	mediaType := v3low.MediaType{}
+	_ = low.BuildModel(node, &mediaType)
+	ctx := context.Background()
+	err := mediaType.Build(ctx, &yaml.Node{}, node, idx)
+	return v3.NewMediaType(&mediaType), err
+}
@@ -4,9 +4,11 @@
 package bundler
 
 import (
+	"mime"
 	"strings"
 
 	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/index"
 	"gopkg.in/yaml.v3"
 )
 
@@ -136,7 +138,7 @@ func hasCallbackProperties(node *yaml.Node) bool {
 func hasPathItemProperties(node *yaml.Node) bool {
 	// PathItem typically has HTTP methods as keys
 	keys := getNodeKeys(node)
-	httpMethods := []string{"get", "post", "put", "delete", "options", "head", "patch", "trace"}
+	httpMethods := []string{"get", "post", "put", "delete", "options", "head", "patch", "trace", "query"}
 
 	for _, method := range httpMethods {
 		if containsKey(keys, method) {
@@ -148,6 +150,26 @@ func hasPathItemProperties(node *yaml.Node) bool {
 	return containsKey(keys, v3.ParametersLabel)
 }
 
+// isMediaTypeReference checks if a reference is used as the value of a content map entry, which means the
+// reference points to a media type (the key will be a media type range like 'application/json').
+func isMediaTypeReference(ref *index.Reference) bool {
+	if ref == nil || ref.ParentNode == nil || ref.ParentNode.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(ref.ParentNode.Content); i += 2 {
+		if ref.ParentNode.Content[i+1] != ref.Node {
+			continue
+		}
+		key := ref.ParentNode.Content[i].Value
+		if strings.HasPrefix(key, "/") || !strings.Contains(key, "/") {
+			return false
+		}
+		_, _, err := mime.ParseMediaType(key)
+		return err == nil
+	}
+	return false
+}
+
 // Helper function to get all keys from a mapping node
 func getNodeKeys(node *yaml.Node) []string {
 	if node.Kind != yaml.MappingNode {
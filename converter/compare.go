@@ -0,0 +1,154 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/utils"
+	what_changed "github.com/pb33f/libopenapi/what-changed"
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+// Comparison is the result of comparing two documents of any version.
+type Comparison struct {
+	// Changes holds the semantic differences between the documents, nil if there are none. Pointers refer to the
+	// normalised (OpenAPI 3) documents.
+	Changes *model.DocumentChanges `json:"changes,omitempty" yaml:"changes,omitempty"`
+
+	// OriginalReport and UpdatedReport contain anything that could not be normalised without losing information,
+	// for each document. Differences in anything lost are not reported.
+	OriginalReport *Report `json:"originalReport,omitempty" yaml:"originalReport,omitempty"`
+	UpdatedReport  *Report `json:"updatedReport,omitempty" yaml:"updatedReport,omitempty"`
+}
+
+// CompareDocuments compares two documents that may be different versions, for example to prove that migrating a
+// Swagger document to OpenAPI 3 has not changed the API.
+//
+// Both documents are normalised into OpenAPI 3 before they are compared: Swagger documents are upgraded using
+// ConvertSwaggerDocument, and if one document is OpenAPI 3.0 and the other is 3.1 or later, the 3.0 document is
+// upgraded using UpgradeDocument. Only semantic differences are reported, so a body parameter and a requestBody
+// holding the same schema are the same, and the version of each document is ignored. Neither document is modified.
+//
+// Documents of the same version are compared as they are, using libopenapi.CompareDocuments.
+func CompareDocuments(original, updated libopenapi.Document, config *Config) (*Comparison, error) {
+	if original == nil || updated == nil || original.GetSpecInfo() == nil || updated.GetSpecInfo() == nil {
+		return nil, ErrInvalidModel
+	}
+	if config == nil {
+		config = &Config{}
+	}
+	if original.GetSpecInfo().SpecType == updated.GetSpecInfo().SpecType &&
+		sameDialect(original.GetSpecInfo().Version, updated.GetSpecInfo().Version) {
+		changes, errs := libopenapi.CompareDocuments(original, updated)
+		if changes == nil && len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return &Comparison{Changes: changes, OriginalReport: new(Report), UpdatedReport: new(Report)}, nil
+	}
+
+	l, lReport, err := normaliseDocument(original, config)
+	if err != nil {
+		return nil, err
+	}
+	r, rReport, err := normaliseDocument(updated, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// schemas are compared using the same dialect.
+	switch {
+	case is30(l.Version) && !is30(r.Version):
+		if l, err = upgradeCopy(l, lReport, config); err != nil {
+			return nil, err
+		}
+	case is30(r.Version) && !is30(l.Version):
+		if r, err = upgradeCopy(r, rReport, config); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := what_changed.CompareOpenAPIDocuments(l.GoLow(), r.GoLow())
+	if changes != nil {
+		// the version is expected to change.
+		var kept []*model.Change
+		for _, c := range changes.Changes {
+			if c.Property != v3.OpenAPILabel {
+				kept = append(kept, c)
+			}
+		}
+		changes.Changes = kept
+		if changes.TotalChanges() == 0 {
+			changes = nil
+		}
+	}
+	return &Comparison{Changes: changes, OriginalReport: lReport, UpdatedReport: rReport}, nil
+}
+
+// normaliseDocument builds an OpenAPI 3 document, converting Swagger documents.
+func normaliseDocument(doc libopenapi.Document, config *Config) (*v3high.Document, *Report, error) {
+	if doc.GetSpecInfo().SpecType == utils.OpenApi2 {
+		m, errs := doc.BuildV2Model()
+		if m == nil {
+			return nil, nil, errors.Join(ErrInvalidModel, errors.Join(errs...))
+		}
+		v3Doc, report, err := ConvertSwaggerDocument(m, config)
+		if v3Doc == nil {
+			return nil, report, err
+		}
+		return v3Doc, report, nil
+	}
+	m, errs := doc.BuildV3Model()
+	if m == nil {
+		return nil, nil, errors.Join(ErrInvalidModel, errors.Join(errs...))
+	}
+	return &m.Model, new(Report), nil
+}
+
+// upgradeCopy upgrades a copy of an OpenAPI 3.0 document to 3.1, anything removed is added to the report.
+func upgradeCopy(doc *v3high.Document, report *Report, config *Config) (*v3high.Document, error) {
+	docConfig := config.DocumentConfiguration
+	if docConfig == nil {
+		docConfig = datamodel.NewDocumentConfiguration()
+	}
+	rebuild := func(d *v3high.Document) (*v3high.Document, error) {
+		out, err := d.Render()
+		if err != nil {
+			return nil, err
+		}
+		n, err := libopenapi.NewDocumentWithConfiguration(out, docConfig)
+		if err != nil {
+			return nil, err
+		}
+		m, errs := n.BuildV3Model()
+		if m == nil {
+			return nil, errors.Join(ErrInvalidModel, errors.Join(errs...))
+		}
+		return &m.Model, nil
+	}
+	c, err := rebuild(doc)
+	if err != nil {
+		return nil, err
+	}
+	upgradeReport, err := UpgradeDocument(c)
+	if err != nil {
+		return nil, err
+	}
+	report.Issues = append(report.Issues, upgradeReport.Issues...)
+	return rebuild(c)
+}
+
+func is30(version string) bool {
+	return strings.HasPrefix(version, "3.0")
+}
+
+// sameDialect returns true if schemas in both versions use the same dialect.
+func sameDialect(l, r string) bool {
+	return is30(l) == is30(r)
+}
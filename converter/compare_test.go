@@ -0,0 +1,135 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const compareSwagger = `swagger: "2.0"
+info:
+  title: pets
+  version: 1.0.0
+host: pets.example.com
+basePath: /v1
+schemes:
+  - https
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      consumes:
+        - application/json
+      produces:
+        - application/json
+      parameters:
+        - in: body
+          name: body
+          required: true
+          schema:
+            $ref: '#/definitions/Pet'
+      responses:
+        "200":
+          description: ok
+          schema:
+            $ref: '#/definitions/Pet'
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+        x-nullable: true`
+
+const compareOpenAPI = `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+servers:
+  - url: https://pets.example.com/v1
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      requestBody:
+        required: %s
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type:
+            - string
+            - "null"`
+
+func compareDocument(t *testing.T, spec string) libopenapi.Document {
+	t.Helper()
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestCompareDocuments(t *testing.T) {
+	original := compareDocument(t, compareSwagger)
+	updated := compareDocument(t, fmt.Sprintf(compareOpenAPI, "true"))
+
+	// the migration did not change the API.
+	comparison, err := CompareDocuments(original, updated, nil)
+	require.NoError(t, err)
+	assert.Nil(t, comparison.Changes)
+	assert.False(t, comparison.OriginalReport.IsLossy())
+	assert.False(t, comparison.UpdatedReport.IsLossy())
+}
+
+func TestCompareDocuments_Changed(t *testing.T) {
+	original := compareDocument(t, compareSwagger)
+	updated := compareDocument(t, fmt.Sprintf(compareOpenAPI, "false"))
+
+	comparison, err := CompareDocuments(original, updated, nil)
+	require.NoError(t, err)
+	require.NotNil(t, comparison.Changes)
+	changes := comparison.Changes.GetAllChanges()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "required", changes[0].Property)
+	assert.Equal(t, "/paths/~1pets/post/requestBody/required", changes[0].Path)
+	assert.True(t, changes[0].Breaking)
+
+	// the other way around.
+	comparison, err = CompareDocuments(updated, original, nil)
+	require.NoError(t, err)
+	require.NotNil(t, comparison.Changes)
+	assert.Equal(t, 1, comparison.Changes.TotalChanges())
+}
+
+func TestCompareDocuments_SameVersion(t *testing.T) {
+	original := compareDocument(t, fmt.Sprintf(compareOpenAPI, "true"))
+	updated := compareDocument(t, fmt.Sprintf(compareOpenAPI, "false"))
+
+	comparison, err := CompareDocuments(original, updated, nil)
+	require.NoError(t, err)
+	require.NotNil(t, comparison.Changes)
+	assert.Equal(t, 1, comparison.Changes.TotalChanges())
+}
+
+func TestCompareDocuments_InvalidModel(t *testing.T) {
+	_, err := CompareDocuments(nil, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidModel)
+}
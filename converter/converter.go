@@ -0,0 +1,140 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package converter provides tools for converting specifications between versions. Swagger (OpenAPI 2) documents
+// can be upgraded to OpenAPI 3 documents, the types are mapped across and every $ref is rewritten to point at the
+// new locations. OpenAPI 3.0 documents can be upgraded to OpenAPI 3.1, and OpenAPI 3.1 documents downgraded to
+// OpenAPI 3.0, for tools that do not support JSON Schema 2020-12 yet.
+//
+// Not everything in one version has an equivalent in another, anything that cannot be carried across
+// without losing information is recorded as an Issue in the Report returned by every conversion.
+package converter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidModel is returned when the model to be converted is not usable.
+var ErrInvalidModel = errors.New("invalid model")
+
+// Config is used to configure a conversion.
+type Config struct {
+	// DocumentConfiguration is used when parsing the source bytes and when building the converted document.
+	// If left empty when converting a model, the configuration is derived from the index of the source model,
+	// so relative references continue to resolve from the same location.
+	DocumentConfiguration *datamodel.DocumentConfiguration
+}
+
+// Issue represents something that could not be converted without losing information.
+type Issue struct {
+	Path    string `json:"path" yaml:"path"`       // JSON Path to the object in the source document.
+	Message string `json:"message" yaml:"message"` // What was lost, and why.
+	Line    int    `json:"line,omitempty" yaml:"line,omitempty"`
+	Column  int    `json:"column,omitempty" yaml:"column,omitempty"`
+}
+
+// Error returns a string representation of the Issue, so it can be used as an error.
+func (i *Issue) Error() string {
+	if i.Line > 0 {
+		return fmt.Sprintf("%s (line %d, column %d): %s", i.Path, i.Line, i.Column, i.Message)
+	}
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// Report contains all the issues found during a conversion.
+type Report struct {
+	Issues []*Issue `json:"issues,omitempty" yaml:"issues,omitempty"`
+}
+
+// IsLossy returns true if anything was lost during the conversion.
+func (r *Report) IsLossy() bool {
+	return r != nil && len(r.Issues) > 0
+}
+
+func (r *Report) add(node *yaml.Node, path, message string) {
+	i := &Issue{Path: path, Message: message}
+	if node != nil {
+		i.Line = node.Line
+		i.Column = node.Column
+	}
+	r.Issues = append(r.Issues, i)
+}
+
+// ConvertSwaggerBytes will take a byte slice of a Swagger (OpenAPI 2) specification and convert it into an
+// OpenAPI 3 specification, returned as rendered YAML bytes. A Report of anything lossy is also returned.
+func ConvertSwaggerBytes(bytes []byte, config *Config) ([]byte, *Report, error) {
+	docConfig := datamodel.NewDocumentConfiguration()
+	if config != nil && config.DocumentConfiguration != nil {
+		docConfig = config.DocumentConfiguration
+	}
+	doc, err := libopenapi.NewDocumentWithConfiguration(bytes, docConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	v2Doc, errs := doc.BuildV2Model()
+	if v2Doc == nil {
+		return nil, nil, errors.Join(ErrInvalidModel, errors.Join(errs...))
+	}
+	return convertSwagger(v2Doc)
+}
+
+// ConvertSwaggerDocument will convert a Swagger (OpenAPI 2) document model into an OpenAPI 3 document. The
+// source model is not modified. The returned document is fully built, it has its own index and rolodex, and can be
+// rendered or compared like any other document.
+//
+// A Report is always returned, it contains everything that could not be converted without losing information.
+func ConvertSwaggerDocument(model *libopenapi.DocumentModel[v2high.Swagger], config *Config) (*v3high.Document, *Report, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	out, report, err := convertSwagger(model)
+	if err != nil {
+		return nil, report, err
+	}
+
+	docConfig := config.DocumentConfiguration
+	if docConfig == nil {
+		docConfig = documentConfigurationFromIndex(model)
+	}
+	doc, err := libopenapi.NewDocumentWithConfiguration(out, docConfig)
+	if err != nil {
+		return nil, report, err
+	}
+	v3Doc, errs := doc.BuildV3Model()
+	if v3Doc == nil {
+		return nil, report, errors.Join(ErrInvalidModel, errors.Join(errs...))
+	}
+	return &v3Doc.Model, report, errors.Join(errs...)
+}
+
+func convertSwagger(model *libopenapi.DocumentModel[v2high.Swagger]) ([]byte, *Report, error) {
+	if model == nil || model.Model.GoLow() == nil {
+		return nil, nil, ErrInvalidModel
+	}
+	c := newSwaggerConverter(model.Model.GoLow())
+	root := c.convert()
+	out, err := yaml.Marshal(root)
+	if err != nil {
+		return nil, c.report, err
+	}
+	return out, c.report, nil
+}
+
+func documentConfigurationFromIndex(model *libopenapi.DocumentModel[v2high.Swagger]) *datamodel.DocumentConfiguration {
+	docConfig := datamodel.NewDocumentConfiguration()
+	if model.Index != nil && model.Index.GetConfig() != nil {
+		idxConfig := model.Index.GetConfig()
+		docConfig.BasePath = idxConfig.BasePath
+		docConfig.BaseURL = idxConfig.BaseURL
+		docConfig.AllowFileReferences = idxConfig.AllowFileLookup
+		docConfig.AllowRemoteReferences = idxConfig.AllowRemoteLookup
+	}
+	return docConfig
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertSwaggerDocument(t *testing.T) {
+	spec, _ := os.ReadFile("../test_specs/petstorev2.json")
+	doc, err := libopenapi.NewDocument(spec)
+	require.NoError(t, err)
+	v2Doc, errs := doc.BuildV2Model()
+	require.Empty(t, errs)
+
+	v3Doc, report, err := ConvertSwaggerDocument(v2Doc, nil)
+	require.NoError(t, err)
+	require.NotNil(t, v3Doc)
+
+	assert.Equal(t, OpenAPIVersion, v3Doc.Version)
+	assert.Equal(t, "Swagger Petstore", v3Doc.Info.Title)
+	assert.Len(t, v3Doc.Servers, 2)
+	assert.Equal(t, "https://petstore.swagger.io/v2", v3Doc.Servers[0].URL)
+	assert.Equal(t, 6, v3Doc.Components.Schemas.Len())
+	assert.Equal(t, 2, v3Doc.Components.SecuritySchemes.Len())
+
+	// body parameters are now request bodies, and references point at components.
+	addPet := v3Doc.Paths.PathItems.GetOrZero("/pet").Post
+	assert.Equal(t, 2, addPet.RequestBody.Content.Len())
+	petSchema := addPet.RequestBody.Content.GetOrZero("application/json").Schema
+	assert.True(t, petSchema.IsReference())
+	assert.Equal(t, "#/components/schemas/Pet", petSchema.GetReference())
+	assert.Equal(t, "Pet", petSchema.Schema().XML.Name)
+
+	// form parameters are merged into a single schema.
+	upload := v3Doc.Paths.PathItems.GetOrZero("/pet/{petId}/uploadImage").Post
+	form := upload.RequestBody.Content.GetOrZero("multipart/form-data").Schema.Schema()
+	assert.Equal(t, "binary", form.Properties.GetOrZero("file").Schema().Format)
+
+	// oauth flows are mapped.
+	auth := v3Doc.Components.SecuritySchemes.GetOrZero("petstore_auth")
+	assert.Equal(t, "https://petstore.swagger.io/oauth/authorize", auth.Flows.Implicit.AuthorizationUrl)
+
+	// the source does not define any lossy constructs.
+	assert.False(t, report.IsLossy())
+}
+
+func TestConvertSwaggerDocument_InvalidModel(t *testing.T) {
+	v3Doc, _, err := ConvertSwaggerDocument(nil, nil)
+	assert.Nil(t, v3Doc)
+	assert.ErrorIs(t, err, ErrInvalidModel)
+}
+
+func TestConvertSwaggerBytes(t *testing.T) {
+	spec := `swagger: "2.0"
+info:
+  title: burgers
+  version: "1.0"
+host: api.pb33f.io
+basePath: /v1
+paths:
+  /burgers:
+    get:
+      produces:
+        - application/json
+      responses:
+        200:
+          description: OK
+          schema:
+            $ref: '#/definitions/Burger'
+definitions:
+  Burger:
+    type: object`
+
+	out, report, err := ConvertSwaggerBytes([]byte(spec), nil)
+	require.NoError(t, err)
+
+	expected := `openapi: 3.0.3
+info:
+    title: burgers
+    version: "1.0"
+servers:
+    - url: https://api.pb33f.io/v1
+paths:
+    /burgers:
+        get:
+            responses:
+                "200":
+                    description: OK
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/Burger'
+components:
+    schemas:
+        Burger:
+            type: object
+`
+	assert.Equal(t, expected, string(out))
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, "$", report.Issues[0].Path)
+	assert.Equal(t, 5, report.Issues[0].Line)
+	assert.Equal(t, "$ (line 5, column 7): no schemes are defined, 'https' has been assumed for the server URL",
+		report.Issues[0].Error())
+}
+
+func TestConvertSwaggerBytes_WithConfig(t *testing.T) {
+	config := &Config{DocumentConfiguration: datamodel.NewDocumentConfiguration()}
+	out, report, err := ConvertSwaggerBytes([]byte(`swagger: "2.0"
+info:
+  title: empty
+  version: "1.0"`), config)
+	require.NoError(t, err)
+	assert.Equal(t, "openapi: 3.0.3\ninfo:\n    title: empty\n    version: \"1.0\"\n", string(out))
+	assert.False(t, report.IsLossy())
+}
+
+func TestConvertSwaggerBytes_NotSwagger(t *testing.T) {
+	_, _, err := ConvertSwaggerBytes([]byte(`openapi: 3.1.0`), nil)
+	assert.ErrorIs(t, err, ErrInvalidModel)
+
+	_, _, err = ConvertSwaggerBytes([]byte(``), nil)
+	assert.Error(t, err)
+}
+
+func TestIssue_Error(t *testing.T) {
+	i := &Issue{Path: "$.paths", Message: "oh no"}
+	assert.Equal(t, "$.paths: oh no", i.Error())
+}
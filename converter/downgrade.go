@@ -0,0 +1,335 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// downgrader is a documentVisitor that downgrades OpenAPI 3.1 and 3.2 semantics to OpenAPI 3.0. Anything that
+// cannot be expressed is removed and reported.
+type downgrader struct {
+	report *Report
+}
+
+// removed records a keyword that has been removed, because it cannot be expressed in OpenAPI 3.0.
+func (d *downgrader) removed(key *yaml.Node, path, keyword string) {
+	d.report.add(key, pathKey(path, keyword), fmt.Sprintf("'%s' cannot be expressed in OpenAPI 3.0, it has been removed", keyword))
+}
+
+func (d *downgrader) document(doc *v3high.Document) {
+	var root *yaml.Node
+	if doc.Index != nil {
+		root = doc.Index.GetRootNode()
+	}
+	if doc.Self != "" {
+		doc.Self = ""
+		d.removed(keyNode(root, "$self"), "$", "$self")
+	}
+	if doc.JsonSchemaDialect != "" {
+		doc.JsonSchemaDialect = ""
+		d.removed(keyNode(root, "jsonSchemaDialect"), "$", "jsonSchemaDialect")
+	}
+	if orderedmap.Len(doc.Webhooks) > 0 {
+		doc.Webhooks = nil
+		d.removed(keyNode(root, "webhooks"), "$", "webhooks")
+	}
+	if info := doc.Info; info != nil {
+		if info.Summary != "" {
+			info.Summary = ""
+			d.removed(sourceKey(info.GoLow(), "summary"), "$.info", "summary")
+		}
+		if l := info.License; l != nil && l.Identifier != "" {
+			l.Identifier = ""
+			d.removed(sourceKey(l.GoLow(), "identifier"), "$.info.license", "identifier")
+		}
+	}
+	for i, tag := range doc.Tags {
+		path := "$.tags[" + strconv.Itoa(i) + "]"
+		if tag.Summary != "" {
+			tag.Summary = ""
+			d.removed(sourceKey(tag.GoLow(), "summary"), path, "summary")
+		}
+		if tag.Parent != "" {
+			tag.Parent = ""
+			d.removed(sourceKey(tag.GoLow(), "parent"), path, "parent")
+		}
+		if tag.Kind != "" {
+			tag.Kind = ""
+			d.removed(sourceKey(tag.GoLow(), "kind"), path, "kind")
+		}
+	}
+	if c := doc.Components; c != nil {
+		if orderedmap.Len(c.PathItems) > 0 {
+			c.PathItems = nil
+			d.removed(sourceKey(c.GoLow(), "pathItems"), "$.components", "pathItems")
+		}
+		if orderedmap.Len(c.MediaTypes) > 0 {
+			c.MediaTypes = nil
+			d.removed(sourceKey(c.GoLow(), "mediaTypes"), "$.components", "mediaTypes")
+		}
+		for k, ss := range c.SecuritySchemes.FromOldest() {
+			d.securityScheme(ss, pathKey("$.components.securitySchemes", k))
+		}
+	}
+}
+
+func (d *downgrader) securityScheme(ss *v3high.SecurityScheme, path string) {
+	if ss == nil || isReference(ss.GoLow()) {
+		return
+	}
+	if ss.OAuth2MetadataUrl != "" {
+		ss.OAuth2MetadataUrl = ""
+		d.removed(sourceKey(ss.GoLow(), "oauth2MetadataUrl"), path, "oauth2MetadataUrl")
+	}
+	if ss.Deprecated {
+		ss.Deprecated = false
+		d.removed(sourceKey(ss.GoLow(), "deprecated"), path, "deprecated")
+	}
+	if ss.Flows != nil && ss.Flows.DeviceAuthorization != nil {
+		ss.Flows.DeviceAuthorization = nil
+		d.removed(sourceKey(ss.Flows.GoLow(), "deviceAuthorization"), pathKey(path, "flows"), "deviceAuthorization")
+	}
+}
+
+func (d *downgrader) pathItem(p *v3high.PathItem, path string) {
+	if p.Query != nil {
+		p.Query = nil
+		d.removed(sourceKey(p.GoLow(), "query"), path, "query")
+	}
+	if orderedmap.Len(p.AdditionalOperations) > 0 {
+		p.AdditionalOperations = nil
+		d.removed(sourceKey(p.GoLow(), "additionalOperations"), path, "additionalOperations")
+	}
+}
+
+func (d *downgrader) mediaType(_ string, m *v3high.MediaType, path string) {
+	if m.ItemSchema != nil {
+		m.ItemSchema = nil
+		d.removed(sourceKey(m.GoLow(), "itemSchema"), path, "itemSchema")
+	}
+	if m.ItemEncoding != nil {
+		m.ItemEncoding = nil
+		d.removed(sourceKey(m.GoLow(), "itemEncoding"), path, "itemEncoding")
+	}
+	if m.PrefixEncoding != nil {
+		m.PrefixEncoding = nil
+		d.removed(sourceKey(m.GoLow(), "prefixEncoding"), path, "prefixEncoding")
+	}
+}
+
+func (d *downgrader) schema(s *base.Schema, path string) {
+	d.types(s, path)
+	exclusiveBoolean(&s.ExclusiveMinimum, &s.Minimum, func(e, l float64) bool { return e >= l })
+	exclusiveBoolean(&s.ExclusiveMaximum, &s.Maximum, func(e, l float64) bool { return e <= l })
+	d.examples(s, path)
+	if s.Const != nil {
+		s.Enum = []*yaml.Node{s.Const}
+		s.Const = nil
+	}
+	d.content(s, path)
+	d.items(s)
+
+	// everything else is JSON Schema 2020-12 that OpenAPI 3.0 has no equivalent for.
+	if s.SchemaTypeRef != "" {
+		s.SchemaTypeRef = ""
+		d.removed(sourceKey(s.GoLow(), "$schema"), path, "$schema")
+	}
+	if s.Id != "" {
+		s.Id = ""
+		d.removed(sourceKey(s.GoLow(), "$id"), path, "$id")
+	}
+	if s.Anchor != "" {
+		s.Anchor = ""
+		d.removed(sourceKey(s.GoLow(), "$anchor"), path, "$anchor")
+	}
+	if s.DynamicAnchor != "" {
+		s.DynamicAnchor = ""
+		d.removed(sourceKey(s.GoLow(), "$dynamicAnchor"), path, "$dynamicAnchor")
+	}
+	if s.DynamicRef != "" {
+		s.DynamicRef = ""
+		d.removed(sourceKey(s.GoLow(), "$dynamicRef"), path, "$dynamicRef")
+	}
+	if s.Comment != "" {
+		s.Comment = ""
+		d.removed(sourceKey(s.GoLow(), "$comment"), path, "$comment")
+	}
+	if orderedmap.Len(s.Vocabulary) > 0 {
+		s.Vocabulary = nil
+		d.removed(sourceKey(s.GoLow(), "$vocabulary"), path, "$vocabulary")
+	}
+	if orderedmap.Len(s.Defs) > 0 {
+		s.Defs = nil
+		d.removed(sourceKey(s.GoLow(), "$defs"), path, "$defs")
+	}
+	if s.If != nil {
+		s.If = nil
+		d.removed(sourceKey(s.GoLow(), "if"), path, "if")
+	}
+	if s.Then != nil {
+		s.Then = nil
+		d.removed(sourceKey(s.GoLow(), "then"), path, "then")
+	}
+	if s.Else != nil {
+		s.Else = nil
+		d.removed(sourceKey(s.GoLow(), "else"), path, "else")
+	}
+	if s.PrefixItems != nil {
+		s.PrefixItems = nil
+		d.removed(sourceKey(s.GoLow(), "prefixItems"), path, "prefixItems")
+	}
+	if s.Contains != nil {
+		s.Contains = nil
+		d.removed(sourceKey(s.GoLow(), "contains"), path, "contains")
+	}
+	if s.MinContains != nil {
+		s.MinContains = nil
+		d.removed(sourceKey(s.GoLow(), "minContains"), path, "minContains")
+	}
+	if s.MaxContains != nil {
+		s.MaxContains = nil
+		d.removed(sourceKey(s.GoLow(), "maxContains"), path, "maxContains")
+	}
+	if orderedmap.Len(s.DependentSchemas) > 0 {
+		s.DependentSchemas = nil
+		d.removed(sourceKey(s.GoLow(), "dependentSchemas"), path, "dependentSchemas")
+	}
+	if orderedmap.Len(s.DependentRequired) > 0 {
+		s.DependentRequired = nil
+		d.removed(sourceKey(s.GoLow(), "dependentRequired"), path, "dependentRequired")
+	}
+	if orderedmap.Len(s.PatternProperties) > 0 {
+		s.PatternProperties = nil
+		d.removed(sourceKey(s.GoLow(), "patternProperties"), path, "patternProperties")
+	}
+	if s.PropertyNames != nil {
+		s.PropertyNames = nil
+		d.removed(sourceKey(s.GoLow(), "propertyNames"), path, "propertyNames")
+	}
+	if s.UnevaluatedItems != nil {
+		s.UnevaluatedItems = nil
+		d.removed(sourceKey(s.GoLow(), "unevaluatedItems"), path, "unevaluatedItems")
+	}
+	if s.UnevaluatedProperties != nil {
+		s.UnevaluatedProperties = nil
+		d.removed(sourceKey(s.GoLow(), "unevaluatedProperties"), path, "unevaluatedProperties")
+	}
+}
+
+// types turns a 'null' type into 'nullable: true'. OpenAPI 3.0 only allows a single type, multiple types become
+// an 'anyOf' with a schema for each type.
+func (d *downgrader) types(s *base.Schema, path string) {
+	if slices.Contains(s.Type, "null") {
+		s.Type = slices.DeleteFunc(slices.Clone(s.Type), func(t string) bool { return t == "null" })
+		if len(s.Type) == 0 {
+			s.Type = nil
+			d.report.add(sourceKey(s.GoLow(), "type"), pathKey(path, "type"),
+				"a 'null' type cannot be expressed in OpenAPI 3.0, it has been removed")
+		} else {
+			nullable := true
+			s.Nullable = &nullable
+		}
+	}
+	if len(s.Type) < 2 {
+		return
+	}
+	if len(s.AnyOf) > 0 {
+		d.report.add(sourceKey(s.GoLow(), "type"), pathKey(path, "type"),
+			fmt.Sprintf("multiple types cannot be expressed in OpenAPI 3.0, only '%s' has been kept", s.Type[0]))
+		s.Type = s.Type[:1]
+		return
+	}
+	for _, t := range s.Type {
+		s.AnyOf = append(s.AnyOf, base.CreateSchemaProxy(&base.Schema{Type: []string{t}, Nullable: s.Nullable}))
+	}
+	s.Type = nil
+	s.Nullable = nil
+}
+
+// exclusiveBoolean converts a numeric 'exclusiveMinimum' or 'exclusiveMaximum' into a boolean that modifies the
+// limit. If both are set, the stricter of the two is kept.
+func exclusiveBoolean(exclusive **base.DynamicValue[bool, float64], limit **float64, stricter func(e, l float64) bool) {
+	e := *exclusive
+	if e == nil || !e.IsB() {
+		return
+	}
+	if *limit == nil || stricter(e.B, **limit) {
+		value := e.B
+		*limit = &value
+		*exclusive = &base.DynamicValue[bool, float64]{A: true}
+		return
+	}
+	*exclusive = nil
+}
+
+// examples keeps the first of the 'examples', OpenAPI 3.0 schemas only have a single 'example'.
+func (d *downgrader) examples(s *base.Schema, path string) {
+	if len(s.Examples) == 0 {
+		return
+	}
+	kept := 0
+	if s.Example == nil {
+		s.Example = s.Examples[0]
+		kept = 1
+	}
+	if lost := len(s.Examples) - kept; lost > 0 {
+		d.report.add(sourceKey(s.GoLow(), "examples"), pathKey(path, "examples"),
+			fmt.Sprintf("a schema can only have a single example in OpenAPI 3.0, %d examples have been removed", lost))
+	}
+	s.Examples = nil
+}
+
+// content converts 'contentEncoding' and 'contentMediaType' back into 'format: byte' and 'format: binary'.
+func (d *downgrader) content(s *base.Schema, path string) {
+	if s.ContentEncoding == "" && s.ContentMediaType == "" {
+		return
+	}
+	switch {
+	case s.ContentEncoding == "base64" && s.Format == "":
+		s.Format = "byte"
+		if s.ContentMediaType != "" {
+			d.removed(sourceKey(s.GoLow(), "contentMediaType"), path, "contentMediaType")
+		}
+	case s.ContentEncoding != "":
+		d.removed(sourceKey(s.GoLow(), "contentEncoding"), path, "contentEncoding")
+		if s.ContentMediaType != "" {
+			d.removed(sourceKey(s.GoLow(), "contentMediaType"), path, "contentMediaType")
+		}
+	case isText(s.ContentMediaType) || s.Format != "":
+		d.removed(sourceKey(s.GoLow(), "contentMediaType"), path, "contentMediaType")
+	default:
+		s.Format = "binary"
+	}
+	s.ContentEncoding = ""
+	s.ContentMediaType = ""
+}
+
+// items converts a boolean 'items' into a schema. An empty schema allows every item, and no items are allowed
+// by a schema that is 'not' an empty schema.
+func (d *downgrader) items(s *base.Schema) {
+	if s.Items == nil || !s.Items.IsB() {
+		return
+	}
+	items := &base.Schema{}
+	if !s.Items.B {
+		items.Not = base.CreateSchemaProxy(&base.Schema{})
+	}
+	s.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(items)}
+}
+
+// isText returns true if a media type is not binary content, so a string of it is not 'format: binary'.
+func isText(mediaType string) bool {
+	mediaType = strings.ToLower(concreteMediaType(mediaType))
+	return strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "json") ||
+		strings.HasSuffix(mediaType, "xml") || strings.HasSuffix(mediaType, "yaml")
+}
@@ -0,0 +1,397 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowngradeDocument_Schemas(t *testing.T) {
+	doc := buildV3Document(t, `openapi: 3.1.0
+components:
+  schemas:
+    Burger:
+      type: object
+      examples:
+        - name: Big Mac
+        - name: Whopper
+      properties:
+        name:
+          type: [string, "null"]
+        size:
+          const: large
+        fries:
+          type: integer
+          exclusiveMinimum: 1
+          minimum: 0
+          exclusiveMaximum: 10
+          maximum: 5
+        sauce:
+          type: [string, number]
+        photo:
+          type: string
+          contentMediaType: image/png
+        receipt:
+          type: string
+          contentEncoding: base64
+        toppings:
+          type: array
+          items: true
+        extras:
+          type: array
+          items: false
+    Sauce:
+      type: "null"`)
+
+	report, err := DowngradeDocument(doc)
+	require.NoError(t, err)
+
+	expected := `openapi: 3.0.3
+components:
+    schemas:
+        Burger:
+            type: object
+            properties:
+                name:
+                    type: string
+                    nullable: true
+                size:
+                    enum:
+                        - large
+                fries:
+                    type: integer
+                    exclusiveMinimum: true
+                    minimum: 1
+                    maximum: 5
+                sauce:
+                    anyOf:
+                        - type: string
+                        - type: number
+                photo:
+                    type: string
+                    format: binary
+                receipt:
+                    type: string
+                    format: byte
+                toppings:
+                    type: array
+                    items: {}
+                extras:
+                    type: array
+                    items:
+                        not: {}
+            example:
+                name: Big Mac
+        Sauce: {}
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+	assert.Equal(t, []string{
+		"$.components.schemas.Burger.examples: a schema can only have a single example in OpenAPI 3.0, 1 examples have been removed",
+		"$.components.schemas.Sauce.type: a 'null' type cannot be expressed in OpenAPI 3.0, it has been removed",
+	}, issueMessages(report))
+}
+
+func TestDowngradeDocument_Unsupported(t *testing.T) {
+	doc := buildV3Document(t, `openapi: 3.1.0
+components:
+  schemas:
+    Burger:
+      $schema: https://json-schema.org/draft/2020-12/schema
+      $id: https://example.com/burger
+      $comment: tasty
+      type: array
+      prefixItems:
+        - type: string
+      items: false
+      contains:
+        type: string
+      minContains: 1
+      maxContains: 2
+      unevaluatedItems:
+        type: string
+    Order:
+      $anchor: order
+      $dynamicAnchor: node
+      type: object
+      if:
+        required: [drink]
+      then:
+        required: [size]
+      else:
+        required: [fries]
+      dependentSchemas:
+        drink:
+          required: [ice]
+      dependentRequired:
+        drink: [size]
+      patternProperties:
+        ^x-:
+          type: string
+      propertyNames:
+        maxLength: 10
+      unevaluatedProperties: false
+      $defs:
+        size:
+          type: string
+      properties:
+        next:
+          $dynamicRef: '#node'
+        note:
+          type: string
+          contentMediaType: application/json
+        scan:
+          type: string
+          contentEncoding: base32
+          contentMediaType: image/png`)
+
+	report, err := DowngradeDocument(doc)
+	require.NoError(t, err)
+
+	expected := `openapi: 3.0.3
+components:
+    schemas:
+        Burger:
+            type: array
+            items:
+                not: {}
+        Order:
+            type: object
+            properties:
+                next: {}
+                note:
+                    type: string
+                scan:
+                    type: string
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+	assert.Equal(t, []string{
+		"$.components.schemas.Burger.$schema: '$schema' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.$id: '$id' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.$comment: '$comment' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.prefixItems: 'prefixItems' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.contains: 'contains' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.minContains: 'minContains' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.maxContains: 'maxContains' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Burger.unevaluatedItems: 'unevaluatedItems' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.$anchor: '$anchor' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.$dynamicAnchor: '$dynamicAnchor' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.$defs: '$defs' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.if: 'if' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.then: 'then' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.else: 'else' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.dependentSchemas: 'dependentSchemas' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.dependentRequired: 'dependentRequired' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.patternProperties: 'patternProperties' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.propertyNames: 'propertyNames' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.unevaluatedProperties: 'unevaluatedProperties' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.properties.next.$dynamicRef: '$dynamicRef' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.properties.note.contentMediaType: 'contentMediaType' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.properties.scan.contentEncoding: 'contentEncoding' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.schemas.Order.properties.scan.contentMediaType: 'contentMediaType' cannot be expressed in OpenAPI 3.0, it has been removed",
+	}, issueMessages(report))
+
+	// every issue points at the keyword in the source document.
+	assert.Equal(t, 9, report.Issues[3].Line)
+	assert.Equal(t, 7, report.Issues[3].Column)
+}
+
+func TestDowngradeDocument_Document(t *testing.T) {
+	doc := buildV3Document(t, `openapi: 3.2.0
+$self: https://example.com/burgers.yaml
+jsonSchemaDialect: https://json-schema.org/draft/2020-12/schema
+info:
+  title: Burgers
+  summary: Burgers API
+  version: 1.0.0
+  license:
+    name: MIT
+    identifier: MIT
+tags:
+  - name: burgers
+    summary: Burgers
+    parent: food
+    kind: nav
+paths:
+  /burgers:
+    query:
+      responses:
+        default:
+          description: OK
+    additionalOperations:
+      COPY:
+        responses:
+          default:
+            description: OK
+    get:
+      responses:
+        default:
+          description: OK
+          content:
+            application/jsonl:
+              itemSchema:
+                type: string
+              itemEncoding:
+                contentType: text/plain
+              prefixEncoding:
+                - contentType: text/plain
+webhooks:
+  cooked:
+    post:
+      responses:
+        default:
+          description: OK
+components:
+  pathItems:
+    Burgers:
+      get:
+        responses:
+          default:
+            description: OK
+  mediaTypes:
+    Burger:
+      schema:
+        type: string
+  securitySchemes:
+    OAuth:
+      type: oauth2
+      oauth2MetadataUrl: https://example.com/.well-known/oauth-authorization-server
+      deprecated: true
+      flows:
+        deviceAuthorization:
+          deviceAuthorizationUrl: https://example.com/device
+          tokenUrl: https://example.com/token
+          scopes: {}`)
+
+	report, err := DowngradeDocument(doc)
+	require.NoError(t, err)
+
+	expected := `openapi: 3.0.3
+info:
+    title: Burgers
+    version: 1.0.0
+    license:
+        name: MIT
+tags:
+    - name: burgers
+paths:
+    /burgers:
+        get:
+            responses:
+                default:
+                    description: OK
+                    content:
+                        application/jsonl: {}
+components:
+    securitySchemes:
+        OAuth:
+            type: oauth2
+            flows: {}
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+	assert.Equal(t, []string{
+		"$.$self: '$self' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.jsonSchemaDialect: 'jsonSchemaDialect' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.webhooks: 'webhooks' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.info.summary: 'summary' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.info.license.identifier: 'identifier' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.tags[0].summary: 'summary' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.tags[0].parent: 'parent' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.tags[0].kind: 'kind' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.pathItems: 'pathItems' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.mediaTypes: 'mediaTypes' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.securitySchemes.OAuth.oauth2MetadataUrl: 'oauth2MetadataUrl' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.securitySchemes.OAuth.deprecated: 'deprecated' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.components.securitySchemes.OAuth.flows.deviceAuthorization: 'deviceAuthorization' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.paths['/burgers'].query: 'query' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.paths['/burgers'].additionalOperations: 'additionalOperations' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.paths['/burgers'].get.responses.default.content['application/jsonl'].itemSchema: 'itemSchema' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.paths['/burgers'].get.responses.default.content['application/jsonl'].itemEncoding: 'itemEncoding' cannot be expressed in OpenAPI 3.0, it has been removed",
+		"$.paths['/burgers'].get.responses.default.content['application/jsonl'].prefixEncoding: 'prefixEncoding' cannot be expressed in OpenAPI 3.0, it has been removed",
+	}, issueMessages(report))
+	assert.Equal(t, 2, report.Issues[0].Line)
+	assert.Equal(t, 18, report.Issues[13].Line)
+}
+
+func TestDowngradeDocument_RoundTrip(t *testing.T) {
+	spec := `openapi: 3.0.3
+paths:
+  /burgers:
+    put:
+      requestBody:
+        content:
+          image/png:
+            schema:
+              type: string
+              format: binary
+      responses:
+        default:
+          description: OK
+components:
+  schemas:
+    Burger:
+      type: object
+      properties:
+        name:
+          type: string
+          nullable: true
+          example: Big Mac
+        fries:
+          type: integer
+          minimum: 1
+          exclusiveMinimum: true
+        photo:
+          type: string
+          format: byte
+`
+	doc := buildV3Document(t, spec)
+	_, err := UpgradeDocument(doc)
+	require.NoError(t, err)
+	report, err := DowngradeDocument(doc)
+	require.NoError(t, err)
+	assert.False(t, report.IsLossy())
+
+	expected := `openapi: 3.0.3
+paths:
+    /burgers:
+        put:
+            requestBody:
+                content:
+                    image/png:
+                        schema:
+                            type: string
+                            format: binary
+            responses:
+                default:
+                    description: OK
+components:
+    schemas:
+        Burger:
+            type: object
+            properties:
+                name:
+                    type: string
+                    nullable: true
+                    example: Big Mac
+                fries:
+                    type: integer
+                    minimum: 1
+                    exclusiveMinimum: true
+                photo:
+                    type: string
+                    format: byte
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+}
+
+func TestDowngradeDocument_Version(t *testing.T) {
+	_, err := DowngradeDocument(nil)
+	assert.ErrorIs(t, err, ErrInvalidModel)
+
+	doc := buildV3Document(t, `openapi: 3.0.3`)
+	_, err = DowngradeDocument(doc)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// cloneNode creates a deep copy of a node, so the source document is never mutated. Aliases are expanded and
+// anchors are dropped, because the anchored node may not survive the conversion.
+func cloneNode(n *yaml.Node) *yaml.Node {
+	if n == nil {
+		return nil
+	}
+	if n.Kind == yaml.AliasNode && n.Alias != nil {
+		return cloneNode(n.Alias)
+	}
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		return cloneNode(n.Content[0])
+	}
+	c := *n
+	c.Anchor = ""
+	if len(n.Content) > 0 {
+		c.Content = make([]*yaml.Node, len(n.Content))
+		for i := range n.Content {
+			c.Content[i] = cloneNode(n.Content[i])
+		}
+	}
+	return &c
+}
+
+// addKey appends a key and value to a mapping node, nil values are skipped.
+func addKey(m *yaml.Node, key string, value *yaml.Node) {
+	if value == nil {
+		return
+	}
+	m.Content = append(m.Content, utils.CreateStringNode(key), value)
+}
+
+// findKey returns the value of a key in a mapping node, or nil if it does not exist.
+func findKey(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setKey replaces the value of a key in a mapping node, or appends it if it does not exist.
+func setKey(m *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		if m.Content[i].Value == key {
+			m.Content[i+1] = value
+			return
+		}
+	}
+	addKey(m, key, value)
+}
+
+// addExtensions appends all extensions to a mapping node, in the order they were defined.
+func addExtensions(m *yaml.Node, ext *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]) {
+	for k, v := range ext.FromOldest() {
+		addKey(m, k.Value, cloneNode(v.Value))
+	}
+}
+
+// fromSource iterates over a low level map in the order the keys appear in the source document, maps are not
+// always built in order.
+func fromSource[T any](m *orderedmap.Map[low.KeyReference[string], low.ValueReference[T]]) iter.Seq2[low.KeyReference[string], low.ValueReference[T]] {
+	type pair struct {
+		key   low.KeyReference[string]
+		value low.ValueReference[T]
+	}
+	var pairs []pair
+	for k, v := range m.FromOldest() {
+		pairs = append(pairs, pair{k, v})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return keyLine(pairs[i].key.KeyNode) < keyLine(pairs[j].key.KeyNode)
+	})
+	return func(yield func(low.KeyReference[string], low.ValueReference[T]) bool) {
+		for _, p := range pairs {
+			if !yield(p.key, p.value) {
+				return
+			}
+		}
+	}
+}
+
+func keyLine(n *yaml.Node) int {
+	if n == nil {
+		return 0
+	}
+	return n.Line
+}
+
+// stringSlice extracts the values from a low level array of strings.
+func stringSlice(n low.NodeReference[[]low.ValueReference[string]]) []string {
+	var s []string
+	for _, v := range n.Value {
+		s = append(s, v.Value)
+	}
+	return s
+}
+
+// stringSequence creates a sequence node from a slice of strings.
+func stringSequence(values []string) *yaml.Node {
+	seq := utils.CreateEmptySequenceNode()
+	for _, v := range values {
+		seq.Content = append(seq.Content, utils.CreateStringNode(v))
+	}
+	return seq
+}
+
+// pathKey appends a key to a JSON Path, using bracket notation when the key is not a simple name.
+func pathKey(path, key string) string {
+	if strings.ContainsAny(key, "/.~[]'{} -") {
+		return fmt.Sprintf("%s['%s']", path, key)
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
+// sourceKey returns the key node of a property in the source of a low level model, so an Issue can point at the
+// line it was defined on. Nil is returned when the model was not built from a document.
+func sourceKey(l low.HasRootNode, key string) *yaml.Node {
+	if l == nil || reflect.ValueOf(l).IsNil() {
+		return nil
+	}
+	return keyNode(l.GetRootNode(), key)
+}
+
+// keyNode returns the key node of a key in a mapping node, or nil if it does not exist.
+func keyNode(m *yaml.Node, key string) *yaml.Node {
+	if m != nil && m.Kind == yaml.DocumentNode && len(m.Content) > 0 {
+		m = m.Content[0]
+	}
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i]
+		}
+	}
+	return nil
+}
+
+// isReference returns true if the low level model behind a high level object was built from a $ref. Referenced
+// objects are transformed where they are defined, not everywhere they are used.
+func isReference(l low.IsReferenced) bool {
+	if l == nil || reflect.ValueOf(l).IsNil() {
+		return false
+	}
+	return l.IsReference()
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi/utils"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCloneNode(t *testing.T) {
+	var root yaml.Node
+	_ = yaml.Unmarshal([]byte(`burger: &burger
+  name: big mac
+copy: *burger`), &root)
+
+	clone := cloneNode(&root)
+	assert.Equal(t, yaml.MappingNode, clone.Kind)
+
+	// aliases are expanded, and anchors are dropped.
+	cp := findKey(clone, "copy")
+	assert.Equal(t, yaml.MappingNode, cp.Kind)
+	assert.Equal(t, "big mac", findKey(cp, "name").Value)
+	assert.Empty(t, findKey(clone, "burger").Anchor)
+
+	// the source is never touched.
+	findKey(cp, "name").Value = "whopper"
+	assert.Equal(t, "big mac", findKey(root.Content[0].Content[1], "name").Value)
+	assert.Nil(t, cloneNode(nil))
+}
+
+func TestSetKey(t *testing.T) {
+	m := utils.CreateEmptyMapNode()
+	setKey(m, "format", utils.CreateStringNode("int32"))
+	setKey(m, "format", utils.CreateStringNode("binary"))
+	assert.Len(t, m.Content, 2)
+	assert.Equal(t, "binary", findKey(m, "format").Value)
+	assert.Nil(t, findKey(utils.CreateStringNode("nope"), "format"))
+}
+
+func TestPathKey(t *testing.T) {
+	assert.Equal(t, "$.paths['/burgers']", pathKey("$.paths", "/burgers"))
+	assert.Equal(t, "$.definitions.Burger", pathKey("$.definitions", "Burger"))
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// OpenAPI31Version is the version set on a document upgraded by UpgradeDocument.
+const OpenAPI31Version = "3.1.1"
+
+// ErrUnsupportedVersion is returned when a document is not the version expected by a conversion.
+var ErrUnsupportedVersion = errors.New("unsupported version")
+
+// UpgradeDocument upgrades an OpenAPI 3.0 document to OpenAPI 3.1. The document is modified in place, schemas are
+// rewritten to use JSON Schema 2020-12 semantics:
+//
+//   - 'nullable: true' becomes a 'null' entry in the type array.
+//   - boolean 'exclusiveMinimum' and 'exclusiveMaximum' become numbers, replacing 'minimum' and 'maximum'.
+//   - 'example' becomes 'examples'.
+//   - 'format: binary' becomes 'contentMediaType' and 'format: byte' becomes 'contentEncoding: base64'.
+//
+// Referenced objects are upgraded where they are defined, schemas in external documents are left untouched.
+// A Report is always returned, it contains anything that had no effect in OpenAPI 3.0 and has been removed.
+func UpgradeDocument(doc *v3high.Document) (*Report, error) {
+	if doc == nil {
+		return nil, ErrInvalidModel
+	}
+	if !strings.HasPrefix(doc.Version, "3.0") {
+		return nil, fmt.Errorf("%w: cannot upgrade version '%s', expected 3.0", ErrUnsupportedVersion, doc.Version)
+	}
+	u := &upgrader{report: new(Report)}
+	walkDocument(doc, u)
+	doc.Version = OpenAPI31Version
+	return u.report, nil
+}
+
+// DowngradeDocument downgrades an OpenAPI 3.1 (or 3.2) document to OpenAPI 3.0. The document is modified in place,
+// schemas are rewritten to use OpenAPI 3.0 semantics, reversing everything done by UpgradeDocument.
+//
+// A lot of JSON Schema 2020-12 cannot be expressed in OpenAPI 3.0, for example 'if/then/else' or 'prefixItems'.
+// Anything that cannot be expressed is removed, and recorded as an Issue in the returned Report.
+func DowngradeDocument(doc *v3high.Document) (*Report, error) {
+	if doc == nil {
+		return nil, ErrInvalidModel
+	}
+	if !strings.HasPrefix(doc.Version, "3.1") && !strings.HasPrefix(doc.Version, "3.2") {
+		return nil, fmt.Errorf("%w: cannot downgrade version '%s', expected 3.1 or 3.2", ErrUnsupportedVersion, doc.Version)
+	}
+	d := &downgrader{report: new(Report)}
+	walkDocument(doc, d)
+	doc.Version = OpenAPIVersion
+	return d.report, nil
+}
+
+// documentVisitor is implemented by transformations that modify a document. The document is visited first, then
+// every path item, media type and schema is visited before its children are walked, so a visitor can remove
+// anything it does not want to be walked.
+type documentVisitor interface {
+	document(doc *v3high.Document)
+	pathItem(p *v3high.PathItem, path string)
+	mediaType(name string, m *v3high.MediaType, path string)
+	schema(s *base.Schema, path string)
+}
+
+type walker struct {
+	visitor documentVisitor
+}
+
+func walkDocument(doc *v3high.Document, visitor documentVisitor) {
+	w := &walker{visitor: visitor}
+	visitor.document(doc)
+	if doc.Paths != nil {
+		for k, p := range doc.Paths.PathItems.FromOldest() {
+			w.pathItem(p, pathKey("$.paths", k))
+		}
+	}
+	for k, p := range doc.Webhooks.FromOldest() {
+		w.pathItem(p, pathKey("$.webhooks", k))
+	}
+	if c := doc.Components; c != nil {
+		w.schemaMap(c.Schemas, "$.components.schemas")
+		for k, r := range c.Responses.FromOldest() {
+			w.response(r, pathKey("$.components.responses", k))
+		}
+		for k, p := range c.Parameters.FromOldest() {
+			w.parameter(p, pathKey("$.components.parameters", k))
+		}
+		for k, r := range c.RequestBodies.FromOldest() {
+			w.requestBody(r, pathKey("$.components.requestBodies", k))
+		}
+		w.headers(c.Headers, "$.components.headers")
+		for k, cb := range c.Callbacks.FromOldest() {
+			w.callback(cb, pathKey("$.components.callbacks", k))
+		}
+		for k, p := range c.PathItems.FromOldest() {
+			w.pathItem(p, pathKey("$.components.pathItems", k))
+		}
+		w.content(c.MediaTypes, "$.components.mediaTypes")
+	}
+}
+
+func (w *walker) pathItem(p *v3high.PathItem, path string) {
+	if p == nil || isReference(p.GoLow()) {
+		return
+	}
+	w.visitor.pathItem(p, path)
+	w.parameters(p.Parameters, pathKey(path, "parameters"))
+	for method, op := range p.GetOperations().FromOldest() {
+		w.operation(op, pathKey(path, method))
+	}
+}
+
+func (w *walker) operation(op *v3high.Operation, path string) {
+	if op == nil {
+		return
+	}
+	w.parameters(op.Parameters, pathKey(path, "parameters"))
+	w.requestBody(op.RequestBody, pathKey(path, "requestBody"))
+	if op.Responses != nil {
+		for code, r := range op.Responses.Codes.FromOldest() {
+			w.response(r, pathKey(pathKey(path, "responses"), code))
+		}
+		w.response(op.Responses.Default, pathKey(pathKey(path, "responses"), "default"))
+	}
+	for k, cb := range op.Callbacks.FromOldest() {
+		w.callback(cb, pathKey(pathKey(path, "callbacks"), k))
+	}
+}
+
+func (w *walker) callback(cb *v3high.Callback, path string) {
+	if cb == nil || isReference(cb.GoLow()) {
+		return
+	}
+	for expression, p := range cb.Expression.FromOldest() {
+		w.pathItem(p, pathKey(path, expression))
+	}
+}
+
+func (w *walker) parameters(params []*v3high.Parameter, path string) {
+	for i, p := range params {
+		w.parameter(p, path+"["+strconv.Itoa(i)+"]")
+	}
+}
+
+func (w *walker) parameter(p *v3high.Parameter, path string) {
+	if p == nil || isReference(p.GoLow()) {
+		return
+	}
+	w.schemaProxy(p.Schema, pathKey(path, "schema"))
+	w.content(p.Content, pathKey(path, "content"))
+}
+
+func (w *walker) requestBody(r *v3high.RequestBody, path string) {
+	if r == nil || isReference(r.GoLow()) {
+		return
+	}
+	w.content(r.Content, pathKey(path, "content"))
+}
+
+func (w *walker) response(r *v3high.Response, path string) {
+	if r == nil || isReference(r.GoLow()) {
+		return
+	}
+	w.headers(r.Headers, pathKey(path, "headers"))
+	w.content(r.Content, pathKey(path, "content"))
+}
+
+func (w *walker) headers(headers *orderedmap.Map[string, *v3high.Header], path string) {
+	for k, h := range headers.FromOldest() {
+		if h == nil || isReference(h.GoLow()) {
+			continue
+		}
+		w.schemaProxy(h.Schema, pathKey(pathKey(path, k), "schema"))
+		w.content(h.Content, pathKey(pathKey(path, k), "content"))
+	}
+}
+
+func (w *walker) content(content *orderedmap.Map[string, *v3high.MediaType], path string) {
+	for k, m := range content.FromOldest() {
+		if m == nil || isReference(m.GoLow()) {
+			continue
+		}
+		mPath := pathKey(path, k)
+		w.visitor.mediaType(k, m, mPath)
+		w.schemaProxy(m.Schema, pathKey(mPath, "schema"))
+		w.schemaProxy(m.ItemSchema, pathKey(mPath, "itemSchema"))
+		for ek, e := range m.Encoding.FromOldest() {
+			w.encoding(e, pathKey(pathKey(mPath, "encoding"), ek))
+		}
+		for i, e := range m.PrefixEncoding {
+			w.encoding(e, pathKey(mPath, "prefixEncoding")+"["+strconv.Itoa(i)+"]")
+		}
+		w.encoding(m.ItemEncoding, pathKey(mPath, "itemEncoding"))
+	}
+}
+
+func (w *walker) encoding(e *v3high.Encoding, path string) {
+	if e == nil {
+		return
+	}
+	w.headers(e.Headers, pathKey(path, "headers"))
+}
+
+func (w *walker) schemaProxy(sp *base.SchemaProxy, path string) {
+	if sp == nil || sp.IsReference() {
+		return
+	}
+	s := sp.Schema()
+	if s == nil {
+		return
+	}
+	w.visitor.schema(s, path)
+	w.schemaProxies(s.AllOf, pathKey(path, "allOf"))
+	w.schemaProxies(s.OneOf, pathKey(path, "oneOf"))
+	w.schemaProxies(s.AnyOf, pathKey(path, "anyOf"))
+	w.schemaProxies(s.PrefixItems, pathKey(path, "prefixItems"))
+	w.schemaProxy(s.Not, pathKey(path, "not"))
+	w.schemaProxy(s.Contains, pathKey(path, "contains"))
+	w.schemaProxy(s.If, pathKey(path, "if"))
+	w.schemaProxy(s.Then, pathKey(path, "then"))
+	w.schemaProxy(s.Else, pathKey(path, "else"))
+	w.schemaProxy(s.PropertyNames, pathKey(path, "propertyNames"))
+	w.schemaProxy(s.UnevaluatedItems, pathKey(path, "unevaluatedItems"))
+	w.dynamicSchema(s.Items, pathKey(path, "items"))
+	w.dynamicSchema(s.AdditionalProperties, pathKey(path, "additionalProperties"))
+	w.dynamicSchema(s.UnevaluatedProperties, pathKey(path, "unevaluatedProperties"))
+	w.schemaMap(s.Properties, pathKey(path, "properties"))
+	w.schemaMap(s.PatternProperties, pathKey(path, "patternProperties"))
+	w.schemaMap(s.DependentSchemas, pathKey(path, "dependentSchemas"))
+	w.schemaMap(s.Defs, pathKey(path, "$defs"))
+}
+
+func (w *walker) schemaProxies(proxies []*base.SchemaProxy, path string) {
+	for i, sp := range proxies {
+		w.schemaProxy(sp, path+"["+strconv.Itoa(i)+"]")
+	}
+}
+
+func (w *walker) dynamicSchema(v *base.DynamicValue[*base.SchemaProxy, bool], path string) {
+	if v != nil && v.IsA() {
+		w.schemaProxy(v.A, path)
+	}
+}
+
+func (w *walker) schemaMap(schemas *orderedmap.Map[string, *base.SchemaProxy], path string) {
+	for k, sp := range schemas.FromOldest() {
+		w.schemaProxy(sp, pathKey(path, k))
+	}
+}
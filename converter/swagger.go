@@ -0,0 +1,755 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// OpenAPIVersion is the version of OpenAPI that Swagger documents are converted into.
+	OpenAPIVersion = "3.0.3"
+
+	defaultMediaType = "application/json"
+	formURLEncoded   = "application/x-www-form-urlencoded"
+	multipartForm    = "multipart/form-data"
+)
+
+// keys copied from Swagger parameters, headers and items into a schema.
+var simpleSchemaKeys = []string{
+	"type", "format", "items", "default", "maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum",
+	"maxLength", "minLength", "pattern", "maxItems", "minItems", "uniqueItems", "enum", "multipleOf",
+}
+
+// Swagger flow names mapped to OpenAPI 3 flow names.
+var oauthFlows = map[string]string{
+	"implicit":    "implicit",
+	"password":    "password",
+	"application": "clientCredentials",
+	"accessCode":  "authorizationCode",
+}
+
+type swaggerConverter struct {
+	swagger        *v2.Swagger
+	report         *Report
+	bodyParameters map[string]bool
+	formParameters map[string]bool
+	consumes       []string
+	produces       []string
+	schemes        []string
+}
+
+func newSwaggerConverter(swagger *v2.Swagger) *swaggerConverter {
+	c := &swaggerConverter{
+		swagger:        swagger,
+		report:         &Report{},
+		bodyParameters: make(map[string]bool),
+		formParameters: make(map[string]bool),
+		consumes:       stringSlice(swagger.Consumes),
+		produces:       stringSlice(swagger.Produces),
+		schemes:        stringSlice(swagger.Schemes),
+	}
+	if !swagger.Parameters.IsEmpty() {
+		for k, v := range swagger.Parameters.Value.Definitions.FromOldest() {
+			switch v.Value.In.Value {
+			case "body":
+				c.bodyParameters[k.Value] = true
+			case "formData":
+				c.formParameters[k.Value] = true
+			}
+		}
+	}
+	return c
+}
+
+// convert builds the root mapping node of the OpenAPI 3 document.
+func (c *swaggerConverter) convert() *yaml.Node {
+	s := c.swagger
+	root := utils.CreateEmptyMapNode()
+	addKey(root, "openapi", utils.CreateStringNode(OpenAPIVersion))
+	if !s.Info.IsEmpty() {
+		addKey(root, "info", cloneNode(s.Info.ValueNode))
+	}
+	addKey(root, "servers", c.servers(c.schemes, s.Host.ValueNode, "$"))
+	if !s.Paths.IsEmpty() {
+		addKey(root, "paths", c.paths(s.Paths.Value))
+	}
+	addKey(root, "components", c.components())
+	if !s.Security.IsEmpty() {
+		addKey(root, "security", cloneNode(s.Security.ValueNode))
+	}
+	if !s.Tags.IsEmpty() {
+		addKey(root, "tags", cloneNode(s.Tags.ValueNode))
+	}
+	if !s.ExternalDocs.IsEmpty() {
+		addKey(root, "externalDocs", cloneNode(s.ExternalDocs.ValueNode))
+	}
+	addExtensions(root, s.Extensions)
+	return root
+}
+
+// servers maps host, basePath and schemes into a list of servers.
+func (c *swaggerConverter) servers(schemes []string, node *yaml.Node, path string) *yaml.Node {
+	host := c.swagger.Host.Value
+	basePath := c.swagger.BasePath.Value
+	if host == "" && basePath == "" && len(schemes) == 0 {
+		return nil
+	}
+	seq := utils.CreateEmptySequenceNode()
+	if host == "" {
+		if len(schemes) > 0 {
+			c.report.add(node, path, "schemes cannot be used without a host, the server URL is relative")
+		}
+		if basePath == "" {
+			basePath = "/"
+		}
+		server := utils.CreateEmptyMapNode()
+		addKey(server, "url", utils.CreateStringNode(basePath))
+		seq.Content = append(seq.Content, server)
+		return seq
+	}
+	if len(schemes) == 0 {
+		c.report.add(node, path, "no schemes are defined, 'https' has been assumed for the server URL")
+		schemes = []string{"https"}
+	}
+	for _, scheme := range schemes {
+		server := utils.CreateEmptyMapNode()
+		addKey(server, "url", utils.CreateStringNode(fmt.Sprintf("%s://%s%s", scheme, host, basePath)))
+		seq.Content = append(seq.Content, server)
+	}
+	return seq
+}
+
+func (c *swaggerConverter) paths(paths *v2.Paths) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	for k, v := range fromSource(paths.PathItems) {
+		addKey(m, k.Value, c.pathItem(v.Value, pathKey("$.paths", k.Value)))
+	}
+	addExtensions(m, paths.Extensions)
+	return m
+}
+
+func (c *swaggerConverter) pathItem(pi *v2.PathItem, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	if !pi.Ref.IsEmpty() {
+		addKey(m, "$ref", utils.CreateStringNode(c.rewriteRef(pi.Ref.Value, pi.Ref.ValueNode, path)))
+	}
+
+	// body and form parameters defined for the path, are pushed down into every operation.
+	var shared []low.ValueReference[*v2.Parameter]
+	if !pi.Parameters.IsEmpty() {
+		params := utils.CreateEmptySequenceNode()
+		for i, p := range pi.Parameters.Value {
+			switch p.Value.In.Value {
+			case "body", "formData":
+				shared = append(shared, p)
+			default:
+				params.Content = append(params.Content,
+					c.parameterOrRef(p, fmt.Sprintf("%s.parameters[%d]", path, i)))
+			}
+		}
+		if len(params.Content) > 0 {
+			addKey(m, "parameters", params)
+		}
+	}
+
+	type namedOperation struct {
+		name string
+		op   low.NodeReference[*v2.Operation]
+	}
+	var ops []namedOperation
+	for _, o := range []namedOperation{
+		{"get", pi.Get}, {"put", pi.Put}, {"post", pi.Post}, {"delete", pi.Delete},
+		{"options", pi.Options}, {"head", pi.Head}, {"patch", pi.Patch},
+	} {
+		if !o.op.IsEmpty() {
+			ops = append(ops, o)
+		}
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		return keyLine(ops[i].op.KeyNode) < keyLine(ops[j].op.KeyNode)
+	})
+	for _, o := range ops {
+		addKey(m, o.name, c.operation(o.op.Value, shared, pathKey(path, o.name)))
+	}
+	addExtensions(m, pi.Extensions)
+	return m
+}
+
+func (c *swaggerConverter) operation(op *v2.Operation, shared []low.ValueReference[*v2.Parameter], path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	if !op.Tags.IsEmpty() {
+		addKey(m, "tags", cloneNode(op.Tags.ValueNode))
+	}
+	if !op.Summary.IsEmpty() {
+		addKey(m, "summary", cloneNode(op.Summary.ValueNode))
+	}
+	if !op.Description.IsEmpty() {
+		addKey(m, "description", cloneNode(op.Description.ValueNode))
+	}
+	if !op.ExternalDocs.IsEmpty() {
+		addKey(m, "externalDocs", cloneNode(op.ExternalDocs.ValueNode))
+	}
+	if !op.OperationId.IsEmpty() {
+		addKey(m, "operationId", cloneNode(op.OperationId.ValueNode))
+	}
+
+	consumes := c.consumes
+	if !op.Consumes.IsEmpty() {
+		consumes = stringSlice(op.Consumes)
+	}
+	produces := c.produces
+	if !op.Produces.IsEmpty() {
+		produces = stringSlice(op.Produces)
+	}
+
+	var body *low.ValueReference[*v2.Parameter]
+	var bodyPath string
+	var form []low.ValueReference[*v2.Parameter]
+	params := utils.CreateEmptySequenceNode()
+	overridden := make(map[string]bool)
+
+	for i, p := range op.Parameters.Value {
+		pPath := fmt.Sprintf("%s.parameters[%d]", path, i)
+		overridden[p.Value.In.Value+":"+p.Value.Name.Value] = true
+		switch p.Value.In.Value {
+		case "body":
+			body = &op.Parameters.Value[i]
+			bodyPath = pPath
+		case "formData":
+			form = append(form, p)
+		default:
+			params.Content = append(params.Content, c.parameterOrRef(p, pPath))
+		}
+	}
+	for i, p := range shared {
+		if overridden[p.Value.In.Value+":"+p.Value.Name.Value] {
+			continue
+		}
+		if p.Value.In.Value == "body" {
+			if body == nil {
+				body = &shared[i]
+				bodyPath = path
+			}
+			continue
+		}
+		form = append(form, p)
+	}
+
+	if len(params.Content) > 0 {
+		addKey(m, "parameters", params)
+	}
+	if body != nil {
+		if body.IsReference() && c.bodyParameters[refName(body.GetReference(), "#/parameters/")] {
+			addKey(m, "requestBody", utils.CreateRefNode(c.rewriteRef(body.GetReference(), body.GetReferenceNode(), bodyPath)))
+		} else {
+			addKey(m, "requestBody", c.requestBody(body.Value, body.ValueNode, consumes, bodyPath))
+		}
+		if len(form) > 0 {
+			c.report.add(body.ValueNode, bodyPath, "operation defines both body and formData parameters, "+
+				"formData parameters have been dropped")
+		}
+	} else if len(form) > 0 {
+		addKey(m, "requestBody", c.formRequestBody(form, consumes, path))
+	}
+
+	if !op.Responses.IsEmpty() {
+		addKey(m, "responses", c.responses(op.Responses.Value, produces, pathKey(path, "responses")))
+	}
+	if !op.Deprecated.IsEmpty() {
+		addKey(m, "deprecated", cloneNode(op.Deprecated.ValueNode))
+	}
+	if !op.Security.IsEmpty() {
+		addKey(m, "security", cloneNode(op.Security.ValueNode))
+	}
+	if !op.Schemes.IsEmpty() {
+		schemes := stringSlice(op.Schemes)
+		if !slices.Equal(schemes, c.schemes) {
+			addKey(m, "servers", c.servers(schemes, op.Schemes.ValueNode, pathKey(path, "schemes")))
+		}
+	}
+	addExtensions(m, op.Extensions)
+	return m
+}
+
+// parameterOrRef converts a non-body parameter, references to parameter definitions are kept as references.
+func (c *swaggerConverter) parameterOrRef(p low.ValueReference[*v2.Parameter], path string) *yaml.Node {
+	if p.IsReference() {
+		name := refName(p.GetReference(), "#/parameters/")
+		if name != "" && !c.bodyParameters[name] && !c.formParameters[name] {
+			return utils.CreateRefNode(c.rewriteRef(p.GetReference(), p.GetReferenceNode(), path))
+		}
+		if name == "" {
+			c.report.add(p.GetReferenceNode(), path,
+				fmt.Sprintf("external reference '%s' has been inlined", p.GetReference()))
+		}
+	}
+	return c.parameter(p.Value, p.ValueNode, path)
+}
+
+func (c *swaggerConverter) parameter(p *v2.Parameter, node *yaml.Node, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	addKey(m, "name", cloneNode(p.Name.ValueNode))
+	addKey(m, "in", cloneNode(p.In.ValueNode))
+	if !p.Description.IsEmpty() {
+		addKey(m, "description", cloneNode(p.Description.ValueNode))
+	}
+	if p.In.Value == "path" {
+		addKey(m, "required", utils.CreateBoolNode("true"))
+	} else if !p.Required.IsEmpty() {
+		addKey(m, "required", cloneNode(p.Required.ValueNode))
+	}
+	if !p.AllowEmptyValue.IsEmpty() {
+		if p.In.Value == "query" {
+			addKey(m, "allowEmptyValue", cloneNode(p.AllowEmptyValue.ValueNode))
+		} else {
+			c.report.add(p.AllowEmptyValue.KeyNode, path,
+				"allowEmptyValue is only supported for query parameters and has been dropped")
+		}
+	}
+	if p.Type.Value == "array" {
+		c.collectionFormat(m, p.In.Value, p.CollectionFormat.Value, p.CollectionFormat.ValueNode, path)
+	}
+	addKey(m, "schema", c.simpleSchema(node, path))
+	addExtensions(m, p.Extensions)
+	return m
+}
+
+// collectionFormat maps a Swagger collectionFormat onto an OpenAPI 3 style and explode.
+func (c *swaggerConverter) collectionFormat(m *yaml.Node, in, format string, node *yaml.Node, path string) {
+	var style string
+	explode := "false"
+	switch format {
+	case "", "csv":
+		if in != "query" {
+			return
+		}
+		style = "form"
+	case "multi":
+		if in != "query" {
+			c.report.add(node, path, fmt.Sprintf("collectionFormat 'multi' is not supported for %s parameters", in))
+			return
+		}
+		style = "form"
+		explode = "true"
+	case "ssv", "pipes":
+		if in != "query" {
+			c.report.add(node, path, fmt.Sprintf("collectionFormat '%s' is not supported for %s parameters", format, in))
+			return
+		}
+		style = "spaceDelimited"
+		if format == "pipes" {
+			style = "pipeDelimited"
+		}
+	default:
+		c.report.add(node, path, fmt.Sprintf("collectionFormat '%s' cannot be represented in OpenAPI 3", format))
+		return
+	}
+	addKey(m, "style", utils.CreateStringNode(style))
+	addKey(m, "explode", utils.CreateBoolNode(explode))
+}
+
+// simpleSchema builds a schema out of the type properties of a Swagger parameter, header or items object.
+func (c *swaggerConverter) simpleSchema(node *yaml.Node, path string) *yaml.Node {
+	schema := utils.CreateEmptyMapNode()
+	if node == nil {
+		return schema
+	}
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		key, value := node.Content[i].Value, node.Content[i+1]
+		if !slices.Contains(simpleSchemaKeys, key) {
+			continue
+		}
+		if key == "items" {
+			if f := findKey(value, "collectionFormat"); f != nil && f.Value != "csv" {
+				c.report.add(f, pathKey(path, "items"),
+					fmt.Sprintf("collectionFormat '%s' of nested items cannot be represented in OpenAPI 3", f.Value))
+			}
+			addKey(schema, key, c.simpleSchema(value, pathKey(path, "items")))
+			continue
+		}
+		addKey(schema, key, cloneNode(value))
+	}
+	c.convertSchema(schema, path)
+	return schema
+}
+
+func (c *swaggerConverter) requestBody(p *v2.Parameter, node *yaml.Node, consumes []string, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	if !p.Description.IsEmpty() {
+		addKey(m, "description", cloneNode(p.Description.ValueNode))
+	}
+	if len(consumes) == 0 {
+		consumes = []string{defaultMediaType}
+	}
+	content := utils.CreateEmptyMapNode()
+	for _, mt := range consumes {
+		mediaType := utils.CreateEmptyMapNode()
+		if !p.Schema.IsEmpty() {
+			addKey(mediaType, "schema", c.schema(p.Schema.Value, pathKey(path, "schema")))
+		}
+		addKey(content, mt, mediaType)
+	}
+	addKey(m, "content", content)
+	if !p.Required.IsEmpty() {
+		addKey(m, "required", cloneNode(p.Required.ValueNode))
+	}
+	addExtensions(m, p.Extensions)
+	return m
+}
+
+// formRequestBody merges all formData parameters into a single object schema.
+func (c *swaggerConverter) formRequestBody(form []low.ValueReference[*v2.Parameter], consumes []string, path string) *yaml.Node {
+	var mediaTypes []string
+	hasFile := false
+	for _, mt := range consumes {
+		if mt == formURLEncoded || mt == multipartForm {
+			mediaTypes = append(mediaTypes, mt)
+		}
+	}
+	schema := utils.CreateEmptyMapNode()
+	addKey(schema, "type", utils.CreateStringNode("object"))
+	props := utils.CreateEmptyMapNode()
+	var required []string
+	for i, p := range form {
+		pPath := fmt.Sprintf("%s.parameters[%d]", path, i)
+		if name := refName(p.GetReference(), "#/parameters/"); name != "" {
+			pPath = pathKey("$.parameters", name)
+		}
+		if p.Value.Type.Value == "file" {
+			hasFile = true
+		}
+		prop := c.simpleSchema(p.ValueNode, pPath)
+		if !p.Value.Description.IsEmpty() {
+			addKey(prop, "description", cloneNode(p.Value.Description.ValueNode))
+		}
+		if p.Value.Type.Value == "array" {
+			if f := p.Value.CollectionFormat.Value; f != "" && f != "csv" && f != "multi" {
+				c.report.add(p.Value.CollectionFormat.ValueNode, pPath,
+					fmt.Sprintf("collectionFormat '%s' cannot be represented in a form request body", f))
+			}
+		}
+		addKey(props, p.Value.Name.Value, prop)
+		if p.Value.Required.Value {
+			required = append(required, p.Value.Name.Value)
+		}
+	}
+	addKey(schema, "properties", props)
+	if len(required) > 0 {
+		addKey(schema, "required", stringSequence(required))
+	}
+	if len(mediaTypes) == 0 {
+		if hasFile {
+			mediaTypes = []string{multipartForm}
+		} else {
+			mediaTypes = []string{formURLEncoded}
+		}
+	}
+
+	m := utils.CreateEmptyMapNode()
+	content := utils.CreateEmptyMapNode()
+	for i, mt := range mediaTypes {
+		mediaType := utils.CreateEmptyMapNode()
+		if i == 0 {
+			addKey(mediaType, "schema", schema)
+		} else {
+			addKey(mediaType, "schema", cloneNode(schema))
+		}
+		addKey(content, mt, mediaType)
+	}
+	addKey(m, "content", content)
+	if len(required) > 0 {
+		addKey(m, "required", utils.CreateBoolNode("true"))
+	}
+	return m
+}
+
+func (c *swaggerConverter) responses(r *v2.Responses, produces []string, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	for k, v := range fromSource(r.Codes) {
+		addKey(m, k.Value, c.responseOrRef(v.Reference, v.Value, produces, pathKey(path, k.Value)))
+	}
+	if !r.Default.IsEmpty() {
+		addKey(m, "default", c.responseOrRef(r.Default.Reference, r.Default.Value, produces, pathKey(path, "default")))
+	}
+	addExtensions(m, r.Extensions)
+	return m
+}
+
+func (c *swaggerConverter) responseOrRef(ref low.Reference, r *v2.Response, produces []string, path string) *yaml.Node {
+	if ref.IsReference() {
+		if refName(ref.GetReference(), "#/responses/") != "" {
+			return utils.CreateRefNode(c.rewriteRef(ref.GetReference(), ref.GetReferenceNode(), path))
+		}
+		c.report.add(ref.GetReferenceNode(), path,
+			fmt.Sprintf("external reference '%s' has been inlined", ref.GetReference()))
+	}
+	return c.response(r, produces, path)
+}
+
+func (c *swaggerConverter) response(r *v2.Response, produces []string, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	if r.Description.IsEmpty() {
+		addKey(m, "description", utils.CreateStringNode(""))
+	} else {
+		addKey(m, "description", cloneNode(r.Description.ValueNode))
+	}
+	if !r.Headers.IsEmpty() {
+		headers := utils.CreateEmptyMapNode()
+		for k, v := range fromSource(r.Headers.Value) {
+			addKey(headers, k.Value, c.header(v.Value, v.ValueNode, pathKey(pathKey(path, "headers"), k.Value)))
+		}
+		addKey(m, "headers", headers)
+	}
+
+	if len(produces) == 0 {
+		produces = []string{defaultMediaType}
+	}
+	var mediaTypes []string
+	if !r.Schema.IsEmpty() {
+		mediaTypes = append(mediaTypes, produces...)
+	}
+	if !r.Examples.IsEmpty() {
+		for k := range r.Examples.Value.Values.KeysFromOldest() {
+			if !slices.Contains(mediaTypes, k.Value) {
+				mediaTypes = append(mediaTypes, k.Value)
+			}
+		}
+	}
+	if len(mediaTypes) > 0 {
+		content := utils.CreateEmptyMapNode()
+		for _, mt := range mediaTypes {
+			mediaType := utils.CreateEmptyMapNode()
+			if !r.Schema.IsEmpty() {
+				addKey(mediaType, "schema", c.schema(r.Schema.Value, pathKey(path, "schema")))
+			}
+			if !r.Examples.IsEmpty() {
+				if ex := low.FindItemInOrderedMap(mt, r.Examples.Value.Values); ex != nil {
+					addKey(mediaType, "example", cloneNode(ex.Value))
+				}
+			}
+			addKey(content, mt, mediaType)
+		}
+		addKey(m, "content", content)
+	}
+	addExtensions(m, r.Extensions)
+	return m
+}
+
+func (c *swaggerConverter) header(h *v2.Header, node *yaml.Node, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	if !h.Description.IsEmpty() {
+		addKey(m, "description", cloneNode(h.Description.ValueNode))
+	}
+	if h.Type.Value == "array" {
+		if f := h.CollectionFormat.Value; f != "" && f != "csv" {
+			c.report.add(h.CollectionFormat.ValueNode, path,
+				fmt.Sprintf("collectionFormat '%s' is not supported for headers", f))
+		}
+	}
+	addKey(m, "schema", c.simpleSchema(node, path))
+	addExtensions(m, h.Extensions)
+	return m
+}
+
+func (c *swaggerConverter) components() *yaml.Node {
+	s := c.swagger
+	m := utils.CreateEmptyMapNode()
+
+	if !s.Definitions.IsEmpty() && s.Definitions.Value.Schemas.Len() > 0 {
+		schemas := utils.CreateEmptyMapNode()
+		for k, v := range fromSource(s.Definitions.Value.Schemas) {
+			addKey(schemas, k.Value, c.schema(v.Value, pathKey("$.definitions", k.Value)))
+		}
+		addKey(m, "schemas", schemas)
+	}
+
+	if !s.Responses.IsEmpty() && s.Responses.Value.Definitions.Len() > 0 {
+		responses := utils.CreateEmptyMapNode()
+		for k, v := range fromSource(s.Responses.Value.Definitions) {
+			addKey(responses, k.Value, c.response(v.Value, c.produces, pathKey("$.responses", k.Value)))
+		}
+		addKey(m, "responses", responses)
+	}
+
+	if !s.Parameters.IsEmpty() {
+		params := utils.CreateEmptyMapNode()
+		bodies := utils.CreateEmptyMapNode()
+		for k, v := range fromSource(s.Parameters.Value.Definitions) {
+			path := pathKey("$.parameters", k.Value)
+			switch v.Value.In.Value {
+			case "body":
+				addKey(bodies, k.Value, c.requestBody(v.Value, v.ValueNode, c.consumes, path))
+			case "formData":
+				c.report.add(v.ValueNode, path, "formData parameters cannot be defined as components, "+
+					"the parameter has been inlined into every operation that references it")
+			default:
+				addKey(params, k.Value, c.parameter(v.Value, v.ValueNode, path))
+			}
+		}
+		if len(params.Content) > 0 {
+			addKey(m, "parameters", params)
+		}
+		if len(bodies.Content) > 0 {
+			addKey(m, "requestBodies", bodies)
+		}
+	}
+
+	if !s.SecurityDefinitions.IsEmpty() && s.SecurityDefinitions.Value.Definitions.Len() > 0 {
+		schemes := utils.CreateEmptyMapNode()
+		for k, v := range fromSource(s.SecurityDefinitions.Value.Definitions) {
+			addKey(schemes, k.Value, c.securityScheme(v.Value, pathKey("$.securityDefinitions", k.Value)))
+		}
+		addKey(m, "securitySchemes", schemes)
+	}
+
+	if len(m.Content) == 0 {
+		return nil
+	}
+	return m
+}
+
+func (c *swaggerConverter) securityScheme(ss *v2.SecurityScheme, path string) *yaml.Node {
+	m := utils.CreateEmptyMapNode()
+	switch ss.Type.Value {
+	case "basic":
+		addKey(m, "type", utils.CreateStringNode("http"))
+		addKey(m, "scheme", utils.CreateStringNode("basic"))
+	case "apiKey":
+		addKey(m, "type", utils.CreateStringNode("apiKey"))
+		addKey(m, "name", cloneNode(ss.Name.ValueNode))
+		addKey(m, "in", cloneNode(ss.In.ValueNode))
+	case "oauth2":
+		addKey(m, "type", utils.CreateStringNode("oauth2"))
+		flow := utils.CreateEmptyMapNode()
+		if !ss.AuthorizationUrl.IsEmpty() {
+			addKey(flow, "authorizationUrl", cloneNode(ss.AuthorizationUrl.ValueNode))
+		}
+		if !ss.TokenUrl.IsEmpty() {
+			addKey(flow, "tokenUrl", cloneNode(ss.TokenUrl.ValueNode))
+		}
+		scopes := utils.CreateEmptyMapNode()
+		if !ss.Scopes.IsEmpty() {
+			for k, v := range fromSource(ss.Scopes.Value.Values) {
+				addKey(scopes, k.Value, utils.CreateStringNode(v.Value))
+			}
+		}
+		addKey(flow, "scopes", scopes)
+		name, ok := oauthFlows[ss.Flow.Value]
+		if !ok {
+			c.report.add(ss.Flow.ValueNode, path, fmt.Sprintf("unknown OAuth2 flow '%s' has been dropped", ss.Flow.Value))
+			break
+		}
+		flows := utils.CreateEmptyMapNode()
+		addKey(flows, name, flow)
+		addKey(m, "flows", flows)
+	default:
+		addKey(m, "type", cloneNode(ss.Type.ValueNode))
+		c.report.add(ss.Type.ValueNode, path, fmt.Sprintf("unknown security scheme type '%s'", ss.Type.Value))
+	}
+	if !ss.Description.IsEmpty() {
+		addKey(m, "description", cloneNode(ss.Description.ValueNode))
+	}
+	addExtensions(m, ss.Extensions)
+	return m
+}
+
+// schema copies a Swagger schema, and converts it into an OpenAPI 3 schema. References are kept as references.
+func (c *swaggerConverter) schema(sp *base.SchemaProxy, path string) *yaml.Node {
+	node := sp.GetValueNode()
+	if sp.IsReference() {
+		node = sp.GetReferenceNode()
+	}
+	schema := cloneNode(node)
+	c.convertSchema(schema, path)
+	return schema
+}
+
+// convertSchema walks a copied schema, rewriting references and Swagger specific keywords.
+func (c *swaggerConverter) convertSchema(schema *yaml.Node, path string) {
+	if schema == nil || schema.Kind != yaml.MappingNode {
+		return
+	}
+	file := false
+	for i := 0; i < len(schema.Content)-1; i += 2 {
+		key, value := schema.Content[i], schema.Content[i+1]
+		switch key.Value {
+		case "$ref":
+			value.Value = c.rewriteRef(value.Value, value, path)
+		case "type":
+			if value.Value == "file" {
+				value.Value = "string"
+				file = true
+			}
+		case "discriminator":
+			if value.Kind == yaml.ScalarNode {
+				d := utils.CreateEmptyMapNode()
+				addKey(d, "propertyName", utils.CreateStringNode(value.Value))
+				schema.Content[i+1] = d
+			}
+		case "x-nullable":
+			if findKey(schema, "nullable") == nil {
+				key.Value = "nullable"
+			}
+		case "properties":
+			for j := 0; j < len(value.Content)-1; j += 2 {
+				c.convertSchema(value.Content[j+1], pathKey(pathKey(path, "properties"), value.Content[j].Value))
+			}
+		case "additionalProperties", "items", "not":
+			if value.Kind == yaml.SequenceNode {
+				for j, item := range value.Content {
+					c.convertSchema(item, fmt.Sprintf("%s[%d]", pathKey(path, key.Value), j))
+				}
+			} else {
+				c.convertSchema(value, pathKey(path, key.Value))
+			}
+		case "allOf", "anyOf", "oneOf":
+			for j, item := range value.Content {
+				c.convertSchema(item, fmt.Sprintf("%s[%d]", pathKey(path, key.Value), j))
+			}
+		}
+	}
+	if file {
+		setKey(schema, "format", utils.CreateStringNode("binary"))
+	}
+}
+
+// rewriteRef maps a Swagger reference onto its new location in the OpenAPI 3 document.
+func (c *swaggerConverter) rewriteRef(ref string, node *yaml.Node, path string) string {
+	if name := refName(ref, "#/definitions/"); name != "" {
+		return "#/components/schemas/" + name
+	}
+	if name := refName(ref, "#/responses/"); name != "" {
+		return "#/components/responses/" + name
+	}
+	if name := refName(ref, "#/parameters/"); name != "" {
+		if c.bodyParameters[name] {
+			return "#/components/requestBodies/" + name
+		}
+		return "#/components/parameters/" + name
+	}
+	if !strings.HasPrefix(ref, "#") {
+		c.report.add(node, path, fmt.Sprintf("external reference '%s' has not been converted", ref))
+	} else if !strings.HasPrefix(ref, "#/paths/") {
+		c.report.add(node, path, fmt.Sprintf("reference '%s' points at a location that does not exist in OpenAPI 3", ref))
+	}
+	return ref
+}
+
+// refName returns the name of a local reference with the given prefix, or an empty string.
+func refName(ref, prefix string) string {
+	if strings.HasPrefix(ref, prefix) {
+		return ref[len(prefix):]
+	}
+	return ""
+}
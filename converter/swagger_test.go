@@ -0,0 +1,581 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func convertSpec(t *testing.T, spec string) (string, *Report) {
+	out, report, err := ConvertSwaggerBytes([]byte(spec), nil)
+	require.NoError(t, err)
+	return string(out), report
+}
+
+func issueMessages(report *Report) []string {
+	var messages []string
+	for _, i := range report.Issues {
+		messages = append(messages, i.Path+": "+i.Message)
+	}
+	return messages
+}
+
+func TestConvertSwagger_Servers(t *testing.T) {
+	out, report := convertSpec(t, `swagger: "2.0"
+basePath: /api
+schemes: [http]
+paths:
+  /burgers:
+    get:
+      schemes: [wss]
+      responses:
+        default:
+          description: OK`)
+
+	expected := `openapi: 3.0.3
+servers:
+    - url: /api
+paths:
+    /burgers:
+        get:
+            responses:
+                default:
+                    description: OK
+            servers:
+                - url: /api
+`
+	assert.Equal(t, expected, out)
+	assert.Equal(t, []string{
+		"$: schemes cannot be used without a host, the server URL is relative",
+		"$.paths['/burgers'].get.schemes: schemes cannot be used without a host, the server URL is relative",
+	}, issueMessages(report))
+}
+
+func TestConvertSwagger_Parameters(t *testing.T) {
+	out, report := convertSpec(t, `swagger: "2.0"
+paths:
+  /burgers/{id}:
+    parameters:
+      - name: id
+        in: path
+        type: string
+    get:
+      parameters:
+        - name: csv
+          in: query
+          type: array
+          items:
+            type: string
+        - name: multi
+          in: query
+          type: array
+          collectionFormat: multi
+          items:
+            type: integer
+            minimum: 1
+        - name: pipes
+          in: query
+          type: array
+          collectionFormat: pipes
+          items:
+            type: string
+        - name: X-Tabs
+          in: header
+          type: array
+          collectionFormat: tsv
+          allowEmptyValue: true
+          items:
+            type: array
+            collectionFormat: ssv
+            items:
+              type: string
+        - $ref: '#/parameters/limit'
+      responses:
+        200:
+          description: OK
+parameters:
+  limit:
+    name: limit
+    in: query
+    type: integer
+    x-limit: high`)
+
+	expected := `openapi: 3.0.3
+paths:
+    /burgers/{id}:
+        parameters:
+            - name: id
+              in: path
+              required: true
+              schema:
+                type: string
+        get:
+            parameters:
+                - name: csv
+                  in: query
+                  style: form
+                  explode: false
+                  schema:
+                    type: array
+                    items:
+                        type: string
+                - name: multi
+                  in: query
+                  style: form
+                  explode: true
+                  schema:
+                    type: array
+                    items:
+                        type: integer
+                        minimum: 1
+                - name: pipes
+                  in: query
+                  style: pipeDelimited
+                  explode: false
+                  schema:
+                    type: array
+                    items:
+                        type: string
+                - name: X-Tabs
+                  in: header
+                  schema:
+                    type: array
+                    items:
+                        type: array
+                        items:
+                            type: string
+                - $ref: '#/components/parameters/limit'
+            responses:
+                "200":
+                    description: OK
+components:
+    parameters:
+        limit:
+            name: limit
+            in: query
+            schema:
+                type: integer
+            x-limit: high
+`
+	assert.Equal(t, expected, out)
+	assert.Equal(t, []string{
+		"$.paths['/burgers/{id}'].get.parameters[3]: allowEmptyValue is only supported for query parameters and has been dropped",
+		"$.paths['/burgers/{id}'].get.parameters[3]: collectionFormat 'tsv' cannot be represented in OpenAPI 3",
+		"$.paths['/burgers/{id}'].get.parameters[3].items: collectionFormat 'ssv' of nested items cannot be represented in OpenAPI 3",
+	}, issueMessages(report))
+}
+
+func TestConvertSwagger_CollectionFormat_NotQuery(t *testing.T) {
+	_, report := convertSpec(t, `swagger: "2.0"
+paths:
+  /burgers:
+    get:
+      parameters:
+        - name: X-Multi
+          in: header
+          type: array
+          collectionFormat: multi
+          items:
+            type: string
+        - name: X-Spaces
+          in: header
+          type: array
+          collectionFormat: ssv
+          items:
+            type: string
+      responses:
+        200:
+          description: OK`)
+
+	assert.Equal(t, []string{
+		"$.paths['/burgers'].get.parameters[0]: collectionFormat 'multi' is not supported for header parameters",
+		"$.paths['/burgers'].get.parameters[1]: collectionFormat 'ssv' is not supported for header parameters",
+	}, issueMessages(report))
+}
+
+func TestConvertSwagger_RequestBodies(t *testing.T) {
+	out, report := convertSpec(t, `swagger: "2.0"
+consumes:
+  - application/json
+paths:
+  /burgers:
+    parameters:
+      - name: burger
+        in: body
+        schema:
+          $ref: '#/definitions/Burger'
+    post:
+      responses:
+        200:
+          description: OK
+    put:
+      parameters:
+        - $ref: '#/parameters/Burger'
+      responses:
+        200:
+          description: OK
+  /burgers/upload:
+    post:
+      consumes:
+        - application/x-www-form-urlencoded
+      parameters:
+        - name: name
+          in: formData
+          type: string
+          required: true
+          description: the name of the burger
+        - name: tags
+          in: formData
+          type: array
+          collectionFormat: tsv
+          items:
+            type: string
+        - $ref: '#/parameters/Photo'
+      responses:
+        200:
+          description: OK
+    put:
+      parameters:
+        - name: burger
+          in: body
+          schema:
+            type: object
+        - name: name
+          in: formData
+          type: string
+      responses:
+        200:
+          description: OK
+parameters:
+  Burger:
+    name: burger
+    in: body
+    required: true
+    description: a burger
+    schema:
+      $ref: '#/definitions/Burger'
+  Photo:
+    name: photo
+    in: formData
+    type: file
+definitions:
+  Burger:
+    type: object`)
+
+	expected := `openapi: 3.0.3
+paths:
+    /burgers:
+        post:
+            requestBody:
+                content:
+                    application/json:
+                        schema:
+                            $ref: '#/components/schemas/Burger'
+            responses:
+                "200":
+                    description: OK
+        put:
+            requestBody:
+                $ref: '#/components/requestBodies/Burger'
+            responses:
+                "200":
+                    description: OK
+    /burgers/upload:
+        post:
+            requestBody:
+                content:
+                    application/x-www-form-urlencoded:
+                        schema:
+                            type: object
+                            properties:
+                                name:
+                                    type: string
+                                    description: the name of the burger
+                                tags:
+                                    type: array
+                                    items:
+                                        type: string
+                                photo:
+                                    type: string
+                                    format: binary
+                            required:
+                                - name
+                required: true
+            responses:
+                "200":
+                    description: OK
+        put:
+            requestBody:
+                content:
+                    application/json:
+                        schema:
+                            type: object
+            responses:
+                "200":
+                    description: OK
+components:
+    schemas:
+        Burger:
+            type: object
+    requestBodies:
+        Burger:
+            description: a burger
+            content:
+                application/json:
+                    schema:
+                        $ref: '#/components/schemas/Burger'
+            required: true
+`
+	assert.Equal(t, expected, out)
+	assert.Equal(t, []string{
+		"$.paths['/burgers/upload'].post.parameters[1]: collectionFormat 'tsv' cannot be represented in a form request body",
+		"$.paths['/burgers/upload'].put.parameters[0]: operation defines both body and formData parameters, formData parameters have been dropped",
+		"$.parameters.Photo: formData parameters cannot be defined as components, the parameter has been inlined into every operation that references it",
+	}, issueMessages(report))
+}
+
+func TestConvertSwagger_Responses(t *testing.T) {
+	out, report := convertSpec(t, `swagger: "2.0"
+produces:
+  - application/json
+paths:
+  /burgers:
+    get:
+      responses:
+        200:
+          description: OK
+          headers:
+            X-Rate:
+              type: array
+              collectionFormat: pipes
+              description: rates
+              items:
+                type: integer
+          schema:
+            type: array
+            items:
+              $ref: '#/definitions/Burger'
+          examples:
+            application/json:
+              - name: big mac
+            text/plain: big mac
+        404:
+          $ref: '#/responses/NotFound'
+        default:
+          $ref: '#/responses/NotFound'
+        x-burger: tasty
+responses:
+  NotFound:
+    description: not found
+    schema:
+      type: file
+definitions:
+  Burger:
+    type: object`)
+
+	expected := `openapi: 3.0.3
+paths:
+    /burgers:
+        get:
+            responses:
+                "200":
+                    description: OK
+                    headers:
+                        X-Rate:
+                            description: rates
+                            schema:
+                                type: array
+                                items:
+                                    type: integer
+                    content:
+                        application/json:
+                            schema:
+                                type: array
+                                items:
+                                    $ref: '#/components/schemas/Burger'
+                            example:
+                                - name: big mac
+                        text/plain:
+                            schema:
+                                type: array
+                                items:
+                                    $ref: '#/components/schemas/Burger'
+                            example: big mac
+                "404":
+                    $ref: '#/components/responses/NotFound'
+                default:
+                    $ref: '#/components/responses/NotFound'
+                x-burger: tasty
+components:
+    schemas:
+        Burger:
+            type: object
+    responses:
+        NotFound:
+            description: not found
+            content:
+                application/json:
+                    schema:
+                        type: string
+                        format: binary
+`
+	assert.Equal(t, expected, out)
+	assert.Equal(t, []string{
+		"$.paths['/burgers'].get.responses.200.headers['X-Rate']: collectionFormat 'pipes' is not supported for headers",
+	}, issueMessages(report))
+}
+
+func TestConvertSwagger_Schemas(t *testing.T) {
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "owners.yaml"), []byte(`definitions:
+  Owner:
+    type: object`), 0o644)
+
+	config := datamodel.NewDocumentConfiguration()
+	config.BasePath = dir
+	config.AllowFileReferences = true
+
+	b, report, err := ConvertSwaggerBytes([]byte(`swagger: "2.0"
+definitions:
+  Pet:
+    type: object
+    discriminator: petType
+    properties:
+      petType:
+        type: string
+      name:
+        type: string
+        x-nullable: true
+      owner:
+        $ref: 'owners.yaml#/definitions/Owner'
+      photo:
+        type: file
+      tags:
+        type: object
+        additionalProperties:
+          $ref: '#/definitions/Tag'
+      other:
+        $ref: '#/nope/Other'
+  Cat:
+    allOf:
+      - $ref: '#/definitions/Pet'
+      - type: object
+  Tag:
+    type: string
+nope:
+  Other:
+    type: string`), &Config{DocumentConfiguration: config})
+	require.NoError(t, err)
+	out := string(b)
+
+	expected := `openapi: 3.0.3
+components:
+    schemas:
+        Pet:
+            type: object
+            discriminator:
+                propertyName: petType
+            properties:
+                petType:
+                    type: string
+                name:
+                    type: string
+                    nullable: true
+                owner:
+                    $ref: 'owners.yaml#/definitions/Owner'
+                photo:
+                    type: string
+                    format: binary
+                tags:
+                    type: object
+                    additionalProperties:
+                        $ref: '#/components/schemas/Tag'
+                other:
+                    $ref: '#/nope/Other'
+        Cat:
+            allOf:
+                - $ref: '#/components/schemas/Pet'
+                - type: object
+        Tag:
+            type: string
+`
+	assert.Equal(t, expected, out)
+	assert.Equal(t, []string{
+		"$.definitions.Pet.properties.owner: external reference 'owners.yaml#/definitions/Owner' has not been converted",
+		"$.definitions.Pet.properties.other: reference '#/nope/Other' points at a location that does not exist in OpenAPI 3",
+	}, issueMessages(report))
+}
+
+func TestConvertSwagger_SecuritySchemes(t *testing.T) {
+	out, report := convertSpec(t, `swagger: "2.0"
+securityDefinitions:
+  basicAuth:
+    type: basic
+    description: basic auth
+  apiKey:
+    type: apiKey
+    name: X-API-Key
+    in: header
+  app:
+    type: oauth2
+    flow: application
+    tokenUrl: https://pb33f.io/token
+    scopes:
+      read: read things
+  code:
+    type: oauth2
+    flow: accessCode
+    authorizationUrl: https://pb33f.io/auth
+    tokenUrl: https://pb33f.io/token
+    x-code: yes
+  broken:
+    type: oauth2
+    flow: magic
+  unknown:
+    type: magic`)
+
+	expected := `openapi: 3.0.3
+components:
+    securitySchemes:
+        basicAuth:
+            type: http
+            scheme: basic
+            description: basic auth
+        apiKey:
+            type: apiKey
+            name: X-API-Key
+            in: header
+        app:
+            type: oauth2
+            flows:
+                clientCredentials:
+                    tokenUrl: https://pb33f.io/token
+                    scopes:
+                        read: read things
+        code:
+            type: oauth2
+            flows:
+                authorizationCode:
+                    authorizationUrl: https://pb33f.io/auth
+                    tokenUrl: https://pb33f.io/token
+                    scopes: {}
+            x-code: yes
+        broken:
+            type: oauth2
+        unknown:
+            type: magic
+`
+	assert.Equal(t, expected, out)
+	assert.Equal(t, []string{
+		"$.securityDefinitions.broken: unknown OAuth2 flow 'magic' has been dropped",
+		"$.securityDefinitions.unknown: unknown security scheme type 'magic'",
+	}, issueMessages(report))
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// octetStream is the media type used for binary content that has no other media type.
+const octetStream = "application/octet-stream"
+
+// upgrader is a documentVisitor that upgrades OpenAPI 3.0 semantics to OpenAPI 3.1.
+type upgrader struct {
+	report *Report
+}
+
+func (u *upgrader) document(*v3high.Document) {}
+
+func (u *upgrader) pathItem(*v3high.PathItem, string) {}
+
+// mediaType uses the name of the media type as the 'contentMediaType' of a binary schema. Multipart properties use
+// the content type of their encoding.
+func (u *upgrader) mediaType(name string, m *v3high.MediaType, _ string) {
+	s := inlineSchema(m.Schema)
+	if s == nil {
+		return
+	}
+	if !strings.HasPrefix(name, "multipart/") {
+		if isBinary(s) {
+			s.Format = ""
+			s.ContentMediaType = concreteMediaType(name)
+		}
+		return
+	}
+	for k, sp := range s.Properties.FromOldest() {
+		prop := inlineSchema(sp)
+		if prop == nil || !isBinary(prop) {
+			continue
+		}
+		contentType := octetStream
+		if m.Encoding != nil {
+			if e := m.Encoding.GetOrZero(k); e != nil && e.ContentType != "" && !strings.Contains(e.ContentType, ",") {
+				contentType = concreteMediaType(e.ContentType)
+			}
+		}
+		prop.Format = ""
+		prop.ContentMediaType = contentType
+	}
+}
+
+func (u *upgrader) schema(s *base.Schema, path string) {
+	u.nullable(s, path)
+	u.exclusive(&s.ExclusiveMinimum, &s.Minimum, s, path, "exclusiveMinimum", "minimum")
+	u.exclusive(&s.ExclusiveMaximum, &s.Maximum, s, path, "exclusiveMaximum", "maximum")
+	if s.Example != nil {
+		s.Examples = append([]*yaml.Node{s.Example}, s.Examples...)
+		s.Example = nil
+	}
+	if isBinary(s) {
+		s.Format = ""
+		s.ContentMediaType = octetStream
+	}
+	if s.Format == "byte" && isString(s) {
+		s.Format = ""
+		s.ContentEncoding = "base64"
+	}
+}
+
+// nullable adds 'null' to the type array, and to the enum if there is one, because an enum must list every value.
+// OpenAPI 3.0.3 clarified that nullable has no effect without a type, so it is removed.
+func (u *upgrader) nullable(s *base.Schema, path string) {
+	if s.Nullable == nil {
+		return
+	}
+	nullable := *s.Nullable
+	s.Nullable = nil
+	if !nullable {
+		return
+	}
+	if len(s.Type) == 0 {
+		u.report.add(sourceKey(s.GoLow(), "nullable"), pathKey(path, "nullable"),
+			"nullable has no effect without a type, it has been removed")
+		return
+	}
+	if !slices.Contains(s.Type, "null") {
+		s.Type = append(s.Type, "null")
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, isNull) {
+		s.Enum = append(s.Enum, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"})
+	}
+}
+
+// exclusive converts a boolean 'exclusiveMinimum' or 'exclusiveMaximum' into a number, which replaces the limit
+// it used to modify.
+func (u *upgrader) exclusive(exclusive **base.DynamicValue[bool, float64], limit **float64, s *base.Schema, path, keyword, limitKeyword string) {
+	e := *exclusive
+	if e == nil || !e.IsA() {
+		return
+	}
+	switch {
+	case !e.A:
+		*exclusive = nil
+	case *limit != nil:
+		*exclusive = &base.DynamicValue[bool, float64]{N: 1, B: **limit}
+		*limit = nil
+	default:
+		*exclusive = nil
+		u.report.add(sourceKey(s.GoLow(), keyword), pathKey(path, keyword),
+			fmt.Sprintf("%s has no effect without %s, it has been removed", keyword, limitKeyword))
+	}
+}
+
+// inlineSchema returns the schema of a proxy, unless it is a reference.
+func inlineSchema(sp *base.SchemaProxy) *base.Schema {
+	if sp == nil || sp.IsReference() {
+		return nil
+	}
+	return sp.Schema()
+}
+
+func isString(s *base.Schema) bool {
+	return len(s.Type) == 0 || slices.Contains(s.Type, "string")
+}
+
+func isBinary(s *base.Schema) bool {
+	return s.Format == "binary" && isString(s)
+}
+
+func isNull(n *yaml.Node) bool {
+	return n != nil && n.Tag == "!!null"
+}
+
+// concreteMediaType returns the media type without parameters, wildcard media types are replaced with
+// 'application/octet-stream'.
+func concreteMediaType(mediaType string) string {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	mediaType = strings.TrimSpace(mediaType)
+	if mediaType == "" || strings.Contains(mediaType, "*") {
+		return octetStream
+	}
+	return mediaType
+}
@@ -0,0 +1,302 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildV3Document(t *testing.T, spec string) *v3high.Document {
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+	model, errs := doc.BuildV3Model()
+	require.Empty(t, errs)
+	return &model.Model
+}
+
+func renderDocument(t *testing.T, doc *v3high.Document) string {
+	out, err := doc.Render()
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestUpgradeDocument_Schemas(t *testing.T) {
+	doc := buildV3Document(t, `openapi: 3.0.3
+components:
+  schemas:
+    Burger:
+      type: object
+      example:
+        name: Big Mac
+      properties:
+        name:
+          type: string
+          nullable: true
+        size:
+          type: string
+          enum: [small, large]
+          nullable: true
+        fries:
+          type: integer
+          minimum: 1
+          exclusiveMinimum: true
+          maximum: 10
+          exclusiveMaximum: false
+        sauce:
+          nullable: false
+          allOf:
+            - $ref: '#/components/schemas/Sauce'
+        photo:
+          type: string
+          format: byte
+    Sauce:
+      nullable: true
+      maximum: 5
+      exclusiveMaximum: true
+      exclusiveMinimum: true`)
+
+	report, err := UpgradeDocument(doc)
+	require.NoError(t, err)
+
+	expected := `openapi: 3.1.1
+components:
+    schemas:
+        Burger:
+            type: object
+            properties:
+                name:
+                    type:
+                        - string
+                        - "null"
+                size:
+                    type:
+                        - string
+                        - "null"
+                    enum:
+                        - small
+                        - large
+                        - null
+                fries:
+                    type: integer
+                    exclusiveMinimum: 1
+                    maximum: 10
+                sauce:
+                    allOf:
+                        - $ref: '#/components/schemas/Sauce'
+                photo:
+                    type: string
+                    contentEncoding: base64
+            examples:
+                - name: Big Mac
+        Sauce:
+            exclusiveMaximum: 5
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+	assert.Equal(t, []string{
+		"$.components.schemas.Sauce.nullable: nullable has no effect without a type, it has been removed",
+		"$.components.schemas.Sauce.exclusiveMinimum: exclusiveMinimum has no effect without minimum, it has been removed",
+	}, issueMessages(report))
+	assert.Equal(t, 30, report.Issues[0].Line)
+}
+
+func TestUpgradeDocument_BinaryContent(t *testing.T) {
+	doc := buildV3Document(t, `openapi: 3.0.3
+paths:
+  /burgers/{id}/photo:
+    put:
+      requestBody:
+        content:
+          image/png:
+            schema:
+              type: string
+              format: binary
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                photo:
+                  type: string
+                  format: binary
+                receipt:
+                  type: string
+                  format: binary
+            encoding:
+              photo:
+                contentType: image/jpeg
+      responses:
+        "200":
+          description: OK
+          content:
+            '*/*':
+              schema:
+                type: string
+                format: binary
+          headers:
+            X-Photo:
+              schema:
+                type: string
+                format: binary`)
+
+	report, err := UpgradeDocument(doc)
+	require.NoError(t, err)
+	assert.False(t, report.IsLossy())
+
+	expected := `openapi: 3.1.1
+paths:
+    /burgers/{id}/photo:
+        put:
+            requestBody:
+                content:
+                    image/png:
+                        schema:
+                            type: string
+                            contentMediaType: image/png
+                    multipart/form-data:
+                        schema:
+                            type: object
+                            properties:
+                                photo:
+                                    type: string
+                                    contentMediaType: image/jpeg
+                                receipt:
+                                    type: string
+                                    contentMediaType: application/octet-stream
+                        encoding:
+                            photo:
+                                contentType: image/jpeg
+            responses:
+                "200":
+                    description: OK
+                    content:
+                        '*/*':
+                            schema:
+                                type: string
+                                contentMediaType: application/octet-stream
+                    headers:
+                        X-Photo:
+                            schema:
+                                type: string
+                                contentMediaType: application/octet-stream
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+}
+
+func TestUpgradeDocument_Everywhere(t *testing.T) {
+	doc := buildV3Document(t, `openapi: 3.0.3
+paths:
+  /burgers:
+    parameters:
+      - name: size
+        in: query
+        schema:
+          type: string
+          nullable: true
+    post:
+      parameters:
+        - $ref: '#/components/parameters/Limit'
+      callbacks:
+        cooked:
+          '{$request.body#/url}':
+            post:
+              requestBody:
+                content:
+                  application/json:
+                    schema:
+                      type: object
+                      nullable: true
+              responses:
+                default:
+                  description: OK
+                  content:
+                    application/json:
+                      schema:
+                        type: array
+                        items:
+                          type: string
+                          nullable: true
+components:
+  parameters:
+    Limit:
+      name: limit
+      in: query
+      content:
+        application/json:
+          schema:
+            type: integer
+            nullable: true`)
+
+	_, err := UpgradeDocument(doc)
+	require.NoError(t, err)
+
+	expected := `openapi: 3.1.1
+paths:
+    /burgers:
+        parameters:
+            - name: size
+              in: query
+              schema:
+                type:
+                    - string
+                    - "null"
+        post:
+            parameters:
+                - $ref: '#/components/parameters/Limit'
+            callbacks:
+                cooked:
+                    '{$request.body#/url}':
+                        post:
+                            requestBody:
+                                content:
+                                    application/json:
+                                        schema:
+                                            type:
+                                                - object
+                                                - "null"
+                            responses:
+                                default:
+                                    description: OK
+                                    content:
+                                        application/json:
+                                            schema:
+                                                type: array
+                                                items:
+                                                    type:
+                                                        - string
+                                                        - "null"
+components:
+    parameters:
+        Limit:
+            name: limit
+            in: query
+            content:
+                application/json:
+                    schema:
+                        type:
+                            - integer
+                            - "null"
+`
+	assert.Equal(t, expected, renderDocument(t, doc))
+}
+
+func TestUpgradeDocument_Version(t *testing.T) {
+	_, err := UpgradeDocument(nil)
+	assert.ErrorIs(t, err, ErrInvalidModel)
+
+	doc := buildV3Document(t, `openapi: 3.1.0`)
+	_, err = UpgradeDocument(doc)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	assert.Equal(t, "unsupported version: cannot upgrade version '3.1.0', expected 3.0", err.Error())
+}
+
+func TestConcreteMediaType(t *testing.T) {
+	assert.Equal(t, "image/png", concreteMediaType("image/png; q=0.5"))
+	assert.Equal(t, octetStream, concreteMediaType("image/*"))
+	assert.Equal(t, octetStream, concreteMediaType(""))
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package arazzo represents all Arazzo 1.0 high-level models. High-level models are easy to navigate
+// and simple to extract what ever is required from an Arazzo document.
+//
+// Steps in an Arazzo document call operations defined in OpenAPI documents, use ResolveOperations to locate
+// the operations called by every step, using the OpenAPI models of the source descriptions.
+//   - https://spec.openapis.org/arazzo/v1.0.1
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Arazzo represents a high-level Arazzo document.
+//   - https://spec.openapis.org/arazzo/v1.0.1#arazzo-specification-object
+type Arazzo struct {
+	// Arazzo is the version of the Arazzo Specification the document uses.
+	Arazzo string `json:"arazzo,omitempty" yaml:"arazzo,omitempty"`
+
+	// Info provides metadata about the workflows in the document.
+	Info *Info `json:"info,omitempty" yaml:"info,omitempty"`
+
+	// SourceDescriptions are the OpenAPI and Arazzo documents used by the workflows.
+	SourceDescriptions []*SourceDescription `json:"sourceDescriptions,omitempty" yaml:"sourceDescriptions,omitempty"`
+
+	// Workflows are the workflows defined by the document.
+	Workflows []*Workflow `json:"workflows,omitempty" yaml:"workflows,omitempty"`
+
+	// Components holds reusable inputs, parameters and actions.
+	Components *Components `json:"components,omitempty" yaml:"components,omitempty"`
+
+	// Extensions contains all custom extensions defined for the top-level document.
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+
+	// Index is a reference to the *index.SpecIndex that was created for the document.
+	//
+	// This property is not a part of the Arazzo schema, this is custom to libopenapi.
+	Index *index.SpecIndex `json:"-" yaml:"-"`
+
+	low *low.Arazzo
+}
+
+// NewArazzo will create a new high-level Arazzo document from a low-level one.
+func NewArazzo(arazzo *low.Arazzo) *Arazzo {
+	a := new(Arazzo)
+	a.low = arazzo
+	a.Index = arazzo.Index
+	a.Arazzo = arazzo.Arazzo.Value
+	if !arazzo.Info.IsEmpty() {
+		a.Info = NewInfo(arazzo.Info.Value)
+	}
+	a.SourceDescriptions = buildSlice(arazzo.SourceDescriptions.Value, NewSourceDescription)
+	a.Workflows = buildSlice(arazzo.Workflows.Value, NewWorkflow)
+	if !arazzo.Components.IsEmpty() {
+		a.Components = NewComponents(arazzo.Components.Value)
+	}
+	a.Extensions = high.ExtractExtensions(arazzo.Extensions)
+	return a
+}
+
+// FindSourceDescription attempts to locate a SourceDescription using the supplied name.
+func (a *Arazzo) FindSourceDescription(name string) *SourceDescription {
+	for _, s := range a.SourceDescriptions {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// FindWorkflow attempts to locate a Workflow using the supplied workflowId.
+func (a *Arazzo) FindWorkflow(workflowId string) *Workflow {
+	for _, w := range a.Workflows {
+		if w.WorkflowId == workflowId {
+			return w
+		}
+	}
+	return nil
+}
+
+// GoLow returns the low-level Arazzo document that was used to create the high-level one.
+func (a *Arazzo) GoLow() *low.Arazzo {
+	return a.low
+}
+
+// GoLowUntyped will return the low-level Arazzo document that was used to create the high-level one, with no type
+func (a *Arazzo) GoLowUntyped() any {
+	return a.low
+}
+
+// Render will return a YAML representation of the Arazzo document as a byte slice.
+func (a *Arazzo) Render() ([]byte, error) {
+	return yaml.Marshal(a)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Arazzo document.
+func (a *Arazzo) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(a, a.low)
+	return nb.Render(), nil
+}
+
+// buildSlice creates a slice of high-level objects from a slice of low-level values, using the supplied constructor.
+func buildSlice[L any, H any](values []lowmodel.ValueReference[L], build func(L) H) []H {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]H, 0, len(values))
+	for _, v := range values {
+		out = append(out, build(v.Value))
+	}
+	return out
+}
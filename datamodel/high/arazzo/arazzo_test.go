@@ -0,0 +1,152 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildArazzo(t *testing.T, data []byte) *Arazzo {
+	info, err := datamodel.ExtractSpecInfo(data)
+	require.NoError(t, err)
+	lowDoc, err := low.CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	require.NoError(t, err)
+	return NewArazzo(lowDoc)
+}
+
+func loadPetstoreArazzo(t *testing.T) *Arazzo {
+	data, err := os.ReadFile("../../../test_specs/petstore.arazzo.yaml")
+	require.NoError(t, err)
+	return buildArazzo(t, data)
+}
+
+func TestNewArazzo(t *testing.T) {
+	doc := loadPetstoreArazzo(t)
+
+	assert.Equal(t, "1.0.1", doc.Arazzo)
+	assert.NotNil(t, doc.Index)
+	assert.Equal(t, "1.0.1", doc.GoLow().Arazzo.Value)
+	assert.Equal(t, doc.GoLow(), doc.GoLowUntyped())
+	assert.Equal(t, "Petstore workflows", doc.Info.Title)
+	assert.Equal(t, "Workflows for adopting pets", doc.Info.Summary)
+	assert.Equal(t, "Log in, find a pet and place an order.", doc.Info.Description)
+	assert.Equal(t, "1.0.0", doc.Info.Version)
+
+	source := doc.FindSourceDescription("petstore")
+	assert.True(t, source.IsOpenAPI())
+	assert.Equal(t, "petstorev3.json", source.URL)
+	assert.Nil(t, doc.FindSourceDescription("nope"))
+
+	workflow := doc.FindWorkflow("adoptPet")
+	assert.Nil(t, doc.FindWorkflow("nope"))
+	assert.Equal(t, "object", workflow.Inputs.Schema().Type[0])
+	assert.Len(t, workflow.Steps, 3)
+	assert.Equal(t, "$steps.placeOrder.outputs.orderId", workflow.Outputs.GetOrZero("orderId"))
+	assert.Equal(t, "adoption", workflow.Extensions.GetOrZero("x-team").Value)
+
+	login := workflow.FindStep("login")
+	assert.Equal(t, "loginUser", login.OperationId)
+	assert.Equal(t, "username", login.Parameters[0].Name)
+	assert.Equal(t, "$inputs.username", login.Parameters[0].Value.Value)
+	assert.Equal(t, CriterionTypeSimple, login.SuccessCriteria[0].GetType())
+	assert.Nil(t, login.Operation())
+	assert.Nil(t, workflow.FindStep("nope"))
+
+	findPets := workflow.FindStep("findPets")
+	assert.True(t, findPets.Parameters[0].IsReusable())
+	assert.Equal(t, CriterionTypeJSONPath, findPets.SuccessCriteria[0].GetType())
+	assert.True(t, findPets.OnFailure[0].IsReusable())
+
+	order := workflow.FindStep("placeOrder")
+	assert.Equal(t, "application/json", order.RequestBody.ContentType)
+	assert.Equal(t, "/petId", order.RequestBody.Replacements[0].Target)
+	assert.Equal(t, CriterionTypeRegex, order.SuccessCriteria[1].GetType())
+	assert.Equal(t, "draft-2020-12", order.SuccessCriteria[1].ExpressionType.Version)
+	assert.Equal(t, ActionTypeEnd, order.OnSuccess[0].Type)
+	assert.False(t, order.OnSuccess[0].IsReusable())
+
+	components := doc.Components
+	assert.NotNil(t, components.Inputs.GetOrZero("credentials"))
+	assert.Equal(t, "available", components.Parameters.GetOrZero("status").Value.Value)
+	assert.Equal(t, ActionTypeEnd, components.SuccessActions.GetOrZero("finish").Type)
+	retry := components.FailureActions.GetOrZero("retryLater")
+	assert.Equal(t, ActionTypeRetry, retry.Type)
+	assert.Equal(t, 1.5, *retry.RetryAfter)
+	assert.Equal(t, int64(3), *retry.RetryLimit)
+	assert.False(t, retry.IsReusable())
+}
+
+func TestArazzo_Render(t *testing.T) {
+	data, err := os.ReadFile("../../../test_specs/petstore.arazzo.yaml")
+	require.NoError(t, err)
+	doc := buildArazzo(t, data)
+
+	rendered, err := doc.Render()
+	assert.NoError(t, err)
+
+	// the document must survive a round trip, reusable objects must not be rendered as JSON references.
+	assert.NotContains(t, string(rendered), "$ref")
+	again := buildArazzo(t, rendered)
+	assert.Equal(t, doc.GoLow().Hash(), again.GoLow().Hash())
+}
+
+func TestArazzo_Render_Objects(t *testing.T) {
+	doc := loadPetstoreArazzo(t)
+	workflow := doc.Workflows[0]
+	step := workflow.Steps[2]
+
+	rendered, _ := doc.Info.Render()
+	assert.True(t, strings.HasPrefix(string(rendered), "title: Petstore workflows"))
+	rendered, _ = doc.SourceDescriptions[0].Render()
+	assert.Equal(t, "name: petstore\nurl: petstorev3.json\ntype: openapi\n", string(rendered))
+	rendered, _ = step.SuccessCriteria[1].Render()
+	assert.Equal(t, "context: $response.header.Content-Type\ncondition: ^application/json\n"+
+		"type:\n    type: regex\n    version: draft-2020-12\n", string(rendered))
+	rendered, _ = step.RequestBody.Replacements[0].Render()
+	assert.Equal(t, "target: /petId\nvalue: $steps.findPets.outputs.petId\n", string(rendered))
+	rendered, _ = workflow.Steps[1].Parameters[0].Render()
+	assert.Equal(t, "reference: $components.parameters.status\n", string(rendered))
+	rendered, _ = doc.Components.FailureActions.GetOrZero("retryLater").Render()
+	assert.Equal(t, "name: retryLater\ntype: retry\nretryAfter: 1.5\nretryLimit: 3\n", string(rendered))
+
+	for _, r := range []interface{ Render() ([]byte, error) }{
+		workflow, step, step.RequestBody, step.OnSuccess[0], doc.Components,
+		step.SuccessCriteria[1].ExpressionType,
+	} {
+		out, err := r.Render()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, out)
+	}
+}
+
+func TestArazzo_GoLow(t *testing.T) {
+	doc := loadPetstoreArazzo(t)
+	workflow := doc.Workflows[0]
+	step := workflow.Steps[2]
+	retry := doc.Components.FailureActions.GetOrZero("retryLater")
+	finish := doc.Components.SuccessActions.GetOrZero("finish")
+	param := doc.Components.Parameters.GetOrZero("status")
+	criterion := step.SuccessCriteria[1]
+	replacement := step.RequestBody.Replacements[0]
+
+	assert.Equal(t, doc.Info.GoLow(), doc.Info.GoLowUntyped())
+	assert.Equal(t, doc.SourceDescriptions[0].GoLow(), doc.SourceDescriptions[0].GoLowUntyped())
+	assert.Equal(t, workflow.GoLow(), workflow.GoLowUntyped())
+	assert.Equal(t, step.GoLow(), step.GoLowUntyped())
+	assert.Equal(t, step.RequestBody.GoLow(), step.RequestBody.GoLowUntyped())
+	assert.Equal(t, replacement.GoLow(), replacement.GoLowUntyped())
+	assert.Equal(t, criterion.GoLow(), criterion.GoLowUntyped())
+	assert.Equal(t, criterion.ExpressionType.GoLow(), criterion.ExpressionType.GoLowUntyped())
+	assert.Equal(t, retry.GoLow(), retry.GoLowUntyped())
+	assert.Equal(t, finish.GoLow(), finish.GoLowUntyped())
+	assert.Equal(t, param.GoLow(), param.GoLowUntyped())
+	assert.Equal(t, doc.Components.GoLow(), doc.Components.GoLowUntyped())
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Criterion types defined by the Arazzo specification, simple is the default.
+const (
+	CriterionTypeSimple   = "simple"
+	CriterionTypeRegex    = "regex"
+	CriterionTypeJSONPath = "jsonpath"
+	CriterionTypeXPath    = "xpath"
+)
+
+// Criterion represents a high-level Arazzo Criterion object, a condition used to determine the success of a step,
+// or whether an action should be taken.
+//
+// The type of a criterion is either a string (Type), or a Criterion Expression Type object (ExpressionType),
+// only one of them should be set.
+//   - https://spec.openapis.org/arazzo/v1.0.1#criterion-object
+type Criterion struct {
+	Context        string                              `json:"context,omitempty" yaml:"context,omitempty"`
+	Condition      string                              `json:"condition,omitempty" yaml:"condition,omitempty"`
+	Type           string                              `json:"type,omitempty" yaml:"type,omitempty"`
+	ExpressionType *CriterionExpressionType            `json:"-" yaml:"type,omitempty"`
+	Extensions     *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low            *low.Criterion
+}
+
+// NewCriterion will create a new high-level Criterion instance from a low-level one.
+func NewCriterion(criterion *low.Criterion) *Criterion {
+	c := new(Criterion)
+	c.low = criterion
+	c.Context = criterion.Context.Value
+	c.Condition = criterion.Condition.Value
+	c.Type = criterion.Type.Value
+	if !criterion.ExpressionType.IsEmpty() {
+		c.ExpressionType = NewCriterionExpressionType(criterion.ExpressionType.Value)
+	}
+	c.Extensions = high.ExtractExtensions(criterion.Extensions)
+	return c
+}
+
+// GetType returns the type of the criterion, when the type is a Criterion Expression Type object, the type
+// of the expression is returned. If no type is set, CriterionTypeSimple is returned.
+func (c *Criterion) GetType() string {
+	if c.ExpressionType != nil && c.ExpressionType.Type != "" {
+		return c.ExpressionType.Type
+	}
+	if c.Type != "" {
+		return c.Type
+	}
+	return CriterionTypeSimple
+}
+
+// GoLow returns the low-level Criterion instance that was used to create the high-level one.
+func (c *Criterion) GoLow() *low.Criterion {
+	return c.low
+}
+
+// GoLowUntyped will return the low-level Criterion instance that was used to create the high-level one, with no type
+func (c *Criterion) GoLowUntyped() any {
+	return c.low
+}
+
+// Render will return a YAML representation of the Criterion object as a byte slice.
+func (c *Criterion) Render() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Criterion object.
+func (c *Criterion) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(c, c.low)
+	return nb.Render(), nil
+}
+
+// CriterionExpressionType represents a high-level Arazzo Criterion Expression Type object, it describes the type
+// and version of an expression used by a criterion.
+//   - https://spec.openapis.org/arazzo/v1.0.1#criterion-expression-type-object
+type CriterionExpressionType struct {
+	Type       string                              `json:"type,omitempty" yaml:"type,omitempty"`
+	Version    string                              `json:"version,omitempty" yaml:"version,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low        *low.CriterionExpressionType
+}
+
+// NewCriterionExpressionType will create a new high-level CriterionExpressionType instance from a low-level one.
+func NewCriterionExpressionType(expressionType *low.CriterionExpressionType) *CriterionExpressionType {
+	c := new(CriterionExpressionType)
+	c.low = expressionType
+	c.Type = expressionType.Type.Value
+	c.Version = expressionType.Version.Value
+	c.Extensions = high.ExtractExtensions(expressionType.Extensions)
+	return c
+}
+
+// GoLow returns the low-level CriterionExpressionType instance that was used to create the high-level one.
+func (c *CriterionExpressionType) GoLow() *low.CriterionExpressionType {
+	return c.low
+}
+
+// GoLowUntyped will return the low-level CriterionExpressionType instance that was used to create the high-level one, with no type
+func (c *CriterionExpressionType) GoLowUntyped() any {
+	return c.low
+}
+
+// Render will return a YAML representation of the CriterionExpressionType object as a byte slice.
+func (c *CriterionExpressionType) Render() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the CriterionExpressionType object.
+func (c *CriterionExpressionType) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(c, c.low)
+	return nb.Render(), nil
+}
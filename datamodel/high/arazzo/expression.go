@@ -0,0 +1,147 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Runtime expression types defined by the Arazzo specification.
+//   - https://spec.openapis.org/arazzo/v1.0.1#runtime-expressions
+const (
+	ExpressionURL                = "$url"
+	ExpressionMethod             = "$method"
+	ExpressionStatusCode         = "$statusCode"
+	ExpressionRequest            = "$request"
+	ExpressionResponse           = "$response"
+	ExpressionInputs             = "$inputs"
+	ExpressionOutputs            = "$outputs"
+	ExpressionSteps              = "$steps"
+	ExpressionWorkflows          = "$workflows"
+	ExpressionSourceDescriptions = "$sourceDescriptions"
+	ExpressionComponents         = "$components"
+)
+
+// Sources of a request or response runtime expression.
+const (
+	ExpressionSourceHeader = "header"
+	ExpressionSourceQuery  = "query"
+	ExpressionSourcePath   = "path"
+	ExpressionSourceBody   = "body"
+)
+
+// RuntimeExpression is a parsed Arazzo runtime expression, for example '$response.body#/pets/0/id' or
+// '$steps.loginStep.outputs.token'.
+//   - https://spec.openapis.org/arazzo/v1.0.1#runtime-expressions
+type RuntimeExpression struct {
+	// Expression is the original expression.
+	Expression string
+
+	// Type is the type of the expression, for example ExpressionResponse or ExpressionSteps.
+	Type string
+
+	// Source is the part of a request or response the expression refers to, for example ExpressionSourceHeader.
+	// Source is only set for ExpressionRequest and ExpressionResponse expressions.
+	Source string
+
+	// Name is the first name following the type (or source) of the expression, for example the stepId of
+	// '$steps.loginStep.outputs.token', or the header name of '$response.header.X-Rate-Limit'.
+	Name string
+
+	// Property is the remainder of the expression following the name, for example 'outputs.token'
+	// of '$steps.loginStep.outputs.token'.
+	Property string
+
+	// JSONPointer is the JSON Pointer following a '#', for example '/pets/0/id' of '$response.body#/pets/0/id'.
+	JSONPointer string
+}
+
+// String returns the original expression.
+func (r *RuntimeExpression) String() string {
+	return r.Expression
+}
+
+// ParseRuntimeExpression parses an Arazzo runtime expression, an error is returned if the expression is not valid.
+func ParseRuntimeExpression(expression string) (*RuntimeExpression, error) {
+	r := &RuntimeExpression{Expression: expression}
+	exp := expression
+	if i := strings.IndexByte(exp, '#'); i >= 0 {
+		r.JSONPointer = exp[i+1:]
+		exp = exp[:i]
+		if r.JSONPointer != "" && !strings.HasPrefix(r.JSONPointer, "/") {
+			return nil, fmt.Errorf("runtime expression '%s' has an invalid JSON pointer '%s'", expression, r.JSONPointer)
+		}
+	}
+	typ, rest, _ := strings.Cut(exp, ".")
+	r.Type = typ
+
+	switch typ {
+	case ExpressionURL, ExpressionMethod, ExpressionStatusCode:
+		if rest != "" || r.JSONPointer != "" {
+			return nil, fmt.Errorf("runtime expression '%s' is not valid, '%s' cannot be followed by a property",
+				expression, typ)
+		}
+		return r, nil
+
+	case ExpressionRequest, ExpressionResponse:
+		source, name, _ := strings.Cut(rest, ".")
+		r.Source = source
+		switch source {
+		case ExpressionSourceBody:
+			if name != "" {
+				return nil, fmt.Errorf("runtime expression '%s' is not valid, body properties must be "+
+					"referenced using a JSON pointer", expression)
+			}
+			return r, nil
+		case ExpressionSourceHeader, ExpressionSourceQuery, ExpressionSourcePath:
+			if name == "" {
+				return nil, fmt.Errorf("runtime expression '%s' is not valid, a %s name is required", expression, source)
+			}
+			if r.JSONPointer != "" {
+				return nil, fmt.Errorf("runtime expression '%s' is not valid, only a body can be "+
+					"referenced using a JSON pointer", expression)
+			}
+			r.Name = name
+			return r, nil
+		default:
+			return nil, fmt.Errorf("runtime expression '%s' is not valid, '%s' must be followed by "+
+				"'header', 'query', 'path' or 'body'", expression, typ)
+		}
+
+	case ExpressionInputs, ExpressionOutputs, ExpressionSteps, ExpressionWorkflows,
+		ExpressionSourceDescriptions, ExpressionComponents:
+		name, property, _ := strings.Cut(rest, ".")
+		if name == "" {
+			return nil, fmt.Errorf("runtime expression '%s' is not valid, a name is required after '%s'",
+				expression, typ)
+		}
+		r.Name = name
+		r.Property = property
+		return r, nil
+	}
+	return nil, fmt.Errorf("runtime expression '%s' is not valid, '%s' is not a known expression type",
+		expression, typ)
+}
+
+// ExtractEmbeddedExpressions returns all runtime expressions embedded in a string using curly braces, for example
+// 'Bearer {$steps.login.outputs.token}'. If the whole value is a runtime expression, it is returned as is.
+func ExtractEmbeddedExpressions(value string) []string {
+	if strings.HasPrefix(value, "$") {
+		return []string{value}
+	}
+	var expressions []string
+	for {
+		start := strings.Index(value, "{$")
+		if start < 0 {
+			return expressions
+		}
+		end := strings.IndexByte(value[start:], '}')
+		if end < 0 {
+			return expressions
+		}
+		expressions = append(expressions, value[start+1:start+end])
+		value = value[start+end+1:]
+	}
+}
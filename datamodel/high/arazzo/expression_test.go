@@ -0,0 +1,77 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRuntimeExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   RuntimeExpression
+	}{
+		{"$url", RuntimeExpression{Type: ExpressionURL}},
+		{"$method", RuntimeExpression{Type: ExpressionMethod}},
+		{"$statusCode", RuntimeExpression{Type: ExpressionStatusCode}},
+		{"$request.header.X-Api-Key", RuntimeExpression{Type: ExpressionRequest, Source: ExpressionSourceHeader, Name: "X-Api-Key"}},
+		{"$request.query.page", RuntimeExpression{Type: ExpressionRequest, Source: ExpressionSourceQuery, Name: "page"}},
+		{"$request.path.petId", RuntimeExpression{Type: ExpressionRequest, Source: ExpressionSourcePath, Name: "petId"}},
+		{"$request.body", RuntimeExpression{Type: ExpressionRequest, Source: ExpressionSourceBody}},
+		{"$response.body#/pets/0/id", RuntimeExpression{Type: ExpressionResponse, Source: ExpressionSourceBody, JSONPointer: "/pets/0/id"}},
+		{"$inputs.username", RuntimeExpression{Type: ExpressionInputs, Name: "username"}},
+		{"$outputs.orderId", RuntimeExpression{Type: ExpressionOutputs, Name: "orderId"}},
+		{"$steps.login.outputs.token", RuntimeExpression{Type: ExpressionSteps, Name: "login", Property: "outputs.token"}},
+		{"$steps.login.outputs.user#/id", RuntimeExpression{Type: ExpressionSteps, Name: "login", Property: "outputs.user", JSONPointer: "/id"}},
+		{"$workflows.adopt.outputs.id", RuntimeExpression{Type: ExpressionWorkflows, Name: "adopt", Property: "outputs.id"}},
+		{"$sourceDescriptions.petstore.url", RuntimeExpression{Type: ExpressionSourceDescriptions, Name: "petstore", Property: "url"}},
+		{"$components.parameters.page", RuntimeExpression{Type: ExpressionComponents, Name: "parameters", Property: "page"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			r, err := ParseRuntimeExpression(tt.expression)
+			assert.NoError(t, err)
+			tt.expected.Expression = tt.expression
+			assert.Equal(t, &tt.expected, r)
+			assert.Equal(t, tt.expression, r.String())
+		})
+	}
+}
+
+func TestParseRuntimeExpression_Invalid(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        string
+	}{
+		{"$nope", "runtime expression '$nope' is not valid, '$nope' is not a known expression type"},
+		{"pizza", "runtime expression 'pizza' is not valid, 'pizza' is not a known expression type"},
+		{"$url.path", "runtime expression '$url.path' is not valid, '$url' cannot be followed by a property"},
+		{"$statusCode#/code", "runtime expression '$statusCode#/code' is not valid, '$statusCode' cannot be followed by a property"},
+		{"$response.cookie.id", "runtime expression '$response.cookie.id' is not valid, '$response' must be followed by 'header', 'query', 'path' or 'body'"},
+		{"$response.header", "runtime expression '$response.header' is not valid, a header name is required"},
+		{"$response.header.Location#/a", "runtime expression '$response.header.Location#/a' is not valid, only a body can be referenced using a JSON pointer"},
+		{"$response.body.id", "runtime expression '$response.body.id' is not valid, body properties must be referenced using a JSON pointer"},
+		{"$response.body#id", "runtime expression '$response.body#id' has an invalid JSON pointer 'id'"},
+		{"$inputs", "runtime expression '$inputs' is not valid, a name is required after '$inputs'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			r, err := ParseRuntimeExpression(tt.expression)
+			assert.Nil(t, r)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestExtractEmbeddedExpressions(t *testing.T) {
+	assert.Equal(t, []string{"$inputs.token"}, ExtractEmbeddedExpressions("$inputs.token"))
+	assert.Equal(t, []string{"$steps.login.outputs.token"},
+		ExtractEmbeddedExpressions("Bearer {$steps.login.outputs.token}"))
+	assert.Equal(t, []string{"$inputs.a", "$inputs.b"},
+		ExtractEmbeddedExpressions("{$inputs.a} and {$inputs.b}"))
+	assert.Nil(t, ExtractEmbeddedExpressions("no expressions {here}"))
+	assert.Nil(t, ExtractEmbeddedExpressions("unclosed {$inputs.a"))
+}
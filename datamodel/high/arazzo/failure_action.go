@@ -0,0 +1,74 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// FailureAction represents a high-level Arazzo Failure Action object, or a Reusable object that references a failure
+// action defined in the components of the document. If Reference is set, the action is a Reusable object.
+//   - https://spec.openapis.org/arazzo/v1.0.1#failure-action-object
+//   - https://spec.openapis.org/arazzo/v1.0.1#reusable-object
+type FailureAction struct {
+	Name       string                              `json:"name,omitempty" yaml:"name,omitempty"`
+	Type       string                              `json:"type,omitempty" yaml:"type,omitempty"`
+	WorkflowId string                              `json:"workflowId,omitempty" yaml:"workflowId,omitempty"`
+	StepId     string                              `json:"stepId,omitempty" yaml:"stepId,omitempty"`
+	RetryAfter *float64                            `json:"retryAfter,omitempty" yaml:"retryAfter,omitempty"`
+	RetryLimit *int64                              `json:"retryLimit,omitempty" yaml:"retryLimit,omitempty"`
+	Criteria   []*Criterion                        `json:"criteria,omitempty" yaml:"criteria,omitempty"`
+	Reference  string                              `json:"reference,omitempty" yaml:"reference,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low        *low.FailureAction
+}
+
+// NewFailureAction will create a new high-level FailureAction instance from a low-level one.
+func NewFailureAction(action *low.FailureAction) *FailureAction {
+	f := new(FailureAction)
+	f.low = action
+	f.Name = action.Name.Value
+	f.Type = action.Type.Value
+	f.WorkflowId = action.WorkflowId.Value
+	f.StepId = action.StepId.Value
+	if !action.RetryAfter.IsEmpty() {
+		f.RetryAfter = &action.RetryAfter.Value
+	}
+	if !action.RetryLimit.IsEmpty() {
+		f.RetryLimit = &action.RetryLimit.Value
+	}
+	f.Criteria = buildSlice(action.Criteria.Value, NewCriterion)
+	f.Reference = action.Reference.Value
+	f.Extensions = high.ExtractExtensions(action.Extensions)
+	return f
+}
+
+// IsReusable returns true if the action is a Reusable object, referencing a component.
+func (f *FailureAction) IsReusable() bool {
+	return f.Reference != ""
+}
+
+// GoLow returns the low-level FailureAction instance that was used to create the high-level one.
+func (f *FailureAction) GoLow() *low.FailureAction {
+	return f.low
+}
+
+// GoLowUntyped will return the low-level FailureAction instance that was used to create the high-level one, with no type
+func (f *FailureAction) GoLowUntyped() any {
+	return f.low
+}
+
+// Render will return a YAML representation of the FailureAction object as a byte slice.
+func (f *FailureAction) Render() ([]byte, error) {
+	return yaml.Marshal(f)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the FailureAction object.
+func (f *FailureAction) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(f, f.low)
+	return nb.Render(), nil
+}
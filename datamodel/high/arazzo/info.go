@@ -0,0 +1,55 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Info represents a high-level Arazzo Info object, it provides metadata about the workflows in the document.
+//   - https://spec.openapis.org/arazzo/v1.0.1#info-object
+type Info struct {
+	Title       string                              `json:"title,omitempty" yaml:"title,omitempty"`
+	Summary     string                              `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string                              `json:"description,omitempty" yaml:"description,omitempty"`
+	Version     string                              `json:"version,omitempty" yaml:"version,omitempty"`
+	Extensions  *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low         *low.Info
+}
+
+// NewInfo will create a new high-level Info instance from a low-level one.
+func NewInfo(info *low.Info) *Info {
+	i := new(Info)
+	i.low = info
+	i.Title = info.Title.Value
+	i.Summary = info.Summary.Value
+	i.Description = info.Description.Value
+	i.Version = info.Version.Value
+	i.Extensions = high.ExtractExtensions(info.Extensions)
+	return i
+}
+
+// GoLow returns the low-level Info instance that was used to create the high-level one.
+func (i *Info) GoLow() *low.Info {
+	return i.low
+}
+
+// GoLowUntyped will return the low-level Info instance that was used to create the high-level one, with no type
+func (i *Info) GoLowUntyped() any {
+	return i.low
+}
+
+// Render will return a YAML representation of the Info object as a byte slice.
+func (i *Info) Render() ([]byte, error) {
+	return yaml.Marshal(i)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Info object.
+func (i *Info) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(i, i.low)
+	return nb.Render(), nil
+}
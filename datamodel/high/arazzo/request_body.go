@@ -0,0 +1,94 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// RequestBody represents a high-level Arazzo Request Body object, the payload sent by a step to an operation.
+//   - https://spec.openapis.org/arazzo/v1.0.1#request-body-object
+type RequestBody struct {
+	ContentType  string                              `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	Payload      *yaml.Node                          `json:"payload,omitempty" yaml:"payload,omitempty"`
+	Replacements []*PayloadReplacement               `json:"replacements,omitempty" yaml:"replacements,omitempty"`
+	Extensions   *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low          *low.RequestBody
+}
+
+// NewRequestBody will create a new high-level RequestBody instance from a low-level one.
+func NewRequestBody(body *low.RequestBody) *RequestBody {
+	r := new(RequestBody)
+	r.low = body
+	r.ContentType = body.ContentType.Value
+	r.Payload = body.Payload.Value
+	r.Replacements = buildSlice(body.Replacements.Value, NewPayloadReplacement)
+	r.Extensions = high.ExtractExtensions(body.Extensions)
+	return r
+}
+
+// GoLow returns the low-level RequestBody instance that was used to create the high-level one.
+func (r *RequestBody) GoLow() *low.RequestBody {
+	return r.low
+}
+
+// GoLowUntyped will return the low-level RequestBody instance that was used to create the high-level one, with no type
+func (r *RequestBody) GoLowUntyped() any {
+	return r.low
+}
+
+// Render will return a YAML representation of the RequestBody object as a byte slice.
+func (r *RequestBody) Render() ([]byte, error) {
+	return yaml.Marshal(r)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the RequestBody object.
+func (r *RequestBody) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(r, r.low)
+	return nb.Render(), nil
+}
+
+// PayloadReplacement represents a high-level Arazzo Payload Replacement object, it replaces a value in the payload
+// of a request body, at the location of the target.
+//   - https://spec.openapis.org/arazzo/v1.0.1#payload-replacement-object
+type PayloadReplacement struct {
+	Target     string                              `json:"target,omitempty" yaml:"target,omitempty"`
+	Value      *yaml.Node                          `json:"value,omitempty" yaml:"value,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low        *low.PayloadReplacement
+}
+
+// NewPayloadReplacement will create a new high-level PayloadReplacement instance from a low-level one.
+func NewPayloadReplacement(replacement *low.PayloadReplacement) *PayloadReplacement {
+	p := new(PayloadReplacement)
+	p.low = replacement
+	p.Target = replacement.Target.Value
+	p.Value = replacement.Value.Value
+	p.Extensions = high.ExtractExtensions(replacement.Extensions)
+	return p
+}
+
+// GoLow returns the low-level PayloadReplacement instance that was used to create the high-level one.
+func (p *PayloadReplacement) GoLow() *low.PayloadReplacement {
+	return p.low
+}
+
+// GoLowUntyped will return the low-level PayloadReplacement instance that was used to create the high-level one, with no type
+func (p *PayloadReplacement) GoLowUntyped() any {
+	return p.low
+}
+
+// Render will return a YAML representation of the PayloadReplacement object as a byte slice.
+func (p *PayloadReplacement) Render() ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the PayloadReplacement object.
+func (p *PayloadReplacement) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(p, p.low)
+	return nb.Render(), nil
+}
@@ -0,0 +1,443 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// ResolvedOperation is an OpenAPI operation called by a step, located using the operationId or operationPath
+// of the step.
+type ResolvedOperation struct {
+	// SourceDescription is the source description of the OpenAPI document that defines the operation.
+	SourceDescription *SourceDescription
+
+	// Document is the OpenAPI document that defines the operation.
+	Document *v3.Document
+
+	// Path is the path of the operation, for example '/pets/{petId}'.
+	Path string
+
+	// Method is the lowercase HTTP method of the operation, for example 'get'.
+	Method string
+
+	// PathItem is the path item that contains the operation.
+	PathItem *v3.PathItem
+
+	// Operation is the resolved operation.
+	Operation *v3.Operation
+}
+
+// ResolutionError is returned by ResolveOperations when a step, or an action or parameter used by a step, refers
+// to something that does not exist.
+type ResolutionError struct {
+	// WorkflowId is the workflowId of the workflow that contains the error.
+	WorkflowId string
+
+	// StepId is the stepId of the step that contains the error, it is empty for workflow level errors.
+	StepId string
+
+	// Reference is the value that could not be resolved, for example an operationId or a runtime expression.
+	Reference string
+
+	// Reason explains why the reference could not be resolved.
+	Reason string
+
+	// Line and Column are the location of the reference in the Arazzo document, they are zero when unknown.
+	Line   int
+	Column int
+}
+
+// Error returns a description of the error, including the workflow, step and location.
+func (r *ResolutionError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("workflow '%s'", r.WorkflowId))
+	if r.StepId != "" {
+		sb.WriteString(fmt.Sprintf(", step '%s'", r.StepId))
+	}
+	sb.WriteString(fmt.Sprintf(": cannot resolve '%s': %s", r.Reference, r.Reason))
+	if r.Line > 0 {
+		sb.WriteString(fmt.Sprintf(" (line %d, column %d)", r.Line, r.Column))
+	}
+	return sb.String()
+}
+
+// ResolveOperations locates the OpenAPI operation called by every step of every workflow, using the supplied
+// OpenAPI documents, keyed by the name of the source description they were loaded from. Resolved operations are
+// available from Step.Operation.
+//
+// Steps using an operationId without a '$sourceDescriptions' prefix are searched for in all OpenAPI source
+// descriptions, the operationId must be unique across all of them. Steps calling workflows, goto actions,
+// dependsOn and Reusable objects are also checked against the Arazzo document.
+//
+// All problems found are returned as a single joined error of *ResolutionError values, nil is returned
+// if every reference could be resolved.
+func (a *Arazzo) ResolveOperations(sources map[string]*v3.Document) error {
+	r := &resolver{arazzo: a, sources: sources}
+	for _, w := range a.Workflows {
+		r.resolveWorkflow(w)
+	}
+	return errors.Join(r.errs...)
+}
+
+type resolver struct {
+	arazzo  *Arazzo
+	sources map[string]*v3.Document
+	errs    []error
+}
+
+func (r *resolver) fail(w *Workflow, s *Step, reference string, node *yaml.Node, reason string, args ...any) {
+	e := &ResolutionError{WorkflowId: w.WorkflowId, Reference: reference, Reason: fmt.Sprintf(reason, args...)}
+	if s != nil {
+		e.StepId = s.StepId
+	}
+	if node != nil {
+		e.Line = node.Line
+		e.Column = node.Column
+	}
+	r.errs = append(r.errs, e)
+}
+
+func (r *resolver) resolveWorkflow(w *Workflow) {
+	for i, d := range w.DependsOn {
+		var node *yaml.Node
+		if w.low != nil && i < len(w.low.DependsOn.Value) {
+			node = w.low.DependsOn.Value[i].ValueNode
+		}
+		if !r.workflowExists(d) {
+			r.fail(w, nil, d, node, "the workflow cannot be found")
+		}
+	}
+	for _, p := range w.Parameters {
+		r.resolveParameter(w, nil, p)
+	}
+	for _, s := range w.Steps {
+		r.resolveStep(w, s)
+	}
+	for _, sa := range w.SuccessActions {
+		r.resolveSuccessAction(w, nil, sa)
+	}
+	for _, fa := range w.FailureActions {
+		r.resolveFailureAction(w, nil, fa)
+	}
+}
+
+func (r *resolver) resolveStep(w *Workflow, s *Step) {
+	s.operation = nil
+	var opIdNode, opPathNode, wfNode *yaml.Node
+	if s.low != nil {
+		opIdNode = s.low.OperationId.ValueNode
+		opPathNode = s.low.OperationPath.ValueNode
+		wfNode = s.low.WorkflowId.ValueNode
+	}
+
+	targets := 0
+	for _, t := range []string{s.OperationId, s.OperationPath, s.WorkflowId} {
+		if t != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		var node *yaml.Node
+		if s.low != nil {
+			node = s.low.KeyNode
+			if s.low.RootNode != nil {
+				node = s.low.RootNode
+			}
+		}
+		r.fail(w, s, s.StepId, node, "a step must define exactly one of operationId, operationPath or workflowId")
+	}
+
+	switch {
+	case s.OperationId != "":
+		s.operation = r.resolveOperationId(w, s, opIdNode)
+	case s.OperationPath != "":
+		s.operation = r.resolveOperationPath(w, s, opPathNode)
+	case s.WorkflowId != "":
+		if !r.workflowExists(s.WorkflowId) {
+			r.fail(w, s, s.WorkflowId, wfNode, "the workflow cannot be found")
+		}
+	}
+
+	for _, p := range s.Parameters {
+		r.resolveParameter(w, s, p)
+	}
+	for _, sa := range s.OnSuccess {
+		r.resolveSuccessAction(w, s, sa)
+	}
+	for _, fa := range s.OnFailure {
+		r.resolveFailureAction(w, s, fa)
+	}
+}
+
+// resolveOperationId locates an operation using an operationId, which is either a plain operationId,
+// or an operationId prefixed with '$sourceDescriptions.<name>.'.
+func (r *resolver) resolveOperationId(w *Workflow, s *Step, node *yaml.Node) *ResolvedOperation {
+	operationId := s.OperationId
+	if strings.HasPrefix(operationId, ExpressionSourceDescriptions+".") {
+		exp, err := ParseRuntimeExpression(operationId)
+		if err != nil || exp.Property == "" {
+			r.fail(w, s, operationId, node, "the operationId is not a valid source description expression")
+			return nil
+		}
+		source, doc := r.source(w, s, exp.Name, operationId, node)
+		if doc == nil {
+			return nil
+		}
+		op := findOperationById(doc, exp.Property)
+		if op == nil {
+			r.fail(w, s, operationId, node, "operation '%s' cannot be found in source description '%s'",
+				exp.Property, exp.Name)
+			return nil
+		}
+		op.SourceDescription = source
+		return op
+	}
+
+	var found []*ResolvedOperation
+	var searched int
+	for _, source := range r.arazzo.SourceDescriptions {
+		if !source.IsOpenAPI() {
+			continue
+		}
+		doc := r.sources[source.Name]
+		if doc == nil {
+			continue
+		}
+		searched++
+		if op := findOperationById(doc, operationId); op != nil {
+			op.SourceDescription = source
+			found = append(found, op)
+		}
+	}
+	switch {
+	case searched == 0:
+		r.fail(w, s, operationId, node, "no OpenAPI source descriptions have been loaded")
+		return nil
+	case len(found) == 0:
+		r.fail(w, s, operationId, node, "operation '%s' cannot be found in any OpenAPI source description",
+			operationId)
+		return nil
+	case len(found) > 1:
+		names := make([]string, 0, len(found))
+		for _, f := range found {
+			names = append(names, f.SourceDescription.Name)
+		}
+		r.fail(w, s, operationId, node, "operation '%s' is ambiguous, it is defined by source descriptions '%s', "+
+			"use a '$sourceDescriptions' expression", operationId, strings.Join(names, "', '"))
+		return nil
+	}
+	return found[0]
+}
+
+// resolveOperationPath locates an operation using an operationPath, for example
+// '{$sourceDescriptions.petstore.url}#/paths/~1pets~1{petId}/get'.
+func (r *resolver) resolveOperationPath(w *Workflow, s *Step, node *yaml.Node) *ResolvedOperation {
+	operationPath := s.OperationPath
+	location, pointer, found := strings.Cut(operationPath, "#")
+	if !found {
+		r.fail(w, s, operationPath, node, "the operationPath must contain a JSON pointer to an operation")
+		return nil
+	}
+
+	var sourceName string
+	if strings.HasPrefix(location, "{") && strings.HasSuffix(location, "}") {
+		exp, err := ParseRuntimeExpression(location[1 : len(location)-1])
+		if err != nil || exp.Type != ExpressionSourceDescriptions || exp.Property != "url" {
+			r.fail(w, s, operationPath, node, "the operationPath must start with a "+
+				"'{$sourceDescriptions.<name>.url}' expression")
+			return nil
+		}
+		sourceName = exp.Name
+	} else {
+		for _, sd := range r.arazzo.SourceDescriptions {
+			if sd.URL == location {
+				sourceName = sd.Name
+				break
+			}
+		}
+		if sourceName == "" {
+			r.fail(w, s, operationPath, node, "no source description has the url '%s'", location)
+			return nil
+		}
+	}
+
+	source, doc := r.source(w, s, sourceName, operationPath, node)
+	if doc == nil {
+		return nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	if len(segments) != 3 || (segments[0] != "paths" && segments[0] != "webhooks") {
+		r.fail(w, s, operationPath, node, "the JSON pointer '%s' does not point to an operation", pointer)
+		return nil
+	}
+	path := unescapePointerSegment(segments[1])
+	method := strings.ToLower(unescapePointerSegment(segments[2]))
+
+	var pathItem *v3.PathItem
+	if segments[0] == "paths" {
+		if doc.Paths != nil && doc.Paths.PathItems != nil {
+			pathItem = doc.Paths.PathItems.GetOrZero(path)
+		}
+	} else if doc.Webhooks != nil {
+		pathItem = doc.Webhooks.GetOrZero(path)
+	}
+	if pathItem == nil {
+		r.fail(w, s, operationPath, node, "path '%s' cannot be found in source description '%s'", path, sourceName)
+		return nil
+	}
+	op := pathItem.GetOperations().GetOrZero(method)
+	if op == nil {
+		r.fail(w, s, operationPath, node, "operation '%s %s' cannot be found in source description '%s'",
+			strings.ToUpper(method), path, sourceName)
+		return nil
+	}
+	return &ResolvedOperation{
+		SourceDescription: source,
+		Document:          doc,
+		Path:              path,
+		Method:            method,
+		PathItem:          pathItem,
+		Operation:         op,
+	}
+}
+
+// source returns the source description and OpenAPI document for the supplied name.
+func (r *resolver) source(w *Workflow, s *Step, name, reference string, node *yaml.Node) (*SourceDescription, *v3.Document) {
+	source := r.arazzo.FindSourceDescription(name)
+	if source == nil {
+		r.fail(w, s, reference, node, "source description '%s' cannot be found", name)
+		return nil, nil
+	}
+	if !source.IsOpenAPI() {
+		r.fail(w, s, reference, node, "source description '%s' is not an OpenAPI document", name)
+		return nil, nil
+	}
+	doc := r.sources[name]
+	if doc == nil {
+		r.fail(w, s, reference, node, "source description '%s' has not been loaded", name)
+		return nil, nil
+	}
+	return source, doc
+}
+
+// workflowExists checks a workflowId exists, either in the Arazzo document, or as an Arazzo source description
+// when the workflowId uses a '$sourceDescriptions' expression. Workflows of other Arazzo documents are not checked.
+func (r *resolver) workflowExists(workflowId string) bool {
+	if strings.HasPrefix(workflowId, ExpressionSourceDescriptions+".") {
+		exp, err := ParseRuntimeExpression(workflowId)
+		if err != nil || exp.Property == "" {
+			return false
+		}
+		source := r.arazzo.FindSourceDescription(exp.Name)
+		return source != nil && source.Type == SourceDescriptionTypeArazzo
+	}
+	return r.arazzo.FindWorkflow(workflowId) != nil
+}
+
+func (r *resolver) resolveParameter(w *Workflow, s *Step, p *Parameter) {
+	if !p.IsReusable() {
+		return
+	}
+	var node *yaml.Node
+	if p.low != nil {
+		node = p.low.Reference.ValueNode
+	}
+	name, ok := r.componentName(p.Reference, "parameters")
+	if !ok || r.arazzo.Components == nil || r.arazzo.Components.Parameters.GetOrZero(name) == nil {
+		r.fail(w, s, p.Reference, node, "the parameter cannot be found in components")
+	}
+}
+
+func (r *resolver) resolveSuccessAction(w *Workflow, s *Step, a *SuccessAction) {
+	if a.IsReusable() {
+		var node *yaml.Node
+		if a.low != nil {
+			node = a.low.Reference.ValueNode
+		}
+		name, ok := r.componentName(a.Reference, "successActions")
+		if !ok || r.arazzo.Components == nil || r.arazzo.Components.SuccessActions.GetOrZero(name) == nil {
+			r.fail(w, s, a.Reference, node, "the success action cannot be found in components")
+		}
+		return
+	}
+	if a.Type == ActionTypeGoto {
+		var stepNode, wfNode *yaml.Node
+		if a.low != nil {
+			stepNode, wfNode = a.low.StepId.ValueNode, a.low.WorkflowId.ValueNode
+		}
+		r.resolveGoto(w, s, a.StepId, stepNode, a.WorkflowId, wfNode)
+	}
+}
+
+func (r *resolver) resolveFailureAction(w *Workflow, s *Step, a *FailureAction) {
+	if a.IsReusable() {
+		var node *yaml.Node
+		if a.low != nil {
+			node = a.low.Reference.ValueNode
+		}
+		name, ok := r.componentName(a.Reference, "failureActions")
+		if !ok || r.arazzo.Components == nil || r.arazzo.Components.FailureActions.GetOrZero(name) == nil {
+			r.fail(w, s, a.Reference, node, "the failure action cannot be found in components")
+		}
+		return
+	}
+	if a.Type == ActionTypeGoto || a.Type == ActionTypeRetry {
+		var stepNode, wfNode *yaml.Node
+		if a.low != nil {
+			stepNode, wfNode = a.low.StepId.ValueNode, a.low.WorkflowId.ValueNode
+		}
+		r.resolveGoto(w, s, a.StepId, stepNode, a.WorkflowId, wfNode)
+	}
+}
+
+// resolveGoto checks the target of a goto (or retry) action, steps must be in the same workflow.
+func (r *resolver) resolveGoto(w *Workflow, s *Step, stepId string, stepNode *yaml.Node,
+	workflowId string, wfNode *yaml.Node,
+) {
+	if stepId != "" && w.FindStep(stepId) == nil {
+		r.fail(w, s, stepId, stepNode, "the step cannot be found in workflow '%s'", w.WorkflowId)
+	}
+	if workflowId != "" && !r.workflowExists(workflowId) {
+		r.fail(w, s, workflowId, wfNode, "the workflow cannot be found")
+	}
+}
+
+// componentName extracts the name of a component from a '$components.<type>.<name>' expression.
+func (r *resolver) componentName(reference, componentType string) (string, bool) {
+	exp, err := ParseRuntimeExpression(reference)
+	if err != nil || exp.Type != ExpressionComponents || exp.Name != componentType || exp.Property == "" {
+		return "", false
+	}
+	return exp.Property, true
+}
+
+// findOperationById searches all paths and webhooks of an OpenAPI document for an operation.
+func findOperationById(doc *v3.Document, operationId string) *ResolvedOperation {
+	var pathItems *orderedmap.Map[string, *v3.PathItem]
+	if doc.Paths != nil {
+		pathItems = doc.Paths.PathItems
+	}
+	for _, items := range []*orderedmap.Map[string, *v3.PathItem]{pathItems, doc.Webhooks} {
+		for path, pathItem := range items.FromOldest() {
+			for method, op := range pathItem.GetOperations().FromOldest() {
+				if op.OperationId == operationId {
+					return &ResolvedOperation{Document: doc, Path: path, Method: method, PathItem: pathItem, Operation: op}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func unescapePointerSegment(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+}
@@ -0,0 +1,260 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	lowv3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildOpenAPI(t *testing.T, data []byte) *v3.Document {
+	info, err := datamodel.ExtractSpecInfo(data)
+	require.NoError(t, err)
+	lowDoc, err := lowv3.CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	require.NoError(t, err)
+	return v3.NewDocument(lowDoc)
+}
+
+func loadPetstore(t *testing.T) *v3.Document {
+	data, err := os.ReadFile("../../../test_specs/petstorev3.json")
+	require.NoError(t, err)
+	return buildOpenAPI(t, data)
+}
+
+func resolutionErrors(err error) []*ResolutionError {
+	var out []*ResolutionError
+	if err == nil {
+		return out
+	}
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var re *ResolutionError
+		if errors.As(e, &re) {
+			out = append(out, re)
+		}
+	}
+	return out
+}
+
+func TestArazzo_ResolveOperations(t *testing.T) {
+	doc := loadPetstoreArazzo(t)
+	petstore := loadPetstore(t)
+
+	err := doc.ResolveOperations(map[string]*v3.Document{"petstore": petstore})
+	assert.NoError(t, err)
+
+	workflow := doc.FindWorkflow("adoptPet")
+
+	login := workflow.FindStep("login").Operation()
+	assert.Equal(t, "/user/login", login.Path)
+	assert.Equal(t, "get", login.Method)
+	assert.Equal(t, "loginUser", login.Operation.OperationId)
+	assert.Equal(t, "petstore", login.SourceDescription.Name)
+	assert.Equal(t, petstore, login.Document)
+	assert.NotNil(t, login.PathItem.Get)
+
+	find := workflow.FindStep("findPets").Operation()
+	assert.Equal(t, "/pet/findByStatus", find.Path)
+	assert.Equal(t, "findPetsByStatus", find.Operation.OperationId)
+	assert.Equal(t, "petstore", find.SourceDescription.Name)
+
+	order := workflow.FindStep("placeOrder").Operation()
+	assert.Equal(t, "/store/order", order.Path)
+	assert.Equal(t, "post", order.Method)
+}
+
+func TestArazzo_ResolveOperations_Missing(t *testing.T) {
+	yml := `arazzo: 1.0.1
+info:
+  title: broken
+  version: 1.0.0
+sourceDescriptions:
+  - name: petstore
+    url: petstorev3.json
+  - name: other
+    url: other.arazzo.yaml
+    type: arazzo
+workflows:
+  - workflowId: broken
+    dependsOn:
+      - nope
+    steps:
+      - stepId: missingId
+        operationId: getPetByName
+      - stepId: missingSourceId
+        operationId: $sourceDescriptions.petstore.getPetByName
+      - stepId: unknownSource
+        operationId: $sourceDescriptions.pizza.getPetById
+      - stepId: missingPath
+        operationPath: '{$sourceDescriptions.petstore.url}#/paths/~1pets/get'
+      - stepId: missingMethod
+        operationPath: '{$sourceDescriptions.petstore.url}#/paths/~1pet~1{petId}/patch'
+      - stepId: notOperation
+        operationPath: '{$sourceDescriptions.petstore.url}#/components/schemas/Pet'
+      - stepId: missingWorkflow
+        workflowId: nope
+      - stepId: tooMany
+        operationId: getPetById
+        workflowId: broken
+        parameters:
+          - reference: $components.parameters.nope
+        onSuccess:
+          - name: jump
+            type: goto
+            stepId: nope
+        onFailure:
+          - reference: $components.failureActions.nope
+      - stepId: remoteWorkflow
+        workflowId: $sourceDescriptions.other.someWorkflow`
+
+	doc := buildArazzo(t, []byte(yml))
+	err := doc.ResolveOperations(map[string]*v3.Document{"petstore": loadPetstore(t)})
+	assert.Error(t, err)
+
+	errs := resolutionErrors(err)
+	require.Len(t, errs, 12)
+
+	assert.Equal(t, "workflow 'broken': cannot resolve 'nope': the workflow cannot be found (line 14, column 9)",
+		errs[0].Error())
+	assert.Equal(t, "workflow 'broken', step 'missingId': cannot resolve 'getPetByName': operation 'getPetByName' "+
+		"cannot be found in any OpenAPI source description (line 17, column 22)", errs[1].Error())
+	assert.Equal(t, "missingId", errs[1].StepId)
+	assert.Equal(t, "getPetByName", errs[1].Reference)
+	assert.Equal(t, 17, errs[1].Line)
+
+	assert.Equal(t, "operation 'getPetByName' cannot be found in source description 'petstore'", errs[2].Reason)
+	assert.Equal(t, "source description 'pizza' cannot be found", errs[3].Reason)
+	assert.Equal(t, "path '/pets' cannot be found in source description 'petstore'", errs[4].Reason)
+	assert.Equal(t, "operation 'PATCH /pet/{petId}' cannot be found in source description 'petstore'", errs[5].Reason)
+	assert.Equal(t, "the JSON pointer '/components/schemas/Pet' does not point to an operation", errs[6].Reason)
+	assert.Equal(t, "missingWorkflow", errs[7].StepId)
+	assert.Equal(t, "the workflow cannot be found", errs[7].Reason)
+	assert.Equal(t, "a step must define exactly one of operationId, operationPath or workflowId", errs[8].Reason)
+	assert.Equal(t, "the parameter cannot be found in components", errs[9].Reason)
+	assert.Equal(t, "the step cannot be found in workflow 'broken'", errs[10].Reason)
+	assert.Equal(t, "the failure action cannot be found in components", errs[11].Reason)
+
+	// the operation of a step with too many targets is still resolved using the operationId.
+	assert.Equal(t, "getPetById", doc.Workflows[0].FindStep("tooMany").Operation().Operation.OperationId)
+	assert.Nil(t, doc.Workflows[0].FindStep("missingId").Operation())
+}
+
+func TestArazzo_ResolveOperations_Ambiguous(t *testing.T) {
+	yml := `arazzo: 1.0.1
+sourceDescriptions:
+  - name: petstore
+    url: petstorev3.json
+  - name: petstore2
+    url: petstorev3-copy.json
+workflows:
+  - workflowId: ambiguous
+    steps:
+      - stepId: get
+        operationId: getPetById
+      - stepId: qualified
+        operationId: $sourceDescriptions.petstore2.getPetById`
+
+	doc := buildArazzo(t, []byte(yml))
+	petstore := loadPetstore(t)
+	err := doc.ResolveOperations(map[string]*v3.Document{"petstore": petstore, "petstore2": petstore})
+
+	errs := resolutionErrors(err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "operation 'getPetById' is ambiguous, it is defined by source descriptions 'petstore', "+
+		"'petstore2', use a '$sourceDescriptions' expression", errs[0].Reason)
+	assert.Equal(t, "petstore2", doc.Workflows[0].Steps[1].Operation().SourceDescription.Name)
+}
+
+func TestArazzo_ResolveOperations_Sources(t *testing.T) {
+	yml := `arazzo: 1.0.1
+sourceDescriptions:
+  - name: petstore
+    url: https://pb33f.io/petstore.json
+  - name: flows
+    url: flows.arazzo.yaml
+    type: arazzo
+workflows:
+  - workflowId: sources
+    steps:
+      - stepId: notLoaded
+        operationId: getPetById
+      - stepId: notLoadedQualified
+        operationId: $sourceDescriptions.petstore.getPetById
+      - stepId: notOpenAPI
+        operationId: $sourceDescriptions.flows.getPetById
+      - stepId: badExpression
+        operationId: $sourceDescriptions.petstore
+      - stepId: noPointer
+        operationPath: https://pb33f.io/petstore.json
+      - stepId: byURL
+        operationPath: https://pb33f.io/petstore.json#/paths/~1pet/put
+      - stepId: unknownURL
+        operationPath: https://pb33f.io/nope.json#/paths/~1pet/put
+      - stepId: badURLExpression
+        operationPath: '{$sourceDescriptions.petstore.name}#/paths/~1pet/put'
+    successActions:
+      - reference: $components.successActions.nope
+    failureActions:
+      - name: retry
+        type: retry
+        workflowId: $sourceDescriptions.nope.flow`
+
+	doc := buildArazzo(t, []byte(yml))
+	err := doc.ResolveOperations(nil)
+	errs := resolutionErrors(err)
+	require.Len(t, errs, 10)
+	assert.Equal(t, "no OpenAPI source descriptions have been loaded", errs[0].Reason)
+	assert.Equal(t, "source description 'petstore' has not been loaded", errs[1].Reason)
+	assert.Equal(t, "source description 'flows' is not an OpenAPI document", errs[2].Reason)
+	assert.Equal(t, "the operationId is not a valid source description expression", errs[3].Reason)
+	assert.Equal(t, "the operationPath must contain a JSON pointer to an operation", errs[4].Reason)
+	assert.Equal(t, "source description 'petstore' has not been loaded", errs[5].Reason)
+	assert.Equal(t, "no source description has the url 'https://pb33f.io/nope.json'", errs[6].Reason)
+	assert.Equal(t, "the operationPath must start with a '{$sourceDescriptions.<name>.url}' expression",
+		errs[7].Reason)
+	assert.Equal(t, "the success action cannot be found in components", errs[8].Reason)
+	assert.Equal(t, "", errs[8].StepId)
+	assert.Equal(t, "the workflow cannot be found", errs[9].Reason)
+
+	// with the source loaded, the operationPath using a URL resolves.
+	err = doc.ResolveOperations(map[string]*v3.Document{"petstore": loadPetstore(t)})
+	assert.Len(t, resolutionErrors(err), 7)
+	assert.Equal(t, "updatePet", doc.Workflows[0].FindStep("byURL").Operation().Operation.OperationId)
+}
+
+func TestArazzo_ResolveOperations_Webhooks(t *testing.T) {
+	spec := `openapi: 3.1.0
+webhooks:
+  newPet:
+    post:
+      operationId: newPetHook`
+	yml := `arazzo: 1.0.1
+sourceDescriptions:
+  - name: hooks
+    url: hooks.yaml
+workflows:
+  - workflowId: hooks
+    steps:
+      - stepId: byId
+        operationId: newPetHook
+      - stepId: byPath
+        operationPath: '{$sourceDescriptions.hooks.url}#/webhooks/newPet/post'`
+
+	doc := buildArazzo(t, []byte(yml))
+	err := doc.ResolveOperations(map[string]*v3.Document{"hooks": buildOpenAPI(t, []byte(spec))})
+	assert.NoError(t, err)
+	assert.Equal(t, "newPet", doc.Workflows[0].Steps[0].Operation().Path)
+	assert.Equal(t, "post", doc.Workflows[0].Steps[1].Operation().Method)
+}
+
+func TestResolutionError_Error(t *testing.T) {
+	err := &ResolutionError{WorkflowId: "flow", Reference: "op", Reason: "it is missing"}
+	assert.Equal(t, "workflow 'flow': cannot resolve 'op': it is missing", err.Error())
+}
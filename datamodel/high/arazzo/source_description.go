@@ -0,0 +1,65 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Source description types defined by the Arazzo specification.
+const (
+	SourceDescriptionTypeOpenAPI = "openapi"
+	SourceDescriptionTypeArazzo  = "arazzo"
+)
+
+// SourceDescription represents a high-level Arazzo Source Description object. A source description points to an
+// OpenAPI or Arazzo document that contains the operations and workflows used by steps.
+//   - https://spec.openapis.org/arazzo/v1.0.1#source-description-object
+type SourceDescription struct {
+	Name       string                              `json:"name,omitempty" yaml:"name,omitempty"`
+	URL        string                              `json:"url,omitempty" yaml:"url,omitempty"`
+	Type       string                              `json:"type,omitempty" yaml:"type,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low        *low.SourceDescription
+}
+
+// NewSourceDescription will create a new high-level SourceDescription instance from a low-level one.
+func NewSourceDescription(source *low.SourceDescription) *SourceDescription {
+	s := new(SourceDescription)
+	s.low = source
+	s.Name = source.Name.Value
+	s.URL = source.URL.Value
+	s.Type = source.Type.Value
+	s.Extensions = high.ExtractExtensions(source.Extensions)
+	return s
+}
+
+// IsOpenAPI returns true if the source description is an OpenAPI document, which is the default when no type is set.
+func (s *SourceDescription) IsOpenAPI() bool {
+	return s.Type == "" || s.Type == SourceDescriptionTypeOpenAPI
+}
+
+// GoLow returns the low-level SourceDescription instance that was used to create the high-level one.
+func (s *SourceDescription) GoLow() *low.SourceDescription {
+	return s.low
+}
+
+// GoLowUntyped will return the low-level SourceDescription instance that was used to create the high-level one, with no type
+func (s *SourceDescription) GoLowUntyped() any {
+	return s.low
+}
+
+// Render will return a YAML representation of the SourceDescription object as a byte slice.
+func (s *SourceDescription) Render() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the SourceDescription object.
+func (s *SourceDescription) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(s, s.low)
+	return nb.Render(), nil
+}
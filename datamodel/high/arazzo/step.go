@@ -0,0 +1,81 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Step represents a high-level Arazzo Step object. A step calls an operation (using an OperationId or
+// OperationPath) or another workflow (using a WorkflowId), only one of them should be set.
+//   - https://spec.openapis.org/arazzo/v1.0.1#step-object
+type Step struct {
+	Description     string                              `json:"description,omitempty" yaml:"description,omitempty"`
+	StepId          string                              `json:"stepId,omitempty" yaml:"stepId,omitempty"`
+	OperationId     string                              `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	OperationPath   string                              `json:"operationPath,omitempty" yaml:"operationPath,omitempty"`
+	WorkflowId      string                              `json:"workflowId,omitempty" yaml:"workflowId,omitempty"`
+	Parameters      []*Parameter                        `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody     *RequestBody                        `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	SuccessCriteria []*Criterion                        `json:"successCriteria,omitempty" yaml:"successCriteria,omitempty"`
+	OnSuccess       []*SuccessAction                    `json:"onSuccess,omitempty" yaml:"onSuccess,omitempty"`
+	OnFailure       []*FailureAction                    `json:"onFailure,omitempty" yaml:"onFailure,omitempty"`
+	Outputs         *orderedmap.Map[string, string]     `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+	Extensions      *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	operation       *ResolvedOperation
+	low             *low.Step
+}
+
+// NewStep will create a new high-level Step instance from a low-level one.
+func NewStep(step *low.Step) *Step {
+	s := new(Step)
+	s.low = step
+	s.Description = step.Description.Value
+	s.StepId = step.StepId.Value
+	s.OperationId = step.OperationId.Value
+	s.OperationPath = step.OperationPath.Value
+	s.WorkflowId = step.WorkflowId.Value
+	s.Parameters = buildSlice(step.Parameters.Value, NewParameter)
+	if !step.RequestBody.IsEmpty() {
+		s.RequestBody = NewRequestBody(step.RequestBody.Value)
+	}
+	s.SuccessCriteria = buildSlice(step.SuccessCriteria.Value, NewCriterion)
+	s.OnSuccess = buildSlice(step.OnSuccess.Value, NewSuccessAction)
+	s.OnFailure = buildSlice(step.OnFailure.Value, NewFailureAction)
+	s.Outputs = lowmodel.FromReferenceMap(step.Outputs.Value)
+	s.Extensions = high.ExtractExtensions(step.Extensions)
+	return s
+}
+
+// Operation returns the OpenAPI operation called by the step. The operation is only available once the
+// operations of the document have been resolved using Arazzo.ResolveOperations, nil is returned if the step
+// calls a workflow, or if the operation could not be resolved.
+func (s *Step) Operation() *ResolvedOperation {
+	return s.operation
+}
+
+// GoLow returns the low-level Step instance that was used to create the high-level one.
+func (s *Step) GoLow() *low.Step {
+	return s.low
+}
+
+// GoLowUntyped will return the low-level Step instance that was used to create the high-level one, with no type
+func (s *Step) GoLowUntyped() any {
+	return s.low
+}
+
+// Render will return a YAML representation of the Step object as a byte slice.
+func (s *Step) Render() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the Step object.
+func (s *Step) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(s, s.low)
+	return nb.Render(), nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high"
+	low "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Action types defined by the Arazzo specification, retry is only valid for failure actions.
+const (
+	ActionTypeEnd   = "end"
+	ActionTypeGoto  = "goto"
+	ActionTypeRetry = "retry"
+)
+
+// SuccessAction represents a high-level Arazzo Success Action object, or a Reusable object that references a success
+// action defined in the components of the document. If Reference is set, the action is a Reusable object.
+//   - https://spec.openapis.org/arazzo/v1.0.1#success-action-object
+//   - https://spec.openapis.org/arazzo/v1.0.1#reusable-object
+type SuccessAction struct {
+	Name       string                              `json:"name,omitempty" yaml:"name,omitempty"`
+	Type       string                              `json:"type,omitempty" yaml:"type,omitempty"`
+	WorkflowId string                              `json:"workflowId,omitempty" yaml:"workflowId,omitempty"`
+	StepId     string                              `json:"stepId,omitempty" yaml:"stepId,omitempty"`
+	Criteria   []*Criterion                        `json:"criteria,omitempty" yaml:"criteria,omitempty"`
+	Reference  string                              `json:"reference,omitempty" yaml:"reference,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low        *low.SuccessAction
+}
+
+// NewSuccessAction will create a new high-level SuccessAction instance from a low-level one.
+func NewSuccessAction(action *low.SuccessAction) *SuccessAction {
+	s := new(SuccessAction)
+	s.low = action
+	s.Name = action.Name.Value
+	s.Type = action.Type.Value
+	s.WorkflowId = action.WorkflowId.Value
+	s.StepId = action.StepId.Value
+	s.Criteria = buildSlice(action.Criteria.Value, NewCriterion)
+	s.Reference = action.Reference.Value
+	s.Extensions = high.ExtractExtensions(action.Extensions)
+	return s
+}
+
+// IsReusable returns true if the action is a Reusable object, referencing a component.
+func (s *SuccessAction) IsReusable() bool {
+	return s.Reference != ""
+}
+
+// GoLow returns the low-level SuccessAction instance that was used to create the high-level one.
+func (s *SuccessAction) GoLow() *low.SuccessAction {
+	return s.low
+}
+
+// GoLowUntyped will return the low-level SuccessAction instance that was used to create the high-level one, with no type
+func (s *SuccessAction) GoLowUntyped() any {
+	return s.low
+}
+
+// Render will return a YAML representation of the SuccessAction object as a byte slice.
+func (s *SuccessAction) Render() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// MarshalYAML will create a ready to render YAML representation of the SuccessAction object.
+func (s *SuccessAction) MarshalYAML() (interface{}, error) {
+	nb := high.NewNodeBuilder(s, s.low)
+	return nb.Render(), nil
+}
@@ -75,6 +75,14 @@ type Schema struct {
 	// 3.1 only, part of the JSON Schema spec provides a way to identify a sub-schema
 	Anchor string `json:"$anchor,omitempty" yaml:"$anchor,omitempty"`
 
+	// 3.1 only, JSON Schema 2020-12 core keywords used for identification, embedded definitions and dynamic referencing.
+	Id            string                                `json:"$id,omitempty" yaml:"$id,omitempty"`
+	Defs          *orderedmap.Map[string, *SchemaProxy] `json:"$defs,omitempty" yaml:"$defs,omitempty"`
+	Comment       string                                `json:"$comment,omitempty" yaml:"$comment,omitempty"`
+	DynamicRef    string                                `json:"$dynamicRef,omitempty" yaml:"$dynamicRef,omitempty"`
+	DynamicAnchor string                                `json:"$dynamicAnchor,omitempty" yaml:"$dynamicAnchor,omitempty"`
+	Vocabulary    *orderedmap.Map[string, bool]         `json:"$vocabulary,omitempty" yaml:"$vocabulary,omitempty"`
+
 	// Compatible with all versions
 	Not                  *SchemaProxy                          `json:"not,omitempty" yaml:"not,omitempty"`
 	Properties           *orderedmap.Map[string, *SchemaProxy] `json:"properties,omitempty" yaml:"properties,omitempty"`
@@ -95,6 +103,8 @@ type Schema struct {
 	Enum                 []*yaml.Node                          `json:"enum,omitempty" yaml:"enum,omitempty"`
 	AdditionalProperties *DynamicValue[*SchemaProxy, bool]     `json:"additionalProperties,renderZero,omitempty" yaml:"additionalProperties,renderZero,omitempty"`
 	Description          string                                `json:"description,omitempty" yaml:"description,omitempty"`
+	ContentEncoding      string                                `json:"contentEncoding,omitempty" yaml:"contentEncoding,omitempty"`
+	ContentMediaType     string                                `json:"contentMediaType,omitempty" yaml:"contentMediaType,omitempty"`
 	Default              *yaml.Node                            `json:"default,omitempty" yaml:"default,renderZero,omitempty"`
 	Const                *yaml.Node                            `json:"const,omitempty" yaml:"const,renderZero,omitempty"`
 	Nullable             *bool                                 `json:"nullable,omitempty" yaml:"nullable,omitempty"`
@@ -266,6 +276,8 @@ func NewSchema(schema *base.Schema) *Schema {
 	s.AdditionalProperties = additionalProperties
 
 	s.Description = schema.Description.Value
+	s.ContentEncoding = schema.ContentEncoding.Value
+	s.ContentMediaType = schema.ContentMediaType.Value
 	s.Default = schema.Default.Value
 	s.Const = schema.Const.Value
 	if !schema.Nullable.IsEmpty() {
@@ -307,6 +319,25 @@ func NewSchema(schema *base.Schema) *Schema {
 	if !schema.Anchor.IsEmpty() {
 		s.Anchor = schema.Anchor.Value
 	}
+	if !schema.Id.IsEmpty() {
+		s.Id = schema.Id.Value
+	}
+	if !schema.Comment.IsEmpty() {
+		s.Comment = schema.Comment.Value
+	}
+	if !schema.DynamicRef.IsEmpty() {
+		s.DynamicRef = schema.DynamicRef.Value
+	}
+	if !schema.DynamicAnchor.IsEmpty() {
+		s.DynamicAnchor = schema.DynamicAnchor.Value
+	}
+	if schema.Vocabulary.Value != nil {
+		vocab := orderedmap.New[string, bool]()
+		for uri, required := range schema.Vocabulary.Value.FromOldest() {
+			vocab.Set(uri.Value, required.Value)
+		}
+		s.Vocabulary = vocab
+	}
 
 	var enum []*yaml.Node
 	for i := range schema.Enum.Value {
@@ -374,6 +405,8 @@ func NewSchema(schema *base.Schema) *Schema {
 			s.DependentSchemas = props
 		case 2:
 			s.PatternProperties = props
+		case 3:
+			s.Defs = props
 		}
 	}
 
@@ -401,6 +434,11 @@ func NewSchema(schema *base.Schema) *Schema {
 		buildProps(name, schemaProxy, patternProps, 2)
 	}
 
+	defs := orderedmap.New[string, *SchemaProxy]()
+	for name, schemaProxy := range schema.Defs.Value.FromOldest() {
+		buildProps(name, schemaProxy, defs, 3)
+	}
+
 	var allOf []*SchemaProxy
 	var oneOf []*SchemaProxy
 	var anyOf []*SchemaProxy
@@ -696,6 +696,21 @@ items: true
 	assert.True(t, highSchema.Items.B)
 }
 
+func TestSchema_ContentEncoding(t *testing.T) {
+	yml := `
+type: string
+contentEncoding: base64
+contentMediaType: image/png
+`
+	highSchema := getHighSchema(t, yml)
+
+	assert.Equal(t, "base64", highSchema.ContentEncoding)
+	assert.Equal(t, "image/png", highSchema.ContentMediaType)
+
+	rend, _ := highSchema.Render()
+	assert.Equal(t, "type: string\ncontentEncoding: base64\ncontentMediaType: image/png\n", string(rend))
+}
+
 func TestSchemaExamples(t *testing.T) {
 	yml := `
 type: number
@@ -1508,3 +1523,55 @@ dependentRequired:
 	assert.Equal(t, []string{"firstName"}, schema.DependentRequired.GetOrZero("lastName"))
 	assert.Equal(t, []string{"username"}, schema.DependentRequired.GetOrZero("email"))
 }
+
+func TestNewSchema_CoreKeywords(t *testing.T) {
+	yml := `$id: https://example.com/schemas/pet
+$comment: this is a pet
+$dynamicRef: "#meta"
+$dynamicAnchor: meta
+$vocabulary:
+  https://json-schema.org/draft/2020-12/vocab/core: true
+  https://example.com/vocab/custom: false
+$defs:
+  name:
+    type: string
+type: object
+properties:
+  name:
+    $ref: "#/$defs/name"`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var lowSchema lowbase.Schema
+	_ = lowSchema.Build(context.Background(), idxNode.Content[0], idx)
+
+	schema := NewSchema(&lowSchema)
+	assert.Equal(t, "https://example.com/schemas/pet", schema.Id)
+	assert.Equal(t, "this is a pet", schema.Comment)
+	assert.Equal(t, "#meta", schema.DynamicRef)
+	assert.Equal(t, "meta", schema.DynamicAnchor)
+	assert.Equal(t, 2, schema.Vocabulary.Len())
+	assert.True(t, schema.Vocabulary.GetOrZero("https://json-schema.org/draft/2020-12/vocab/core"))
+	assert.False(t, schema.Vocabulary.GetOrZero("https://example.com/vocab/custom"))
+	assert.Equal(t, 1, schema.Defs.Len())
+	assert.Equal(t, []string{"string"}, schema.Defs.GetOrZero("name").Schema().Type)
+
+	rendered, err := schema.Render()
+	assert.NoError(t, err)
+	assert.Equal(t, `$id: https://example.com/schemas/pet
+$comment: this is a pet
+$dynamicRef: "#meta"
+$dynamicAnchor: meta
+$vocabulary:
+    https://json-schema.org/draft/2020-12/vocab/core: true
+    https://example.com/vocab/custom: false
+$defs:
+    name:
+        type: string
+type: object
+properties:
+    name:
+        $ref: "#/$defs/name"`, strings.TrimSpace(string(rendered)))
+}
@@ -32,6 +32,7 @@ type Components struct {
 	Links           *orderedmap.Map[string, *Link]                 `json:"links,omitempty" yaml:"links,omitempty"`
 	Callbacks       *orderedmap.Map[string, *Callback]             `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
 	PathItems       *orderedmap.Map[string, *PathItem]             `json:"pathItems,omitempty" yaml:"pathItems,omitempty"`
+	MediaTypes      *orderedmap.Map[string, *MediaType]            `json:"mediaTypes,omitempty" yaml:"mediaTypes,omitempty"`
 	Extensions      *orderedmap.Map[string, *yaml.Node]            `json:"-" yaml:"-"`
 	low             *low.Components
 }
@@ -53,12 +54,13 @@ func NewComponents(comp *low.Components) *Components {
 	requestBodyMap := orderedmap.New[string, *RequestBody]()
 	headerMap := orderedmap.New[string, *Header]()
 	pathItemMap := orderedmap.New[string, *PathItem]()
+	mediaTypeMap := orderedmap.New[string, *MediaType]()
 	securitySchemeMap := orderedmap.New[string, *SecurityScheme]()
 	schemas := orderedmap.New[string, *highbase.SchemaProxy]()
 
 	// build all components asynchronously.
 	var wg sync.WaitGroup
-	wg.Add(10)
+	wg.Add(11)
 	go func() {
 		buildComponent[*low.Callback, *Callback](comp.Callbacks.Value, cbMap, NewCallback)
 		wg.Done()
@@ -91,6 +93,10 @@ func NewComponents(comp *low.Components) *Components {
 		buildComponent[*low.PathItem, *PathItem](comp.PathItems.Value, pathItemMap, NewPathItem)
 		wg.Done()
 	}()
+	go func() {
+		buildComponent[*low.MediaType, *MediaType](comp.MediaTypes.Value, mediaTypeMap, NewMediaType)
+		wg.Done()
+	}()
 	go func() {
 		buildComponent[*low.SecurityScheme, *SecurityScheme](comp.SecuritySchemes.Value, securitySchemeMap, NewSecurityScheme)
 		wg.Done()
@@ -111,6 +117,7 @@ func NewComponents(comp *low.Components) *Components {
 	c.Examples = exampleMap
 	c.SecuritySchemes = securitySchemeMap
 	c.PathItems = pathItemMap
+	c.MediaTypes = mediaTypeMap
 	return c
 }
 
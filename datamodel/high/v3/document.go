@@ -28,6 +28,11 @@ type Document struct {
 	// This is not a standard property of the OpenAPI model, it's a convenience mechanism only.
 	Version string `json:"openapi,omitempty" yaml:"openapi,omitempty"`
 
+	// Self is a 3.2+ property that declares the URI of the document, it is used as the base URI when resolving
+	// relative references found in the document.
+	// - https://spec.openapis.org/oas/v3.2.0#openapi-object
+	Self string `json:"$self,omitempty" yaml:"$self,omitempty"`
+
 	// Info represents a specification Info definitions
 	// Provides metadata about the API. The metadata MAY be used by tooling as required.
 	// - https://spec.openapis.org/oas/v3.1.0#info-object
@@ -109,6 +114,9 @@ func NewDocument(document *lowv3.Document) *Document {
 	if !document.Version.IsEmpty() {
 		d.Version = document.Version.Value
 	}
+	if !document.Self.IsEmpty() {
+		d.Self = document.Self.Value
+	}
 	var servers []*Server
 	for _, ser := range document.Servers.Value {
 		servers = append(servers, NewServer(ser.Value))
@@ -5,6 +5,7 @@ package v3
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"log/slog"
 	"net/http"
@@ -879,3 +880,83 @@ components:
 
 	assert.Equal(t, desired, strings.TrimSpace(string(r)))
 }
+
+func TestDocument_Self(t *testing.T) {
+	spec := `openapi: 3.2.0
+$self: https://api.example.com/specs/openapi.yaml
+info:
+  title: self
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: 'schemas/pet.yaml'`
+
+	info, _ := datamodel.ExtractSpecInfo([]byte(spec))
+
+	var requested []string
+	config := datamodel.NewDocumentConfiguration()
+	config.AllowRemoteReferences = true
+	config.RemoteURLHandler = func(url string) (*http.Response, error) {
+		requested = append(requested, url)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("type: object\ndescription: a pet")),
+		}, nil
+	}
+
+	lDoc, err := lowv3.CreateDocumentFromConfig(info, config)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://api.example.com/specs/schemas/pet.yaml"}, requested)
+	assert.Equal(t, "https://api.example.com/specs/openapi.yaml", lDoc.Index.GetSpecAbsolutePath())
+
+	d := NewDocument(lDoc)
+	assert.Equal(t, "https://api.example.com/specs/openapi.yaml", d.Self)
+
+	mt := d.Paths.PathItems.GetOrZero("/pets").Get.Responses.Codes.GetOrZero("200").Content.GetOrZero("application/json")
+	assert.Equal(t, "a pet", mt.Schema.Schema().Description)
+
+	rend, _ := d.Render()
+	assert.Contains(t, string(rend), "$self: https://api.example.com/specs/openapi.yaml")
+}
+
+func TestDocument_ComponentsMediaTypes(t *testing.T) {
+	spec := `openapi: 3.2.0
+info:
+  title: media types
+  version: 1.0.0
+paths:
+  /events:
+    get:
+      responses:
+        "200":
+          description: OK
+          content:
+            text/event-stream:
+              $ref: '#/components/mediaTypes/EventStream'
+components:
+  mediaTypes:
+    EventStream:
+      itemSchema:
+        type: object
+        description: an event`
+
+	info, _ := datamodel.ExtractSpecInfo([]byte(spec))
+	lDoc, err := lowv3.CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	assert.NoError(t, err)
+
+	d := NewDocument(lDoc)
+	assert.Equal(t, 1, d.Components.MediaTypes.Len())
+	assert.Equal(t, "an event", d.Components.MediaTypes.GetOrZero("EventStream").ItemSchema.Schema().Description)
+
+	mt := d.Paths.PathItems.GetOrZero("/events").Get.Responses.Codes.GetOrZero("200").Content.GetOrZero("text/event-stream")
+	assert.Equal(t, "an event", mt.ItemSchema.Schema().Description)
+	assert.True(t, mt.GoLow().IsReference())
+	assert.Equal(t, "#/components/mediaTypes/EventStream", mt.GoLow().GetReference())
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/pb33f/libopenapi/datamodel/low"
 	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
 	"github.com/pb33f/libopenapi/index"
@@ -189,3 +190,54 @@ func TestMediaType_Examples_NotFromSchema(t *testing.T) {
 
 	assert.Equal(t, 0, orderedmap.Len(r.Examples))
 }
+
+func TestMediaType_Streaming(t *testing.T) {
+	yml := `itemSchema:
+    type: object
+    properties:
+        event:
+            type: string
+prefixEncoding:
+    - contentType: application/json
+    - contentType: image/png
+itemEncoding:
+    contentType: text/plain`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndexWithConfig(&idxNode, index.CreateOpenAPIIndexConfig())
+
+	var n v3.MediaType
+	_ = low.BuildModel(idxNode.Content[0], &n)
+	_ = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+
+	r := NewMediaType(&n)
+
+	assert.Nil(t, r.Schema)
+	assert.Equal(t, []string{"object"}, r.ItemSchema.Schema().Type)
+	assert.Len(t, r.PrefixEncoding, 2)
+	assert.Equal(t, "image/png", r.PrefixEncoding[1].ContentType)
+	assert.Equal(t, "text/plain", r.ItemEncoding.ContentType)
+	assert.Equal(t, "text/plain", r.ItemEncoding.GoLow().ContentType.Value)
+
+	rend, _ := r.Render()
+	assert.Equal(t, yml, strings.TrimSpace(string(rend)))
+}
+
+func TestMediaType_Streaming_Create(t *testing.T) {
+	mt := &MediaType{
+		ItemSchema: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}),
+		PrefixEncoding: []*Encoding{
+			{ContentType: "application/json"},
+		},
+		ItemEncoding: &Encoding{ContentType: "text/plain"},
+	}
+
+	rend, _ := mt.Render()
+	assert.Equal(t, `itemSchema:
+    type: string
+prefixEncoding:
+    - contentType: application/json
+itemEncoding:
+    contentType: text/plain`, strings.TrimSpace(string(rend)))
+}
@@ -13,12 +13,13 @@ import (
 // OAuthFlows represents a high-level OpenAPI 3+ OAuthFlows object that is backed by a low-level one.
 //   - https://spec.openapis.org/oas/v3.1.0#oauth-flows-object
 type OAuthFlows struct {
-	Implicit          *OAuthFlow                          `json:"implicit,omitempty" yaml:"implicit,omitempty"`
-	Password          *OAuthFlow                          `json:"password,omitempty" yaml:"password,omitempty"`
-	ClientCredentials *OAuthFlow                          `json:"clientCredentials,omitempty" yaml:"clientCredentials,omitempty"`
-	AuthorizationCode *OAuthFlow                          `json:"authorizationCode,omitempty" yaml:"authorizationCode,omitempty"`
-	Extensions        *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
-	low               *low.OAuthFlows
+	Implicit            *OAuthFlow                          `json:"implicit,omitempty" yaml:"implicit,omitempty"`
+	Password            *OAuthFlow                          `json:"password,omitempty" yaml:"password,omitempty"`
+	ClientCredentials   *OAuthFlow                          `json:"clientCredentials,omitempty" yaml:"clientCredentials,omitempty"`
+	AuthorizationCode   *OAuthFlow                          `json:"authorizationCode,omitempty" yaml:"authorizationCode,omitempty"`
+	DeviceAuthorization *OAuthFlow                          `json:"deviceAuthorization,omitempty" yaml:"deviceAuthorization,omitempty"`
+	Extensions          *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low                 *low.OAuthFlows
 }
 
 // NewOAuthFlows creates a new high-level OAuthFlows instance from a low-level one.
@@ -37,6 +38,9 @@ func NewOAuthFlows(flows *low.OAuthFlows) *OAuthFlows {
 	if !flows.AuthorizationCode.IsEmpty() {
 		o.AuthorizationCode = NewOAuthFlow(flows.AuthorizationCode.Value)
 	}
+	if !flows.DeviceAuthorization.IsEmpty() {
+		o.DeviceAuthorization = NewOAuthFlow(flows.DeviceAuthorization.Value)
+	}
 	if !flows.Implicit.IsEmpty() {
 		o.Implicit = NewOAuthFlow(flows.Implicit.Value)
 	}
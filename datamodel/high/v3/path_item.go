@@ -23,6 +23,7 @@ const (
 	head
 	patch
 	trace
+	query
 )
 
 // PathItem represents a high-level OpenAPI 3+ PathItem object backed by a low-level one.
@@ -30,22 +31,27 @@ const (
 // Describes the operations available on a single path. A Path Item MAY be empty, due to ACL constraints.
 // The path itself is still exposed to the documentation viewer but they will not know which operations and parameters
 // are available.
+//
+// OpenAPI 3.2 adds the 'query' method and an 'additionalOperations' map for any other HTTP method.
 //   - https://spec.openapis.org/oas/v3.1.0#path-item-object
+//   - https://spec.openapis.org/oas/v3.2.0#path-item-object
 type PathItem struct {
-	Description string                              `json:"description,omitempty" yaml:"description,omitempty"`
-	Summary     string                              `json:"summary,omitempty" yaml:"summary,omitempty"`
-	Get         *Operation                          `json:"get,omitempty" yaml:"get,omitempty"`
-	Put         *Operation                          `json:"put,omitempty" yaml:"put,omitempty"`
-	Post        *Operation                          `json:"post,omitempty" yaml:"post,omitempty"`
-	Delete      *Operation                          `json:"delete,omitempty" yaml:"delete,omitempty"`
-	Options     *Operation                          `json:"options,omitempty" yaml:"options,omitempty"`
-	Head        *Operation                          `json:"head,omitempty" yaml:"head,omitempty"`
-	Patch       *Operation                          `json:"patch,omitempty" yaml:"patch,omitempty"`
-	Trace       *Operation                          `json:"trace,omitempty" yaml:"trace,omitempty"`
-	Servers     []*Server                           `json:"servers,omitempty" yaml:"servers,omitempty"`
-	Parameters  []*Parameter                        `json:"parameters,omitempty" yaml:"parameters,omitempty"`
-	Extensions  *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
-	low         *lowV3.PathItem
+	Description          string                              `json:"description,omitempty" yaml:"description,omitempty"`
+	Summary              string                              `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Get                  *Operation                          `json:"get,omitempty" yaml:"get,omitempty"`
+	Put                  *Operation                          `json:"put,omitempty" yaml:"put,omitempty"`
+	Post                 *Operation                          `json:"post,omitempty" yaml:"post,omitempty"`
+	Delete               *Operation                          `json:"delete,omitempty" yaml:"delete,omitempty"`
+	Options              *Operation                          `json:"options,omitempty" yaml:"options,omitempty"`
+	Head                 *Operation                          `json:"head,omitempty" yaml:"head,omitempty"`
+	Patch                *Operation                          `json:"patch,omitempty" yaml:"patch,omitempty"`
+	Trace                *Operation                          `json:"trace,omitempty" yaml:"trace,omitempty"`
+	Query                *Operation                          `json:"query,omitempty" yaml:"query,omitempty"`
+	AdditionalOperations *orderedmap.Map[string, *Operation] `json:"additionalOperations,omitempty" yaml:"additionalOperations,omitempty"`
+	Servers              []*Server                           `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Parameters           []*Parameter                        `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Extensions           *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+	low                  *lowV3.PathItem
 }
 
 // NewPathItem creates a new high-level PathItem instance from a low-level one.
@@ -83,6 +89,11 @@ func NewPathItem(pathItem *lowV3.PathItem) *PathItem {
 	go buildOperation(head, pathItem.Head.Value, opChan)
 	go buildOperation(patch, pathItem.Patch.Value, opChan)
 	go buildOperation(trace, pathItem.Trace.Value, opChan)
+	go buildOperation(query, pathItem.Query.Value, opChan)
+
+	if !pathItem.AdditionalOperations.IsEmpty() {
+		pi.AdditionalOperations = low.FromReferenceMapWithFunc(pathItem.AdditionalOperations.Value, NewOperation)
+	}
 
 	if !pathItem.Parameters.IsEmpty() {
 		params := make([]*Parameter, len(pathItem.Parameters.Value))
@@ -113,10 +124,12 @@ func NewPathItem(pathItem *lowV3.PathItem) *PathItem {
 			pi.Patch = opRes.op
 		case trace:
 			pi.Trace = opRes.op
+		case query:
+			pi.Query = opRes.op
 		}
 
 		opCount++
-		if opCount == 8 {
+		if opCount == 9 {
 			complete = true
 		}
 	}
@@ -133,6 +146,9 @@ func (p *PathItem) GoLowUntyped() any {
 	return p.low
 }
 
+// GetOperations returns an ordered map of all operations defined on the PathItem, keyed by HTTP method. Operations
+// defined under 'additionalOperations' are included, keyed by their declared method name. Operations are ordered
+// by the line they appear on in the source document.
 func (p *PathItem) GetOperations() *orderedmap.Map[string, *Operation] {
 	o := orderedmap.New[string, *Operation]()
 
@@ -160,28 +176,40 @@ func (p *PathItem) GetOperations() *orderedmap.Map[string, *Operation] {
 	ops := []op{}
 
 	if p.Get != nil {
-		ops = append(ops, op{name: lowV3.GetLabel, op: p.Get, line: getLine("Get", -8)})
+		ops = append(ops, op{name: lowV3.GetLabel, op: p.Get, line: getLine("Get", -9)})
 	}
 	if p.Put != nil {
-		ops = append(ops, op{name: lowV3.PutLabel, op: p.Put, line: getLine("Put", -7)})
+		ops = append(ops, op{name: lowV3.PutLabel, op: p.Put, line: getLine("Put", -8)})
 	}
 	if p.Post != nil {
-		ops = append(ops, op{name: lowV3.PostLabel, op: p.Post, line: getLine("Post", -6)})
+		ops = append(ops, op{name: lowV3.PostLabel, op: p.Post, line: getLine("Post", -7)})
 	}
 	if p.Delete != nil {
-		ops = append(ops, op{name: lowV3.DeleteLabel, op: p.Delete, line: getLine("Delete", -5)})
+		ops = append(ops, op{name: lowV3.DeleteLabel, op: p.Delete, line: getLine("Delete", -6)})
 	}
 	if p.Options != nil {
-		ops = append(ops, op{name: lowV3.OptionsLabel, op: p.Options, line: getLine("Options", -4)})
+		ops = append(ops, op{name: lowV3.OptionsLabel, op: p.Options, line: getLine("Options", -5)})
 	}
 	if p.Head != nil {
-		ops = append(ops, op{name: lowV3.HeadLabel, op: p.Head, line: getLine("Head", -3)})
+		ops = append(ops, op{name: lowV3.HeadLabel, op: p.Head, line: getLine("Head", -4)})
 	}
 	if p.Patch != nil {
-		ops = append(ops, op{name: lowV3.PatchLabel, op: p.Patch, line: getLine("Patch", -2)})
+		ops = append(ops, op{name: lowV3.PatchLabel, op: p.Patch, line: getLine("Patch", -3)})
 	}
 	if p.Trace != nil {
-		ops = append(ops, op{name: lowV3.TraceLabel, op: p.Trace, line: getLine("Trace", -1)})
+		ops = append(ops, op{name: lowV3.TraceLabel, op: p.Trace, line: getLine("Trace", -2)})
+	}
+	if p.Query != nil {
+		ops = append(ops, op{name: lowV3.QueryLabel, op: p.Query, line: getLine("Query", -1)})
+	}
+	addIdx := 0
+	for method, addOp := range p.AdditionalOperations.FromOldest() {
+		line := addIdx
+		if addOp.GoLow() != nil && addOp.GoLow().KeyNode != nil {
+			line = addOp.GoLow().KeyNode.Line
+		}
+		ops = append(ops, op{name: method, op: addOp, line: line})
+		addIdx++
 	}
 
 	slices.SortStableFunc(ops, func(a op, b op) int {
@@ -11,6 +11,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/low"
 	lowV3 "github.com/pb33f/libopenapi/datamodel/low/v3"
 	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
 )
@@ -195,3 +196,67 @@ func TestPathItem_GetOperations_LowWithUnsetOperations(t *testing.T) {
 
 	assert.Equal(t, expectedOrderOfOps, actualOrder)
 }
+
+func TestPathItem_QueryAndAdditionalOperations(t *testing.T) {
+	yml := `get:
+  description: get
+query:
+  description: query
+additionalOperations:
+  LINK:
+    description: link
+  COPY:
+    description: copy
+`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n lowV3.PathItem
+	_ = low.BuildModel(&idxNode, &n)
+	_ = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+
+	r := NewPathItem(&n)
+
+	assert.Equal(t, "query", r.Query.Description)
+	assert.Equal(t, 2, r.AdditionalOperations.Len())
+	assert.Equal(t, "link", r.AdditionalOperations.GetOrZero("LINK").Description)
+	assert.Equal(t, 4, r.GetOperations().Len())
+
+	expectedOrder := []string{"get", "query", "LINK", "COPY"}
+	i := 0
+	for k := range r.GetOperations().KeysFromOldest() {
+		assert.Equal(t, expectedOrder[i], k)
+		i++
+	}
+
+	rend, _ := r.Render()
+	assert.Equal(t, strings.TrimSpace(yml), strings.TrimSpace(strings.ReplaceAll(string(rend), "    ", "  ")))
+}
+
+func TestPathItem_MarshalYAML_QueryAndAdditionalOperations(t *testing.T) {
+	pi := &PathItem{
+		Get: &Operation{
+			Description: "a get operation",
+		},
+		Query: &Operation{
+			Description: "a query operation",
+		},
+		AdditionalOperations: orderedmap.ToOrderedMap(map[string]*Operation{
+			"PURGE": {Description: "a purge operation"},
+		}),
+	}
+
+	rend, _ := pi.Render()
+
+	desired := `get:
+    description: a get operation
+query:
+    description: a query operation
+additionalOperations:
+    PURGE:
+        description: a purge operation`
+
+	assert.Equal(t, desired, strings.TrimSpace(string(rend)))
+}
@@ -46,3 +46,32 @@ scheme: https`
 
 	assert.Equal(t, desired, strings.TrimSpace(string(dat)))
 }
+
+func TestSecurityScheme_MarshalYAML_OAuth2Metadata(t *testing.T) {
+	yml := `type: oauth2
+description: device flow
+flows:
+    deviceAuthorization:
+        deviceAuthorizationUrl: https://pb33f.io/oauth/device
+        tokenUrl: https://pb33f.io/oauth/token
+        scopes:
+            read:burgers: read all the burgers
+oauth2MetadataUrl: https://pb33f.io/.well-known/oauth-authorization-server
+deprecated: true`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndexWithConfig(&idxNode, index.CreateOpenAPIIndexConfig())
+
+	var n v3.SecurityScheme
+	_ = low.BuildModel(idxNode.Content[0], &n)
+	_ = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+
+	r := NewSecurityScheme(&n)
+	assert.Equal(t, "https://pb33f.io/.well-known/oauth-authorization-server", r.OAuth2MetadataUrl)
+	assert.True(t, r.Deprecated)
+	assert.Equal(t, "https://pb33f.io/oauth/device", r.Flows.DeviceAuthorization.DeviceAuthorizationUrl)
+
+	dat, _ := r.Render()
+	assert.Equal(t, yml, strings.TrimSpace(string(dat)))
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package arazzo represents all Arazzo 1.0 low-level models. Arazzo documents describe workflows, sequences of
+// calls to operations defined in OpenAPI documents (the source descriptions of the workflow).
+//
+// Arazzo objects do not use JSON references, components are referenced using a Reusable object, which has a
+// 'reference' property containing a runtime expression. Parameters and actions capture the reference in a
+// Reference property, and never report themselves as a JSON reference. The only exception is the JSON Schemas
+// used to describe workflow inputs, they are base.SchemaProxy instances and can use references.
+//
+// Every property is wrapped in a NodeReference or a KeyReference or a ValueReference.
+//   - https://spec.openapis.org/arazzo/v1.0.1
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// Arazzo represents a low-level Arazzo document.
+//   - https://spec.openapis.org/arazzo/v1.0.1#arazzo-specification-object
+type Arazzo struct {
+	// Arazzo is the version of the Arazzo Specification the document uses, extracted from 'arazzo: x.x.x'.
+	Arazzo low.NodeReference[string]
+
+	// Info provides metadata about the workflows in the document.
+	Info low.NodeReference[*Info]
+
+	// SourceDescriptions are the OpenAPI and Arazzo documents used by the workflows.
+	SourceDescriptions low.NodeReference[[]low.ValueReference[*SourceDescription]]
+
+	// Workflows are the workflows defined by the document.
+	Workflows low.NodeReference[[]low.ValueReference[*Workflow]]
+
+	// Components holds reusable inputs, parameters and actions.
+	Components low.NodeReference[*Components]
+
+	// Extensions contains all custom extensions defined for the top-level document.
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+
+	// Index is a reference to the *index.SpecIndex that was created for the document, it is used to resolve
+	// references in the JSON Schemas of the document.
+	//
+	// This property is not a part of the Arazzo schema, this is custom to libopenapi.
+	Index *index.SpecIndex
+
+	// Rolodex is a reference to the rolodex used when creating this document, it is used to load the source
+	// descriptions of the document.
+	Rolodex *index.Rolodex
+
+	low.NodeMap
+}
+
+// FindSourceDescription attempts to locate a SourceDescription using the supplied name.
+func (a *Arazzo) FindSourceDescription(name string) *SourceDescription {
+	for _, s := range a.SourceDescriptions.Value {
+		if s.Value.Name.Value == name {
+			return s.Value
+		}
+	}
+	return nil
+}
+
+// FindWorkflow attempts to locate a Workflow using the supplied workflowId.
+func (a *Arazzo) FindWorkflow(workflowId string) *Workflow {
+	for _, w := range a.Workflows.Value {
+		if w.Value.WorkflowId.Value == workflowId {
+			return w.Value
+		}
+	}
+	return nil
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the Arazzo document.
+func (a *Arazzo) GetIndex() *index.SpecIndex {
+	return a.Index
+}
+
+// GetExtensions returns all Arazzo extensions and satisfies the low.HasExtensions interface.
+func (a *Arazzo) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return a.Extensions
+}
+
+// Hash will return a consistent SHA256 Hash of the Arazzo document
+func (a *Arazzo) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	if !a.Arazzo.IsEmpty() {
+		sb.WriteString(a.Arazzo.Value)
+		sb.WriteByte('|')
+	}
+	if !a.Info.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(a.Info.Value))
+		sb.WriteByte('|')
+	}
+	for _, s := range a.SourceDescriptions.Value {
+		sb.WriteString(low.GenerateHashString(s.Value))
+		sb.WriteByte('|')
+	}
+	for _, w := range a.Workflows.Value {
+		sb.WriteString(low.GenerateHashString(w.Value))
+		sb.WriteByte('|')
+	}
+	if !a.Components.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(a.Components.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(a.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
+
+// extractArray extracts an array of objects using a label, the label node is added to the supplied node map.
+func extractArray[T low.Buildable[N], N any](ctx context.Context, label string, root *yaml.Node,
+	idx *index.SpecIndex, nodes *sync.Map,
+) (low.NodeReference[[]low.ValueReference[T]], error) {
+	items, ln, vn, err := low.ExtractArray[T](ctx, label, root, idx)
+	if err != nil || ln == nil {
+		return low.NodeReference[[]low.ValueReference[T]]{}, err
+	}
+	nodes.Store(ln.Line, ln)
+	return low.NodeReference[[]low.ValueReference[T]]{Value: items, KeyNode: ln, ValueNode: vn}, nil
+}
+
+// extractMap extracts a map of objects using a label, the label node is added to the supplied node map.
+func extractMap[T low.Buildable[N], N any](ctx context.Context, label string, root *yaml.Node,
+	idx *index.SpecIndex, nodes *sync.Map,
+) (low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[T]]], error) {
+	items, ln, vn, err := low.ExtractMap[T](ctx, label, root, idx)
+	if err != nil || ln == nil {
+		return low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[T]]]{}, err
+	}
+	nodes.Store(ln.Line, ln)
+	return low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[T]]]{
+		Value: items, KeyNode: ln, ValueNode: vn,
+	}, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Components represents a low-level Arazzo Components object, it holds reusable inputs, parameters and actions.
+// Components are referenced using runtime expressions, for example '$components.parameters.page'.
+//   - https://spec.openapis.org/arazzo/v1.0.1#components-object
+type Components struct {
+	Inputs         low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*base.SchemaProxy]]]
+	Parameters     low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*Parameter]]]
+	SuccessActions low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*SuccessAction]]]
+	FailureActions low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*FailureAction]]]
+	Extensions     *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode        *yaml.Node
+	RootNode       *yaml.Node
+	index          *index.SpecIndex
+	context        context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// FindInput attempts to locate an input schema using the supplied name.
+func (c *Components) FindInput(name string) *low.ValueReference[*base.SchemaProxy] {
+	return low.FindItemInOrderedMap[*base.SchemaProxy](name, c.Inputs.Value)
+}
+
+// FindParameter attempts to locate a Parameter using the supplied name.
+func (c *Components) FindParameter(name string) *low.ValueReference[*Parameter] {
+	return low.FindItemInOrderedMap[*Parameter](name, c.Parameters.Value)
+}
+
+// FindSuccessAction attempts to locate a SuccessAction using the supplied name.
+func (c *Components) FindSuccessAction(name string) *low.ValueReference[*SuccessAction] {
+	return low.FindItemInOrderedMap[*SuccessAction](name, c.SuccessActions.Value)
+}
+
+// FindFailureAction attempts to locate a FailureAction using the supplied name.
+func (c *Components) FindFailureAction(name string) *low.ValueReference[*FailureAction] {
+	return low.FindItemInOrderedMap[*FailureAction](name, c.FailureActions.Value)
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the Components object.
+func (c *Components) GetIndex() *index.SpecIndex {
+	return c.index
+}
+
+// GetContext returns the context.Context instance used when building the Components object.
+func (c *Components) GetContext() context.Context {
+	return c.context
+}
+
+// GetRootNode returns the root yaml node of the Components object.
+func (c *Components) GetRootNode() *yaml.Node {
+	return c.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the Components object.
+func (c *Components) GetKeyNode() *yaml.Node {
+	return c.KeyNode
+}
+
+// GetExtensions returns all Components extensions and satisfies the low.HasExtensions interface.
+func (c *Components) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return c.Extensions
+}
+
+// Build will extract inputs, parameters and actions from the supplied node.
+func (c *Components) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	c.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	c.RootNode = root
+	utils.CheckForMergeNodes(root)
+	c.Reference = new(low.Reference)
+	c.Nodes = low.ExtractNodes(ctx, root)
+	c.Extensions = low.ExtractExtensions(root)
+	c.index = idx
+	c.context = ctx
+	low.ExtractExtensionNodes(ctx, c.Extensions, c.Nodes)
+
+	inputs, err := extractMap[*base.SchemaProxy](ctx, InputsLabel, root, idx, c.Nodes)
+	if err != nil {
+		return err
+	}
+	c.Inputs = inputs
+
+	params, err := extractMap[*Parameter](ctx, ParametersLabel, root, idx, c.Nodes)
+	if err != nil {
+		return err
+	}
+	c.Parameters = params
+
+	successActions, err := extractMap[*SuccessAction](ctx, SuccessActionsLabel, root, idx, c.Nodes)
+	if err != nil {
+		return err
+	}
+	c.SuccessActions = successActions
+
+	failureActions, err := extractMap[*FailureAction](ctx, FailureActionsLabel, root, idx, c.Nodes)
+	if err != nil {
+		return err
+	}
+	c.FailureActions = failureActions
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the Components object
+func (c *Components) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, h := range low.AppendMapHashes(nil, c.Inputs.Value) {
+		sb.WriteString(h)
+		sb.WriteByte('|')
+	}
+	for _, h := range low.AppendMapHashes(nil, c.Parameters.Value) {
+		sb.WriteString(h)
+		sb.WriteByte('|')
+	}
+	for _, h := range low.AppendMapHashes(nil, c.SuccessActions.Value) {
+		sb.WriteString(h)
+		sb.WriteByte('|')
+	}
+	for _, h := range low.AppendMapHashes(nil, c.FailureActions.Value) {
+		sb.WriteString(h)
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(c.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+// Label definitions used to look up vales in yaml.Node tree.
+const (
+	ArazzoLabel             = "arazzo"
+	InfoLabel               = "info"
+	SourceDescriptionsLabel = "sourceDescriptions"
+	WorkflowsLabel          = "workflows"
+	ComponentsLabel         = "components"
+	StepsLabel              = "steps"
+	InputsLabel             = "inputs"
+	ParametersLabel         = "parameters"
+	SuccessActionsLabel     = "successActions"
+	FailureActionsLabel     = "failureActions"
+	SuccessCriteriaLabel    = "successCriteria"
+	OnSuccessLabel          = "onSuccess"
+	OnFailureLabel          = "onFailure"
+	CriteriaLabel           = "criteria"
+	RequestBodyLabel        = "requestBody"
+	ReplacementsLabel       = "replacements"
+	TypeLabel               = "type"
+)
@@ -0,0 +1,116 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
+)
+
+// CreateDocumentFromConfig creates a new Arazzo document from the provided SpecInfo and DocumentConfiguration.
+//
+// A rolodex is created for the document, using the same rules as an OpenAPI document. File and remote file systems
+// are only added when the configuration allows them, they are used to resolve references in JSON Schemas, and to
+// load source descriptions.
+func CreateDocumentFromConfig(info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration) (*Arazzo, error) {
+	if info == nil || info.RootNode == nil || len(info.RootNode.Content) == 0 {
+		return nil, errors.New("no arazzo document found, cannot create document")
+	}
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+	root := info.RootNode.Content[0]
+	_, labelNode, versionNode := utils.FindKeyNodeFullTop(ArazzoLabel, root.Content)
+	if versionNode == nil {
+		return nil, errors.New("no arazzo version found, cannot create document")
+	}
+	doc := &Arazzo{
+		Arazzo: low.NodeReference[string]{Value: versionNode.Value, KeyNode: labelNode, ValueNode: versionNode},
+	}
+	doc.Nodes = low.ExtractNodes(nil, root)
+
+	idxConfig := index.CreateClosedAPIIndexConfig()
+	idxConfig.SpecInfo = info
+	idxConfig.BaseURL = config.BaseURL
+	idxConfig.BasePath = config.BasePath
+	idxConfig.SpecFilePath = config.SpecFilePath
+	idxConfig.Logger = config.Logger
+	idxConfig.AvoidCircularReferenceCheck = true
+	rolodex := index.NewRolodex(idxConfig)
+	rolodex.SetRootNode(info.RootNode)
+	doc.Rolodex = rolodex
+
+	if idxConfig.BasePath != "" || config.AllowFileReferences {
+		cwd, _ := filepath.Abs(config.BasePath)
+		if config.LocalFS != nil {
+			rolodex.AddLocalFS(cwd, config.LocalFS)
+		} else {
+			fileFS, _ := index.NewLocalFSWithConfig(&index.LocalFSConfig{
+				BaseDirectory: cwd,
+				IndexConfig:   idxConfig,
+				FileFilters:   config.FileFilter,
+			})
+			idxConfig.AllowFileLookup = true
+			rolodex.AddLocalFS(cwd, fileFS)
+		}
+	}
+	if idxConfig.BaseURL != nil || config.AllowRemoteReferences {
+		remoteFS, _ := index.NewRemoteFSWithConfig(idxConfig)
+		if config.RemoteURLHandler != nil {
+			remoteFS.RemoteHandlerFunc = config.RemoteURLHandler
+		}
+		idxConfig.AllowRemoteLookup = true
+		u := "default"
+		if config.BaseURL != nil {
+			u = config.BaseURL.String()
+		}
+		rolodex.AddRemoteFS(u, remoteFS)
+	}
+
+	var errs []error
+	_ = rolodex.IndexTheRolodex(context.Background())
+	errs = append(errs, rolodex.GetCaughtErrors()...)
+	doc.Index = rolodex.GetRootIndex()
+	idx := doc.Index
+
+	var cacheMap sync.Map
+	ctx := context.WithValue(context.Background(), "modelCtx", &base.ModelContext{SchemaCache: &cacheMap})
+
+	doc.Extensions = low.ExtractExtensions(root)
+	low.ExtractExtensionNodes(ctx, doc.Extensions, doc.Nodes)
+
+	infoRef, err := low.ExtractObject[*Info](ctx, InfoLabel, root, idx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	doc.Info = infoRef
+
+	sources, err := extractArray[*SourceDescription](ctx, SourceDescriptionsLabel, root, idx, doc.Nodes)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	doc.SourceDescriptions = sources
+
+	workflows, err := extractArray[*Workflow](ctx, WorkflowsLabel, root, idx, doc.Nodes)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	doc.Workflows = workflows
+
+	components, err := low.ExtractObject[*Components](ctx, ComponentsLabel, root, idx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	doc.Components = components
+
+	return doc, errors.Join(errs...)
+}
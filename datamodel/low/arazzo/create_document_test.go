@@ -0,0 +1,123 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadPetstoreArazzo(t *testing.T) *Arazzo {
+	data, err := os.ReadFile("../../../test_specs/petstore.arazzo.yaml")
+	require.NoError(t, err)
+	info, err := datamodel.ExtractSpecInfo(data)
+	require.NoError(t, err)
+	doc, err := CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	require.NoError(t, err)
+	return doc
+}
+
+func TestCreateDocumentFromConfig(t *testing.T) {
+	doc := loadPetstoreArazzo(t)
+
+	assert.Equal(t, "1.0.1", doc.Arazzo.Value)
+	assert.Equal(t, 1, doc.Arazzo.ValueNode.Line)
+	assert.NotNil(t, doc.GetIndex())
+	assert.NotNil(t, doc.Rolodex)
+
+	assert.Equal(t, "Petstore workflows", doc.Info.Value.Title.Value)
+	assert.Equal(t, "Workflows for adopting pets", doc.Info.Value.Summary.Value)
+	assert.Equal(t, "1.0.0", doc.Info.Value.Version.Value)
+
+	source := doc.FindSourceDescription("petstore")
+	assert.NotNil(t, source)
+	assert.Equal(t, "petstorev3.json", source.URL.Value)
+	assert.Equal(t, "openapi", source.Type.Value)
+	assert.Nil(t, doc.FindSourceDescription("nope"))
+
+	workflow := doc.FindWorkflow("adoptPet")
+	assert.NotNil(t, workflow)
+	assert.Nil(t, doc.FindWorkflow("nope"))
+	assert.Equal(t, "Adopt an available pet", workflow.Summary.Value)
+	assert.True(t, workflow.Inputs.Value.Schema().Properties.Value.Len() == 2)
+	assert.Len(t, workflow.Steps.Value, 3)
+	assert.Equal(t, "$steps.placeOrder.outputs.orderId", workflow.FindOutput("orderId").Value)
+	assert.Nil(t, workflow.FindOutput("nope"))
+	assert.Equal(t, "adoption", workflow.GetExtensions().First().Value().Value.Value)
+
+	login := workflow.FindStep("login")
+	assert.Equal(t, "loginUser", login.OperationId.Value)
+	assert.Len(t, login.Parameters.Value, 2)
+	assert.Equal(t, "query", login.Parameters.Value[0].Value.In.Value)
+	assert.Equal(t, "$inputs.username", login.Parameters.Value[0].Value.Value.Value.Value)
+	assert.Equal(t, "$response.body", login.FindOutput("token").Value)
+	assert.Nil(t, workflow.FindStep("nope"))
+
+	findPets := workflow.FindStep("findPets")
+	assert.Equal(t, "{$sourceDescriptions.petstore.url}#/paths/~1pet~1findByStatus/get", findPets.OperationPath.Value)
+	assert.True(t, findPets.Parameters.Value[0].Value.IsReusable())
+	assert.Equal(t, "$components.parameters.status", findPets.Parameters.Value[0].Value.Reference.Value)
+	assert.Equal(t, "jsonpath", findPets.SuccessCriteria.Value[0].Value.Type.Value)
+	assert.True(t, findPets.OnFailure.Value[0].Value.IsReusable())
+
+	order := workflow.FindStep("placeOrder")
+	body := order.RequestBody.Value
+	assert.Equal(t, "application/json", body.ContentType.Value)
+	assert.Equal(t, "/petId", body.Replacements.Value[0].Value.Target.Value)
+	assert.Equal(t, "$steps.findPets.outputs.petId", body.Replacements.Value[0].Value.Value.Value.Value)
+	criterion := order.SuccessCriteria.Value[1].Value
+	assert.True(t, criterion.Type.IsEmpty())
+	assert.Equal(t, "regex", criterion.ExpressionType.Value.Type.Value)
+	assert.Equal(t, "draft-2020-12", criterion.ExpressionType.Value.Version.Value)
+	assert.Equal(t, "end", order.OnSuccess.Value[0].Value.Type.Value)
+
+	components := doc.Components.Value
+	assert.NotNil(t, components.FindInput("credentials"))
+	assert.Equal(t, "available", components.FindParameter("status").Value.Value.Value.Value)
+	assert.Equal(t, "end", components.FindSuccessAction("finish").Value.Type.Value)
+	retry := components.FindFailureAction("retryLater").Value
+	assert.Equal(t, 1.5, retry.RetryAfter.Value)
+	assert.Equal(t, int64(3), retry.RetryLimit.Value)
+}
+
+func TestCreateDocumentFromConfig_Hash(t *testing.T) {
+	a := loadPetstoreArazzo(t)
+	b := loadPetstoreArazzo(t)
+	assert.Equal(t, a.Hash(), b.Hash())
+
+	wa, wb := a.FindWorkflow("adoptPet"), b.FindWorkflow("adoptPet")
+	assert.Equal(t, wa.Hash(), wb.Hash())
+	assert.Equal(t, a.Components.Value.Hash(), b.Components.Value.Hash())
+
+	wb.Summary.Value = "changed"
+	assert.NotEqual(t, wa.Hash(), wb.Hash())
+}
+
+func TestCreateDocumentFromConfig_NoVersion(t *testing.T) {
+	info, _ := datamodel.ExtractSpecInfoWithDocumentCheck([]byte(`info:
+  title: nope`), true)
+	_, err := CreateDocumentFromConfig(info, nil)
+	assert.Equal(t, "no arazzo version found, cannot create document", err.Error())
+}
+
+func TestCreateDocumentFromConfig_NoDocument(t *testing.T) {
+	_, err := CreateDocumentFromConfig(nil, nil)
+	assert.Equal(t, "no arazzo document found, cannot create document", err.Error())
+}
+
+func TestCreateDocumentFromConfig_BadWorkflow(t *testing.T) {
+	yml := `arazzo: 1.0.1
+workflows:
+  - workflowId: broken
+    inputs:
+      $ref: '#/does/not/exist'`
+	info, _ := datamodel.ExtractSpecInfo([]byte(yml))
+	doc, err := CreateDocumentFromConfig(info, nil)
+	assert.Error(t, err)
+	assert.NotNil(t, doc)
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Criterion represents a low-level Arazzo Criterion object, a condition used to determine the success of a step,
+// or whether an action should be taken.
+//
+// The type of a criterion is either a string (simple, regex, jsonpath or xpath), or a Criterion Expression Type
+// object. A string is captured by Type, an object is captured by ExpressionType.
+//   - https://spec.openapis.org/arazzo/v1.0.1#criterion-object
+type Criterion struct {
+	Context        low.NodeReference[string]
+	Condition      low.NodeReference[string]
+	Type           low.NodeReference[string]
+	ExpressionType low.NodeReference[*CriterionExpressionType]
+	Extensions     *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode        *yaml.Node
+	RootNode       *yaml.Node
+	index          *index.SpecIndex
+	ctx            context.Context // 'context' is a property of a criterion.
+	*low.Reference
+	low.NodeMap
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the Criterion object.
+func (c *Criterion) GetIndex() *index.SpecIndex {
+	return c.index
+}
+
+// GetContext returns the context.Context instance used when building the Criterion object.
+func (c *Criterion) GetContext() context.Context {
+	return c.ctx
+}
+
+// GetRootNode returns the root yaml node of the Criterion object.
+func (c *Criterion) GetRootNode() *yaml.Node {
+	return c.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the Criterion object.
+func (c *Criterion) GetKeyNode() *yaml.Node {
+	return c.KeyNode
+}
+
+// GetExtensions returns all Criterion extensions and satisfies the low.HasExtensions interface.
+func (c *Criterion) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return c.Extensions
+}
+
+// Build will extract the expression type of the criterion, if the type is an object.
+func (c *Criterion) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	c.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	c.RootNode = root
+	utils.CheckForMergeNodes(root)
+	c.Reference = new(low.Reference)
+	c.Nodes = low.ExtractNodes(ctx, root)
+	c.Extensions = low.ExtractExtensions(root)
+	c.index = idx
+	c.ctx = ctx
+	low.ExtractExtensionNodes(ctx, c.Extensions, c.Nodes)
+
+	_, tl, tn := utils.FindKeyNodeFullTop(TypeLabel, root.Content)
+	if tn != nil && utils.IsNodeMap(tn) {
+		c.Type = low.NodeReference[string]{}
+		et, err := low.ExtractObject[*CriterionExpressionType](ctx, TypeLabel, root, idx)
+		if err != nil {
+			return err
+		}
+		c.ExpressionType = et
+		c.Nodes.Store(tl.Line, tl)
+	}
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the Criterion object
+func (c *Criterion) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{c.Context, c.Condition, c.Type} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	if !c.ExpressionType.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(c.ExpressionType.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(c.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
+
+// CriterionExpressionType represents a low-level Arazzo Criterion Expression Type object, it describes the type
+// and version of an expression used by a criterion.
+//   - https://spec.openapis.org/arazzo/v1.0.1#criterion-expression-type-object
+type CriterionExpressionType struct {
+	Type       low.NodeReference[string]
+	Version    low.NodeReference[string]
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode    *yaml.Node
+	RootNode   *yaml.Node
+	index      *index.SpecIndex
+	context    context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the CriterionExpressionType object.
+func (c *CriterionExpressionType) GetIndex() *index.SpecIndex {
+	return c.index
+}
+
+// GetContext returns the context.Context instance used when building the CriterionExpressionType object.
+func (c *CriterionExpressionType) GetContext() context.Context {
+	return c.context
+}
+
+// GetRootNode returns the root yaml node of the CriterionExpressionType object.
+func (c *CriterionExpressionType) GetRootNode() *yaml.Node {
+	return c.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the CriterionExpressionType object.
+func (c *CriterionExpressionType) GetKeyNode() *yaml.Node {
+	return c.KeyNode
+}
+
+// GetExtensions returns all CriterionExpressionType extensions and satisfies the low.HasExtensions interface.
+func (c *CriterionExpressionType) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return c.Extensions
+}
+
+// Build will extract extensions from the supplied node.
+func (c *CriterionExpressionType) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	c.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	c.RootNode = root
+	utils.CheckForMergeNodes(root)
+	c.Reference = new(low.Reference)
+	c.Nodes = low.ExtractNodes(ctx, root)
+	c.Extensions = low.ExtractExtensions(root)
+	c.index = idx
+	c.context = ctx
+	low.ExtractExtensionNodes(ctx, c.Extensions, c.Nodes)
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the CriterionExpressionType object
+func (c *CriterionExpressionType) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{c.Type, c.Version} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	for _, ext := range low.HashExtensions(c.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func buildCriterion(t *testing.T, yml string) *Criterion {
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &node)
+
+	var c Criterion
+	err := low.BuildModel(node.Content[0], &c)
+	assert.NoError(t, err)
+	err = c.Build(context.Background(), nil, node.Content[0], nil)
+	assert.NoError(t, err)
+	return &c
+}
+
+func TestCriterion_Build(t *testing.T) {
+	c := buildCriterion(t, `context: $response.body
+condition: $.id
+type: jsonpath
+x-pizza: cake`)
+
+	assert.Equal(t, "$response.body", c.Context.Value)
+	assert.Equal(t, "$.id", c.Condition.Value)
+	assert.Equal(t, "jsonpath", c.Type.Value)
+	assert.True(t, c.ExpressionType.IsEmpty())
+	assert.Equal(t, "cake", c.GetExtensions().First().Value().Value.Value)
+	assert.NotNil(t, c.GetRootNode())
+	assert.Nil(t, c.GetKeyNode())
+	assert.Nil(t, c.GetIndex())
+	assert.NotNil(t, c.GetContext())
+	assert.False(t, c.IsReference())
+}
+
+func TestCriterion_Build_ExpressionType(t *testing.T) {
+	c := buildCriterion(t, `condition: //id
+type:
+  type: xpath
+  version: xpath-30
+  x-pizza: cake`)
+
+	assert.True(t, c.Type.IsEmpty())
+	assert.Equal(t, "xpath", c.ExpressionType.Value.Type.Value)
+	assert.Equal(t, "xpath-30", c.ExpressionType.Value.Version.Value)
+	assert.Equal(t, 1, c.ExpressionType.Value.GetExtensions().Len())
+}
+
+func TestCriterion_Hash(t *testing.T) {
+	a := buildCriterion(t, `condition: $statusCode == 200`)
+	b := buildCriterion(t, `condition: $statusCode == 200`)
+	assert.Equal(t, a.Hash(), b.Hash())
+
+	c := buildCriterion(t, `condition: $statusCode == 200
+type:
+  type: jsonpath
+  version: draft-goessner-dispatch-jsonpath-00`)
+	d := buildCriterion(t, `condition: $statusCode == 200
+type:
+  type: jsonpath
+  version: rfc9535`)
+	assert.NotEqual(t, a.Hash(), c.Hash())
+	assert.NotEqual(t, c.Hash(), d.Hash())
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+	"strconv"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// FailureAction represents a low-level Arazzo Failure Action object, or a Reusable object that references a failure
+// action defined in the components of the document. If Reference is set, the action is a Reusable object.
+//   - https://spec.openapis.org/arazzo/v1.0.1#failure-action-object
+//   - https://spec.openapis.org/arazzo/v1.0.1#reusable-object
+type FailureAction struct {
+	Name       low.NodeReference[string]
+	Type       low.NodeReference[string]
+	WorkflowId low.NodeReference[string]
+	StepId     low.NodeReference[string]
+	RetryAfter low.NodeReference[float64]
+	RetryLimit low.NodeReference[int64]
+	Criteria   low.NodeReference[[]low.ValueReference[*Criterion]]
+	Reference  low.NodeReference[string]
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode    *yaml.Node
+	RootNode   *yaml.Node
+	index      *index.SpecIndex
+	context    context.Context
+	low.NodeMap
+}
+
+// IsReusable returns true if the action is a Reusable object, referencing a component.
+func (f *FailureAction) IsReusable() bool {
+	return !f.Reference.IsEmpty()
+}
+
+// IsReference always returns false, a Reusable object is not a JSON reference and is rendered as is. It
+// satisfies the low.IsReferenced interface.
+func (f *FailureAction) IsReference() bool {
+	return false
+}
+
+// GetReference always returns an empty string, use Reference to read the component a Reusable object refers to.
+func (f *FailureAction) GetReference() string {
+	return ""
+}
+
+// GetReferenceNode always returns nil, use Reference to read the component a Reusable object refers to.
+func (f *FailureAction) GetReferenceNode() *yaml.Node {
+	return nil
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the FailureAction object.
+func (f *FailureAction) GetIndex() *index.SpecIndex {
+	return f.index
+}
+
+// GetContext returns the context.Context instance used when building the FailureAction object.
+func (f *FailureAction) GetContext() context.Context {
+	return f.context
+}
+
+// GetRootNode returns the root yaml node of the FailureAction object.
+func (f *FailureAction) GetRootNode() *yaml.Node {
+	return f.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the FailureAction object.
+func (f *FailureAction) GetKeyNode() *yaml.Node {
+	return f.KeyNode
+}
+
+// GetExtensions returns all FailureAction extensions and satisfies the low.HasExtensions interface.
+func (f *FailureAction) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return f.Extensions
+}
+
+// Build will extract criteria and extensions from the supplied node.
+func (f *FailureAction) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	f.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	f.RootNode = root
+	utils.CheckForMergeNodes(root)
+	f.Nodes = low.ExtractNodes(ctx, root)
+	f.Extensions = low.ExtractExtensions(root)
+	f.index = idx
+	f.context = ctx
+	low.ExtractExtensionNodes(ctx, f.Extensions, f.Nodes)
+
+	criteria, err := extractArray[*Criterion](ctx, CriteriaLabel, root, idx, f.Nodes)
+	if err != nil {
+		return err
+	}
+	f.Criteria = criteria
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the FailureAction object
+func (f *FailureAction) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{f.Name, f.Type, f.WorkflowId, f.StepId, f.Reference} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	if !f.RetryAfter.IsEmpty() {
+		sb.WriteString(strconv.FormatFloat(f.RetryAfter.Value, 'f', -1, 64))
+		sb.WriteByte('|')
+	}
+	if !f.RetryLimit.IsEmpty() {
+		sb.WriteString(strconv.FormatInt(f.RetryLimit.Value, 10))
+		sb.WriteByte('|')
+	}
+	for _, c := range f.Criteria.Value {
+		sb.WriteString(low.GenerateHashString(c.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(f.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
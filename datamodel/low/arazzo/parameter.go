@@ -0,0 +1,117 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Parameter represents a low-level Arazzo Parameter object, or a Reusable object that references a parameter
+// defined in the components of the document.
+//
+// If Reference is set, the parameter is a Reusable object, and Value (if set) overrides the value of the
+// referenced parameter.
+//   - https://spec.openapis.org/arazzo/v1.0.1#parameter-object
+//   - https://spec.openapis.org/arazzo/v1.0.1#reusable-object
+type Parameter struct {
+	Name       low.NodeReference[string]
+	In         low.NodeReference[string]
+	Value      low.NodeReference[*yaml.Node]
+	Reference  low.NodeReference[string]
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode    *yaml.Node
+	RootNode   *yaml.Node
+	index      *index.SpecIndex
+	context    context.Context
+	low.NodeMap
+}
+
+// IsReusable returns true if the parameter is a Reusable object, referencing a component.
+func (p *Parameter) IsReusable() bool {
+	return !p.Reference.IsEmpty()
+}
+
+// IsReference always returns false, a Reusable object is not a JSON reference and is rendered as is. It
+// satisfies the low.IsReferenced interface.
+func (p *Parameter) IsReference() bool {
+	return false
+}
+
+// GetReference always returns an empty string, use Reference to read the component a Reusable object refers to.
+func (p *Parameter) GetReference() string {
+	return ""
+}
+
+// GetReferenceNode always returns nil, use Reference to read the component a Reusable object refers to.
+func (p *Parameter) GetReferenceNode() *yaml.Node {
+	return nil
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the Parameter object.
+func (p *Parameter) GetIndex() *index.SpecIndex {
+	return p.index
+}
+
+// GetContext returns the context.Context instance used when building the Parameter object.
+func (p *Parameter) GetContext() context.Context {
+	return p.context
+}
+
+// GetRootNode returns the root yaml node of the Parameter object.
+func (p *Parameter) GetRootNode() *yaml.Node {
+	return p.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the Parameter object.
+func (p *Parameter) GetKeyNode() *yaml.Node {
+	return p.KeyNode
+}
+
+// GetExtensions returns all Parameter extensions and satisfies the low.HasExtensions interface.
+func (p *Parameter) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return p.Extensions
+}
+
+// Build will extract extensions from the supplied node.
+func (p *Parameter) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	p.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	p.RootNode = root
+	utils.CheckForMergeNodes(root)
+	p.Nodes = low.ExtractNodes(ctx, root)
+	p.Extensions = low.ExtractExtensions(root)
+	p.index = idx
+	p.context = ctx
+	low.ExtractExtensionNodes(ctx, p.Extensions, p.Nodes)
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the Parameter object
+func (p *Parameter) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{p.Name, p.In, p.Reference} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	if !p.Value.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(p.Value.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(p.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParameter_Build_Reusable(t *testing.T) {
+	yml := `reference: $components.parameters.page
+value: 2`
+
+	var node yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &node)
+
+	var p Parameter
+	err := low.BuildModel(node.Content[0], &p)
+	assert.NoError(t, err)
+	err = p.Build(context.Background(), nil, node.Content[0], nil)
+	assert.NoError(t, err)
+
+	assert.True(t, p.IsReusable())
+	assert.Equal(t, "$components.parameters.page", p.Reference.Value)
+	assert.Equal(t, "2", p.Value.Value.Value)
+
+	// a reusable object is not a JSON reference, it must never be rendered as one.
+	assert.False(t, p.IsReference())
+	assert.Empty(t, p.GetReference())
+	assert.Nil(t, p.GetReferenceNode())
+}
+
+func TestParameter_Hash(t *testing.T) {
+	build := func(yml string) *Parameter {
+		var node yaml.Node
+		_ = yaml.Unmarshal([]byte(yml), &node)
+		var p Parameter
+		_ = low.BuildModel(node.Content[0], &p)
+		_ = p.Build(context.Background(), nil, node.Content[0], nil)
+		return &p
+	}
+	a := build(`name: page
+in: query
+value: 1`)
+	b := build(`name: page
+in: query
+value: 2`)
+	assert.NotEqual(t, a.Hash(), b.Hash())
+	assert.False(t, a.IsReusable())
+}
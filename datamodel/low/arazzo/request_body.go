@@ -0,0 +1,175 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// RequestBody represents a low-level Arazzo Request Body object, the payload sent by a step to an operation.
+//   - https://spec.openapis.org/arazzo/v1.0.1#request-body-object
+type RequestBody struct {
+	ContentType  low.NodeReference[string]
+	Payload      low.NodeReference[*yaml.Node]
+	Replacements low.NodeReference[[]low.ValueReference[*PayloadReplacement]]
+	Extensions   *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode      *yaml.Node
+	RootNode     *yaml.Node
+	index        *index.SpecIndex
+	context      context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the RequestBody object.
+func (r *RequestBody) GetIndex() *index.SpecIndex {
+	return r.index
+}
+
+// GetContext returns the context.Context instance used when building the RequestBody object.
+func (r *RequestBody) GetContext() context.Context {
+	return r.context
+}
+
+// GetRootNode returns the root yaml node of the RequestBody object.
+func (r *RequestBody) GetRootNode() *yaml.Node {
+	return r.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the RequestBody object.
+func (r *RequestBody) GetKeyNode() *yaml.Node {
+	return r.KeyNode
+}
+
+// GetExtensions returns all RequestBody extensions and satisfies the low.HasExtensions interface.
+func (r *RequestBody) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return r.Extensions
+}
+
+// Build will extract payload replacements and extensions from the supplied node.
+func (r *RequestBody) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	r.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	r.RootNode = root
+	utils.CheckForMergeNodes(root)
+	r.Reference = new(low.Reference)
+	r.Nodes = low.ExtractNodes(ctx, root)
+	r.Extensions = low.ExtractExtensions(root)
+	r.index = idx
+	r.context = ctx
+	low.ExtractExtensionNodes(ctx, r.Extensions, r.Nodes)
+
+	replacements, err := extractArray[*PayloadReplacement](ctx, ReplacementsLabel, root, idx, r.Nodes)
+	if err != nil {
+		return err
+	}
+	r.Replacements = replacements
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the RequestBody object
+func (r *RequestBody) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	if !r.ContentType.IsEmpty() {
+		sb.WriteString(r.ContentType.Value)
+		sb.WriteByte('|')
+	}
+	if !r.Payload.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(r.Payload.Value))
+		sb.WriteByte('|')
+	}
+	for _, p := range r.Replacements.Value {
+		sb.WriteString(low.GenerateHashString(p.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(r.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
+
+// PayloadReplacement represents a low-level Arazzo Payload Replacement object, it replaces a value in the payload
+// of a request body, at the location of the target.
+//   - https://spec.openapis.org/arazzo/v1.0.1#payload-replacement-object
+type PayloadReplacement struct {
+	Target     low.NodeReference[string]
+	Value      low.NodeReference[*yaml.Node]
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode    *yaml.Node
+	RootNode   *yaml.Node
+	index      *index.SpecIndex
+	context    context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the PayloadReplacement object.
+func (p *PayloadReplacement) GetIndex() *index.SpecIndex {
+	return p.index
+}
+
+// GetContext returns the context.Context instance used when building the PayloadReplacement object.
+func (p *PayloadReplacement) GetContext() context.Context {
+	return p.context
+}
+
+// GetRootNode returns the root yaml node of the PayloadReplacement object.
+func (p *PayloadReplacement) GetRootNode() *yaml.Node {
+	return p.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the PayloadReplacement object.
+func (p *PayloadReplacement) GetKeyNode() *yaml.Node {
+	return p.KeyNode
+}
+
+// GetExtensions returns all PayloadReplacement extensions and satisfies the low.HasExtensions interface.
+func (p *PayloadReplacement) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return p.Extensions
+}
+
+// Build will extract extensions from the supplied node.
+func (p *PayloadReplacement) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	p.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	p.RootNode = root
+	utils.CheckForMergeNodes(root)
+	p.Reference = new(low.Reference)
+	p.Nodes = low.ExtractNodes(ctx, root)
+	p.Extensions = low.ExtractExtensions(root)
+	p.index = idx
+	p.context = ctx
+	low.ExtractExtensionNodes(ctx, p.Extensions, p.Nodes)
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the PayloadReplacement object
+func (p *PayloadReplacement) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	if !p.Target.IsEmpty() {
+		sb.WriteString(p.Target.Value)
+		sb.WriteByte('|')
+	}
+	if !p.Value.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(p.Value.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(p.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
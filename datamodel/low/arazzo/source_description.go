@@ -0,0 +1,89 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// SourceDescription represents a low-level Arazzo Source Description object. A source description points to an
+// OpenAPI or Arazzo document that contains the operations and workflows used by steps.
+//   - https://spec.openapis.org/arazzo/v1.0.1#source-description-object
+type SourceDescription struct {
+	Name       low.NodeReference[string]
+	URL        low.NodeReference[string]
+	Type       low.NodeReference[string]
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode    *yaml.Node
+	RootNode   *yaml.Node
+	index      *index.SpecIndex
+	context    context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the SourceDescription object.
+func (s *SourceDescription) GetIndex() *index.SpecIndex {
+	return s.index
+}
+
+// GetContext returns the context.Context instance used when building the SourceDescription object.
+func (s *SourceDescription) GetContext() context.Context {
+	return s.context
+}
+
+// GetRootNode returns the root yaml node of the SourceDescription object.
+func (s *SourceDescription) GetRootNode() *yaml.Node {
+	return s.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the SourceDescription object.
+func (s *SourceDescription) GetKeyNode() *yaml.Node {
+	return s.KeyNode
+}
+
+// GetExtensions returns all SourceDescription extensions and satisfies the low.HasExtensions interface.
+func (s *SourceDescription) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return s.Extensions
+}
+
+// Build will extract extensions from the supplied node.
+func (s *SourceDescription) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	s.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	s.RootNode = root
+	utils.CheckForMergeNodes(root)
+	s.Reference = new(low.Reference)
+	s.Nodes = low.ExtractNodes(ctx, root)
+	s.Extensions = low.ExtractExtensions(root)
+	s.index = idx
+	s.context = ctx
+	low.ExtractExtensionNodes(ctx, s.Extensions, s.Nodes)
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the SourceDescription object
+func (s *SourceDescription) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{s.Name, s.URL, s.Type} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	for _, ext := range low.HashExtensions(s.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
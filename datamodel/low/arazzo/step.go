@@ -0,0 +1,158 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Step represents a low-level Arazzo Step object. A step calls an operation (using an operationId or operationPath)
+// or another workflow (using a workflowId).
+//   - https://spec.openapis.org/arazzo/v1.0.1#step-object
+type Step struct {
+	Description     low.NodeReference[string]
+	StepId          low.NodeReference[string]
+	OperationId     low.NodeReference[string]
+	OperationPath   low.NodeReference[string]
+	WorkflowId      low.NodeReference[string]
+	Parameters      low.NodeReference[[]low.ValueReference[*Parameter]]
+	RequestBody     low.NodeReference[*RequestBody]
+	SuccessCriteria low.NodeReference[[]low.ValueReference[*Criterion]]
+	OnSuccess       low.NodeReference[[]low.ValueReference[*SuccessAction]]
+	OnFailure       low.NodeReference[[]low.ValueReference[*FailureAction]]
+	Outputs         low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[string]]]
+	Extensions      *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode         *yaml.Node
+	RootNode        *yaml.Node
+	index           *index.SpecIndex
+	context         context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// FindOutput attempts to locate an output expression using the supplied name.
+func (s *Step) FindOutput(name string) *low.ValueReference[string] {
+	return low.FindItemInOrderedMap[string](name, s.Outputs.Value)
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the Step object.
+func (s *Step) GetIndex() *index.SpecIndex {
+	return s.index
+}
+
+// GetContext returns the context.Context instance used when building the Step object.
+func (s *Step) GetContext() context.Context {
+	return s.context
+}
+
+// GetRootNode returns the root yaml node of the Step object.
+func (s *Step) GetRootNode() *yaml.Node {
+	return s.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the Step object.
+func (s *Step) GetKeyNode() *yaml.Node {
+	return s.KeyNode
+}
+
+// GetExtensions returns all Step extensions and satisfies the low.HasExtensions interface.
+func (s *Step) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return s.Extensions
+}
+
+// Build will extract parameters, the request body, success criteria and actions from the supplied node.
+func (s *Step) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	s.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	s.RootNode = root
+	utils.CheckForMergeNodes(root)
+	s.Reference = new(low.Reference)
+	s.Nodes = low.ExtractNodes(ctx, root)
+	s.Extensions = low.ExtractExtensions(root)
+	s.index = idx
+	s.context = ctx
+	low.ExtractExtensionNodes(ctx, s.Extensions, s.Nodes)
+
+	params, err := extractArray[*Parameter](ctx, ParametersLabel, root, idx, s.Nodes)
+	if err != nil {
+		return err
+	}
+	s.Parameters = params
+
+	body, err := low.ExtractObject[*RequestBody](ctx, RequestBodyLabel, root, idx)
+	if err != nil {
+		return err
+	}
+	s.RequestBody = body
+
+	criteria, err := extractArray[*Criterion](ctx, SuccessCriteriaLabel, root, idx, s.Nodes)
+	if err != nil {
+		return err
+	}
+	s.SuccessCriteria = criteria
+
+	onSuccess, err := extractArray[*SuccessAction](ctx, OnSuccessLabel, root, idx, s.Nodes)
+	if err != nil {
+		return err
+	}
+	s.OnSuccess = onSuccess
+
+	onFailure, err := extractArray[*FailureAction](ctx, OnFailureLabel, root, idx, s.Nodes)
+	if err != nil {
+		return err
+	}
+	s.OnFailure = onFailure
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the Step object
+func (s *Step) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{s.Description, s.StepId, s.OperationId, s.OperationPath, s.WorkflowId} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	for _, p := range s.Parameters.Value {
+		sb.WriteString(low.GenerateHashString(p.Value))
+		sb.WriteByte('|')
+	}
+	if !s.RequestBody.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(s.RequestBody.Value))
+		sb.WriteByte('|')
+	}
+	for _, c := range s.SuccessCriteria.Value {
+		sb.WriteString(low.GenerateHashString(c.Value))
+		sb.WriteByte('|')
+	}
+	for _, a := range s.OnSuccess.Value {
+		sb.WriteString(low.GenerateHashString(a.Value))
+		sb.WriteByte('|')
+	}
+	for _, a := range s.OnFailure.Value {
+		sb.WriteString(low.GenerateHashString(a.Value))
+		sb.WriteByte('|')
+	}
+	for k, v := range s.Outputs.Value.FromOldest() {
+		sb.WriteString(k.Value)
+		sb.WriteByte(':')
+		sb.WriteString(v.Value)
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(s.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// SuccessAction represents a low-level Arazzo Success Action object, or a Reusable object that references a success
+// action defined in the components of the document. If Reference is set, the action is a Reusable object.
+//   - https://spec.openapis.org/arazzo/v1.0.1#success-action-object
+//   - https://spec.openapis.org/arazzo/v1.0.1#reusable-object
+type SuccessAction struct {
+	Name       low.NodeReference[string]
+	Type       low.NodeReference[string]
+	WorkflowId low.NodeReference[string]
+	StepId     low.NodeReference[string]
+	Criteria   low.NodeReference[[]low.ValueReference[*Criterion]]
+	Reference  low.NodeReference[string]
+	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode    *yaml.Node
+	RootNode   *yaml.Node
+	index      *index.SpecIndex
+	context    context.Context
+	low.NodeMap
+}
+
+// IsReusable returns true if the action is a Reusable object, referencing a component.
+func (s *SuccessAction) IsReusable() bool {
+	return !s.Reference.IsEmpty()
+}
+
+// IsReference always returns false, a Reusable object is not a JSON reference and is rendered as is. It
+// satisfies the low.IsReferenced interface.
+func (s *SuccessAction) IsReference() bool {
+	return false
+}
+
+// GetReference always returns an empty string, use Reference to read the component a Reusable object refers to.
+func (s *SuccessAction) GetReference() string {
+	return ""
+}
+
+// GetReferenceNode always returns nil, use Reference to read the component a Reusable object refers to.
+func (s *SuccessAction) GetReferenceNode() *yaml.Node {
+	return nil
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the SuccessAction object.
+func (s *SuccessAction) GetIndex() *index.SpecIndex {
+	return s.index
+}
+
+// GetContext returns the context.Context instance used when building the SuccessAction object.
+func (s *SuccessAction) GetContext() context.Context {
+	return s.context
+}
+
+// GetRootNode returns the root yaml node of the SuccessAction object.
+func (s *SuccessAction) GetRootNode() *yaml.Node {
+	return s.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the SuccessAction object.
+func (s *SuccessAction) GetKeyNode() *yaml.Node {
+	return s.KeyNode
+}
+
+// GetExtensions returns all SuccessAction extensions and satisfies the low.HasExtensions interface.
+func (s *SuccessAction) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return s.Extensions
+}
+
+// Build will extract criteria and extensions from the supplied node.
+func (s *SuccessAction) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	s.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	s.RootNode = root
+	utils.CheckForMergeNodes(root)
+	s.Nodes = low.ExtractNodes(ctx, root)
+	s.Extensions = low.ExtractExtensions(root)
+	s.index = idx
+	s.context = ctx
+	low.ExtractExtensionNodes(ctx, s.Extensions, s.Nodes)
+
+	criteria, err := extractArray[*Criterion](ctx, CriteriaLabel, root, idx, s.Nodes)
+	if err != nil {
+		return err
+	}
+	s.Criteria = criteria
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the SuccessAction object
+func (s *SuccessAction) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{s.Name, s.Type, s.WorkflowId, s.StepId, s.Reference} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	for _, c := range s.Criteria.Value {
+		sb.WriteString(low.GenerateHashString(c.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(s.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package arazzo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Workflow represents a low-level Arazzo Workflow object, an ordered list of steps that call operations or
+// other workflows. The inputs of a workflow are described using a JSON Schema.
+//   - https://spec.openapis.org/arazzo/v1.0.1#workflow-object
+type Workflow struct {
+	WorkflowId     low.NodeReference[string]
+	Summary        low.NodeReference[string]
+	Description    low.NodeReference[string]
+	Inputs         low.NodeReference[*base.SchemaProxy]
+	DependsOn      low.NodeReference[[]low.ValueReference[string]]
+	Steps          low.NodeReference[[]low.ValueReference[*Step]]
+	SuccessActions low.NodeReference[[]low.ValueReference[*SuccessAction]]
+	FailureActions low.NodeReference[[]low.ValueReference[*FailureAction]]
+	Outputs        low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[string]]]
+	Parameters     low.NodeReference[[]low.ValueReference[*Parameter]]
+	Extensions     *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode        *yaml.Node
+	RootNode       *yaml.Node
+	index          *index.SpecIndex
+	context        context.Context
+	*low.Reference
+	low.NodeMap
+}
+
+// FindStep attempts to locate a Step using the supplied stepId.
+func (w *Workflow) FindStep(stepId string) *Step {
+	for _, s := range w.Steps.Value {
+		if s.Value.StepId.Value == stepId {
+			return s.Value
+		}
+	}
+	return nil
+}
+
+// FindOutput attempts to locate an output expression using the supplied name.
+func (w *Workflow) FindOutput(name string) *low.ValueReference[string] {
+	return low.FindItemInOrderedMap[string](name, w.Outputs.Value)
+}
+
+// GetIndex returns the index.SpecIndex instance attached to the Workflow object.
+func (w *Workflow) GetIndex() *index.SpecIndex {
+	return w.index
+}
+
+// GetContext returns the context.Context instance used when building the Workflow object.
+func (w *Workflow) GetContext() context.Context {
+	return w.context
+}
+
+// GetRootNode returns the root yaml node of the Workflow object.
+func (w *Workflow) GetRootNode() *yaml.Node {
+	return w.RootNode
+}
+
+// GetKeyNode returns the key yaml node of the Workflow object.
+func (w *Workflow) GetKeyNode() *yaml.Node {
+	return w.KeyNode
+}
+
+// GetExtensions returns all Workflow extensions and satisfies the low.HasExtensions interface.
+func (w *Workflow) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
+	return w.Extensions
+}
+
+// Build will extract inputs, steps, actions and parameters from the supplied node.
+func (w *Workflow) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
+	w.KeyNode = keyNode
+	root = utils.NodeAlias(root)
+	w.RootNode = root
+	utils.CheckForMergeNodes(root)
+	w.Reference = new(low.Reference)
+	w.Nodes = low.ExtractNodes(ctx, root)
+	w.Extensions = low.ExtractExtensions(root)
+	w.index = idx
+	w.context = ctx
+	low.ExtractExtensionNodes(ctx, w.Extensions, w.Nodes)
+
+	inputs, err := base.ExtractSchemaWithLabel(ctx, InputsLabel, root, idx)
+	if err != nil {
+		return err
+	}
+	if inputs != nil {
+		w.Inputs = *inputs
+	}
+
+	steps, err := extractArray[*Step](ctx, StepsLabel, root, idx, w.Nodes)
+	if err != nil {
+		return err
+	}
+	w.Steps = steps
+
+	successActions, err := extractArray[*SuccessAction](ctx, SuccessActionsLabel, root, idx, w.Nodes)
+	if err != nil {
+		return err
+	}
+	w.SuccessActions = successActions
+
+	failureActions, err := extractArray[*FailureAction](ctx, FailureActionsLabel, root, idx, w.Nodes)
+	if err != nil {
+		return err
+	}
+	w.FailureActions = failureActions
+
+	params, err := extractArray[*Parameter](ctx, ParametersLabel, root, idx, w.Nodes)
+	if err != nil {
+		return err
+	}
+	w.Parameters = params
+	return nil
+}
+
+// Hash will return a consistent SHA256 Hash of the Workflow object
+func (w *Workflow) Hash() [32]byte {
+	sb := low.GetStringBuilder()
+	defer low.PutStringBuilder(sb)
+
+	for _, v := range []low.NodeReference[string]{w.WorkflowId, w.Summary, w.Description} {
+		if !v.IsEmpty() {
+			sb.WriteString(v.Value)
+			sb.WriteByte('|')
+		}
+	}
+	if !w.Inputs.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(w.Inputs.Value))
+		sb.WriteByte('|')
+	}
+	for _, d := range w.DependsOn.Value {
+		sb.WriteString(d.Value)
+		sb.WriteByte('|')
+	}
+	for _, s := range w.Steps.Value {
+		sb.WriteString(low.GenerateHashString(s.Value))
+		sb.WriteByte('|')
+	}
+	for _, a := range w.SuccessActions.Value {
+		sb.WriteString(low.GenerateHashString(a.Value))
+		sb.WriteByte('|')
+	}
+	for _, a := range w.FailureActions.Value {
+		sb.WriteString(low.GenerateHashString(a.Value))
+		sb.WriteByte('|')
+	}
+	for k, v := range w.Outputs.Value.FromOldest() {
+		sb.WriteString(k.Value)
+		sb.WriteByte(':')
+		sb.WriteString(v.Value)
+		sb.WriteByte('|')
+	}
+	for _, p := range w.Parameters.Value {
+		sb.WriteString(low.GenerateHashString(p.Value))
+		sb.WriteByte('|')
+	}
+	for _, ext := range low.HashExtensions(w.Extensions) {
+		sb.WriteString(ext)
+		sb.WriteByte('|')
+	}
+	return sha256.Sum256([]byte(sb.String()))
+}
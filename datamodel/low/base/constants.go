@@ -51,6 +51,12 @@ const (
 	SchemaLabel                = "schema"
 	SchemaTypeLabel            = "$schema"
 	AnchorLabel                = "$anchor"
+	IdLabel                    = "$id"
+	DefsLabel                  = "$defs"
+	CommentLabel               = "$comment"
+	DynamicRefLabel            = "$dynamicRef"
+	DynamicAnchorLabel         = "$dynamicAnchor"
+	VocabularyLabel            = "$vocabulary"
 )
 
 /*
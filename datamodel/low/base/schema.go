@@ -106,6 +106,14 @@ type Schema struct {
 	UnevaluatedProperties low.NodeReference[*SchemaDynamicValue[*SchemaProxy, bool]]
 	Anchor                low.NodeReference[string]
 
+	// JSON Schema 2020-12 core keywords (3.1 only)
+	Id            low.NodeReference[string]
+	Defs          low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*SchemaProxy]]]
+	Comment       low.NodeReference[string]
+	DynamicRef    low.NodeReference[string]
+	DynamicAnchor low.NodeReference[string]
+	Vocabulary    low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[bool]]]
+
 	// Compatible with all versions
 	Title                low.NodeReference[string]
 	MultipleOf           low.NodeReference[float64]
@@ -488,6 +496,28 @@ func (s *Schema) hash(quick bool) [32]byte {
 		sb.WriteString(s.Anchor.Value)
 		sb.WriteByte('|')
 	}
+	if !s.Id.IsEmpty() {
+		sb.WriteString(s.Id.Value)
+		sb.WriteByte('|')
+	}
+	if !s.Comment.IsEmpty() {
+		sb.WriteString(s.Comment.Value)
+		sb.WriteByte('|')
+	}
+	if !s.DynamicRef.IsEmpty() {
+		sb.WriteString(s.DynamicRef.Value)
+		sb.WriteByte('|')
+	}
+	if !s.DynamicAnchor.IsEmpty() {
+		sb.WriteString(s.DynamicAnchor.Value)
+		sb.WriteByte('|')
+	}
+	if s.Vocabulary.Value != nil {
+		for k, v := range orderedmap.SortAlpha(s.Vocabulary.Value).FromOldest() {
+			sb.WriteString(fmt.Sprintf("%s:%t", k.Value, v.Value))
+			sb.WriteByte('|')
+		}
+	}
 
 	// Process dependent schemas and pattern properties
 	for _, hash := range low.AppendMapHashes(nil, orderedmap.SortAlpha(s.DependentSchemas.Value)) {
@@ -525,6 +555,11 @@ func (s *Schema) hash(quick bool) [32]byte {
 		sb.WriteByte('|')
 	}
 
+	for _, hash := range low.AppendMapHashes(nil, orderedmap.SortAlpha(s.Defs.Value)) {
+		sb.WriteString(hash)
+		sb.WriteByte('|')
+	}
+
 	// Process PrefixItems
 	if len(s.PrefixItems.Value) > 0 {
 		itemsKeys := make([]string, len(s.PrefixItems.Value))
@@ -596,6 +631,12 @@ func (s *Schema) FindPatternProperty(name string) *low.ValueReference[*SchemaPro
 	return low.FindItemInOrderedMap[*SchemaProxy](name, s.PatternProperties.Value)
 }
 
+// FindDef will return a ValueReference pointer containing a SchemaProxy pointer
+// from a '$defs' key name. if found (3.1+ only)
+func (s *Schema) FindDef(name string) *low.ValueReference[*SchemaProxy] {
+	return low.FindItemInOrderedMap[*SchemaProxy](name, s.Defs.Value)
+}
+
 // GetExtensions returns all extensions for Schema
 func (s *Schema) GetExtensions() *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]] {
 	return s.Extensions
@@ -630,6 +671,7 @@ func (s *Schema) GetRootNode() *yaml.Node {
 //   - UnevaluatedItems
 //   - UnevaluatedProperties
 //   - Anchor
+//   - Id, Defs, Comment, DynamicRef, DynamicAnchor and Vocabulary
 func (s *Schema) Build(ctx context.Context, root *yaml.Node, idx *index.SpecIndex) error {
 	if root == nil {
 		return fmt.Errorf("cannot build schema from a nil node")
@@ -812,6 +854,21 @@ func (s *Schema) Build(ctx context.Context, root *yaml.Node, idx *index.SpecInde
 		}
 	}
 
+	// handle JSON Schema 2020-12 core keywords. (3.1)
+	// these are always reset, the model builder matches keys loosely and will pick up 'id' or 'comment'.
+	s.Id = buildCoreKeyword(IdLabel, root)
+	s.Comment = buildCoreKeyword(CommentLabel, root)
+	s.DynamicRef = buildCoreKeyword(DynamicRefLabel, root)
+	s.DynamicAnchor = buildCoreKeyword(DynamicAnchorLabel, root)
+
+	vocab, err := buildVocabularyMap(root, VocabularyLabel)
+	if err != nil {
+		return err
+	}
+	if vocab != nil {
+		s.Vocabulary = *vocab
+	}
+
 	// handle example if set. (3.0)
 	_, expLabel, expNode := utils.FindKeyNodeFullTop(ExampleLabel, root.Content)
 	if expNode != nil {
@@ -956,6 +1013,15 @@ func (s *Schema) Build(ctx context.Context, root *yaml.Node, idx *index.SpecInde
 		s.PatternProperties = *props
 	}
 
+	// handle $defs
+	props, err = buildPropertyMap(ctx, s, root, idx, DefsLabel)
+	if err != nil {
+		return err
+	}
+	if props != nil {
+		s.Defs = *props
+	}
+
 	// check items type for schema or bool (3.1 only)
 	itemsIsBool := false
 	itemsBoolValue := false
@@ -1344,6 +1410,54 @@ func buildDependentRequiredMap(root *yaml.Node, label string) (*low.NodeReferenc
 	return nil, nil
 }
 
+// buildCoreKeyword extracts a string keyword (such as '$id' or '$comment') from the top level of a schema node.
+func buildCoreKeyword(label string, root *yaml.Node) low.NodeReference[string] {
+	_, keyNode, valueNode := utils.FindKeyNodeFullTop(label, root.Content)
+	if valueNode == nil {
+		return low.NodeReference[string]{}
+	}
+	return low.NodeReference[string]{
+		Value: valueNode.Value, KeyNode: keyNode, ValueNode: valueNode,
+	}
+}
+
+// buildVocabularyMap builds an ordered map of vocabulary URIs to booleans for the $vocabulary property
+func buildVocabularyMap(root *yaml.Node, label string) (*low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[bool]]], error) {
+	_, vocabLabel, vocabNode := utils.FindKeyNodeFullTop(label, root.Content)
+	if vocabNode == nil {
+		return nil, nil
+	}
+	if !utils.IsNodeMap(vocabNode) {
+		return nil, fmt.Errorf("$vocabulary must be an object, found %v at line %d, col %d",
+			vocabNode.Kind, vocabNode.Line, vocabNode.Column)
+	}
+	vocabMap := orderedmap.New[low.KeyReference[string], low.ValueReference[bool]]()
+	var currentKey *yaml.Node
+	for i, node := range vocabNode.Content {
+		if i%2 == 0 {
+			currentKey = node
+			continue
+		}
+		if !utils.IsNodeBoolValue(node) {
+			return nil, fmt.Errorf("$vocabulary values must be booleans, found '%s' at line %d, col %d",
+				node.Value, node.Line, node.Column)
+		}
+		val, _ := strconv.ParseBool(node.Value)
+		vocabMap.Set(low.KeyReference[string]{
+			KeyNode: currentKey,
+			Value:   currentKey.Value,
+		}, low.ValueReference[bool]{
+			Value:     val,
+			ValueNode: node,
+		})
+	}
+	return &low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[bool]]]{
+		Value:     vocabMap,
+		KeyNode:   vocabLabel,
+		ValueNode: vocabNode,
+	}, nil
+}
+
 // count the number of sub-schemas in a node.
 func countSubSchemaItems(node *yaml.Node) int {
 	if utils.IsNodeMap(node) {
@@ -1484,67 +1598,71 @@ func buildSchema(ctx context.Context, schemas chan schemaProxyBuildResult, label
 // will specifically look for a key node named 'schema' and extract the value mapped to that key. If the operation
 // fails then no NodeReference is returned and an error is returned instead.
 func ExtractSchema(ctx context.Context, root *yaml.Node, idx *index.SpecIndex) (*low.NodeReference[*SchemaProxy], error) {
-	var schLabel, schNode *yaml.Node
-	errStr := "schema build failed: reference '%s' cannot be found at line %d, col %d"
-
-	refLocation := ""
-	var refNode *yaml.Node
-
-	foundIndex := idx
-	foundCtx := ctx
 	if rf, rl, _ := utils.IsNodeRefValue(root); rf {
 		// locate reference in index.
 		ref, fIdx, _, nCtx := low.LocateRefNodeWithContext(ctx, root, idx)
-		if ref != nil {
-			schNode = ref
-			schLabel = rl
-			foundCtx = nCtx
-			foundIndex = fIdx
-		} else {
+		if ref == nil {
 			v := root.Content[1].Value
 			if root.Content[1].Value == "" {
 				v = "[empty]"
 			}
-			return nil, fmt.Errorf(errStr,
+			return nil, fmt.Errorf("schema build failed: reference '%s' cannot be found at line %d, col %d",
 				v, root.Content[1].Line, root.Content[1].Column)
 		}
-	} else {
-		_, schLabel, schNode = utils.FindKeyNodeFull(SchemaLabel, root.Content)
-		if schNode != nil {
-			h := false
-			if h, _, refLocation = utils.IsNodeRefValue(schNode); h {
-				ref, fIdx, _, nCtx := low.LocateRefNodeWithContext(foundCtx, schNode, foundIndex)
-				if ref != nil {
-					refNode = schNode
-					schNode = ref
-					if fIdx != nil {
-						foundIndex = fIdx
-					}
-					foundCtx = nCtx
-				} else {
-					v := schNode.Content[1].Value
-					if schNode.Content[1].Value == "" {
-						v = "[empty]"
-					}
-					return nil, fmt.Errorf(errStr,
-						v, schNode.Content[1].Line, schNode.Content[1].Column)
-				}
+		return &low.NodeReference[*SchemaProxy]{
+			Value:     &SchemaProxy{kn: rl, vn: ref, idx: fIdx, ctx: nCtx},
+			KeyNode:   rl,
+			ValueNode: ref,
+		}, nil
+	}
+	return ExtractSchemaWithLabel(ctx, SchemaLabel, root, idx)
+}
+
+// ExtractSchemaWithLabel will return a pointer to a NodeReference that contains a *SchemaProxy if successful. Unlike
+// ExtractSchema, the function will look for a top level key node that matches the supplied label (for example
+// 'itemSchema') and extract the value mapped to that key. If no key is found, nothing is returned.
+func ExtractSchemaWithLabel(ctx context.Context, label string, root *yaml.Node, idx *index.SpecIndex) (*low.NodeReference[*SchemaProxy], error) {
+	root = utils.NodeAlias(root)
+	if root == nil {
+		return nil, nil
+	}
+	_, schLabel, schNode := utils.FindKeyNodeFullTop(label, root.Content)
+	if schNode == nil {
+		return nil, nil
+	}
+
+	refLocation := ""
+	var refNode *yaml.Node
+	foundIndex := idx
+	foundCtx := ctx
+
+	h := false
+	if h, _, refLocation = utils.IsNodeRefValue(schNode); h {
+		ref, fIdx, _, nCtx := low.LocateRefNodeWithContext(ctx, schNode, idx)
+		if ref == nil {
+			v := schNode.Content[1].Value
+			if v == "" {
+				v = "[empty]"
 			}
+			return nil, fmt.Errorf("schema build failed: reference '%s' cannot be found at line %d, col %d",
+				v, schNode.Content[1].Line, schNode.Content[1].Column)
 		}
+		refNode = schNode
+		schNode = ref
+		if fIdx != nil {
+			foundIndex = fIdx
+		}
+		foundCtx = nCtx
 	}
 
-	if schNode != nil {
-		// check if schema has already been built.
-		schema := &SchemaProxy{kn: schLabel, vn: schNode, idx: foundIndex, ctx: foundCtx}
-		schema.SetReference(refLocation, refNode)
+	schema := &SchemaProxy{kn: schLabel, vn: schNode, idx: foundIndex, ctx: foundCtx}
+	schema.SetReference(refLocation, refNode)
 
-		n := &low.NodeReference[*SchemaProxy]{
-			Value:     schema,
-			KeyNode:   schLabel,
-			ValueNode: schNode,
-		}
-		n.SetReference(refLocation, refNode)
-		return n, nil
+	n := &low.NodeReference[*SchemaProxy]{
+		Value:     schema,
+		KeyNode:   schLabel,
+		ValueNode: schNode,
 	}
-	return nil, nil
+	n.SetReference(refLocation, refNode)
+	return n, nil
 }
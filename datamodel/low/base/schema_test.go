@@ -2221,3 +2221,113 @@ dependentRequired:
 	hash2 := schema2.Hash()
 	assert.Equal(t, hash1, hash2)
 }
+
+func TestSchema_Build_CoreKeywords(t *testing.T) {
+	yml := `$id: https://example.com/schemas/pet
+$comment: this is a pet
+$dynamicRef: "#meta"
+$dynamicAnchor: meta
+$vocabulary:
+  https://json-schema.org/draft/2020-12/vocab/core: true
+  https://example.com/vocab/custom: false
+$defs:
+  name:
+    type: string
+  age:
+    type: integer
+type: object`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n Schema
+	err := n.Build(context.Background(), idxNode.Content[0], idx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/schemas/pet", n.Id.Value)
+	assert.Equal(t, 1, n.Id.KeyNode.Line)
+	assert.Equal(t, "this is a pet", n.Comment.Value)
+	assert.Equal(t, "#meta", n.DynamicRef.Value)
+	assert.Equal(t, "meta", n.DynamicAnchor.Value)
+
+	assert.Equal(t, 2, n.Vocabulary.Value.Len())
+	assert.True(t, low.FindItemInOrderedMap[bool]("https://json-schema.org/draft/2020-12/vocab/core", n.Vocabulary.Value).Value)
+	assert.False(t, low.FindItemInOrderedMap[bool]("https://example.com/vocab/custom", n.Vocabulary.Value).Value)
+
+	assert.Equal(t, 2, n.Defs.Value.Len())
+	assert.Equal(t, "string", n.FindDef("name").Value.Schema().Type.Value.A)
+	assert.Equal(t, "integer", n.FindDef("age").Value.Schema().Type.Value.A)
+	assert.Nil(t, n.FindDef("pizza"))
+}
+
+func TestSchema_Build_CoreKeywords_IgnoreUnprefixed(t *testing.T) {
+	yml := `id: not-an-id
+comment: not a comment
+type: object`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n Schema
+	err := n.Build(context.Background(), idxNode.Content[0], idx)
+	assert.NoError(t, err)
+	assert.True(t, n.Id.IsEmpty())
+	assert.True(t, n.Comment.IsEmpty())
+}
+
+func TestSchema_Build_Vocabulary_Invalid(t *testing.T) {
+	yml := `$vocabulary:
+  https://example.com/vocab/custom: sure`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n Schema
+	err := n.Build(context.Background(), idxNode.Content[0], idx)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "$vocabulary values must be booleans")
+
+	yml = `$vocabulary: nope`
+	var idxNode2 yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode2)
+
+	var n2 Schema
+	err = n2.Build(context.Background(), idxNode2.Content[0], index.NewSpecIndex(&idxNode2))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "$vocabulary must be an object")
+}
+
+func TestSchema_Hash_IncludesCoreKeywords(t *testing.T) {
+	base := `type: object
+$defs:
+  name:
+    type: string`
+
+	variants := []string{
+		base + "\n$id: https://example.com/a",
+		base + "\n$comment: hello",
+		base + "\n$dynamicRef: \"#meta\"",
+		base + "\n$dynamicAnchor: meta",
+		base + "\n$vocabulary:\n  https://example.com/vocab: true",
+		`type: object
+$defs:
+  name:
+    type: integer`,
+	}
+
+	build := func(yml string) [32]byte {
+		var idxNode yaml.Node
+		_ = yaml.Unmarshal([]byte(yml), &idxNode)
+		var s Schema
+		_ = s.Build(context.Background(), idxNode.Content[0], index.NewSpecIndex(&idxNode))
+		return s.Hash()
+	}
+
+	baseHash := build(base)
+	for _, v := range variants {
+		assert.NotEqual(t, baseHash, build(v), v)
+	}
+}
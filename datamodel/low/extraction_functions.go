@@ -119,6 +119,14 @@ func LocateRefNodeWithContext(ctx context.Context, root *yaml.Node, idx *index.S
 				root.Line, root.Column), ctx
 		}
 
+		// references resolved using a JSON Schema '$id' base URI or a plain name anchor are already mapped.
+		if found := idx.GetSchemaIdentifierReference(root); found != nil {
+			if jh, _, _ := utils.IsNodeRefValue(found.Node); jh && found.Node != root && !IsCircular(found.Node, idx) {
+				return LocateRefNodeWithContext(ctx, found.Node, idx)
+			}
+			return utils.NodeAlias(found.Node), idx, nil, ctx
+		}
+
 		// run through everything and return as soon as we find a match.
 		// this operates as fast as possible as ever
 		collections := generateIndexCollection(idx)
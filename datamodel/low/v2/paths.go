@@ -156,10 +156,7 @@ func (p *Paths) Build(ctx context.Context, _, root *yaml.Node, idx *index.SpecIn
 
 // Hash will return a consistent SHA256 Hash of the PathItem object
 func (p *Paths) Hash() [32]byte {
-	var f []string
-	for v := range orderedmap.SortAlpha(p.PathItems).ValuesFromOldest() {
-		f = append(f, low.GenerateHashString(v.Value))
-	}
+	f := low.AppendMapHashes(nil, p.PathItems)
 	f = append(f, low.HashExtensions(p.Extensions)...)
 	return sha256.Sum256([]byte(strings.Join(f, "|")))
 }
@@ -99,6 +99,45 @@ x-milk: creamy`
 	assert.Equal(t, 1, orderedmap.Len(n.GetExtensions()))
 }
 
+func TestPaths_Hash_RenamedPath(t *testing.T) {
+	build := func(yml string) *Paths {
+		var idxNode yaml.Node
+		_ = yaml.Unmarshal([]byte(yml), &idxNode)
+		idx := index.NewSpecIndex(&idxNode)
+
+		var n Paths
+		_ = low.BuildModel(idxNode.Content[0], &n)
+		_ = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+		return &n
+	}
+
+	n := build(`/data/dog:
+  get:
+    description: does data kinda, ish.
+/snow/flake:
+  get:
+    description: does data`)
+
+	// same path items, but one of them lives at a different path.
+	n2 := build(`/data/cat:
+  get:
+    description: does data kinda, ish.
+/snow/flake:
+  get:
+    description: does data`)
+
+	// same paths, in a different order.
+	n3 := build(`/snow/flake:
+  get:
+    description: does data
+/data/dog:
+  get:
+    description: does data kinda, ish.`)
+
+	assert.NotEqual(t, n.Hash(), n2.Hash())
+	assert.Equal(t, n.Hash(), n3.Hash())
+}
+
 // Test parse failure among many paths.
 // This stresses `TranslatePipeline`'s error handling.
 func TestPaths_Build_Fail_Many(t *testing.T) {
@@ -60,6 +60,7 @@ func (r *Responses) getDefault() *low.NodeReference[*Response] {
 	for code, resp := range r.Codes.FromOldest() {
 		if strings.ToLower(code.Value) == DefaultLabel {
 			return &low.NodeReference[*Response]{
+				Reference: resp.Reference,
 				ValueNode: resp.ValueNode,
 				KeyNode:   code.KeyNode,
 				Value:     resp.Value,
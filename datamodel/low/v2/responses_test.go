@@ -10,6 +10,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/low"
 	"github.com/pb33f/libopenapi/index"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
 )
@@ -47,6 +48,30 @@ func TestResponses_Build_Response_Default(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestResponses_Build_Response_DefaultReference(t *testing.T) {
+	yml := `swagger: "2.0"
+responses:
+  NotFound:
+    description: not found
+default:
+  $ref: "#/responses/NotFound"`
+
+	var idxNode yaml.Node
+	mErr := yaml.Unmarshal([]byte(yml), &idxNode)
+	assert.NoError(t, mErr)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n Responses
+	_, _, defaultNode := utils.FindKeyNodeFullTop("default", idxNode.Content[0].Content)
+	root := utils.CreateEmptyMapNode()
+	root.Content = []*yaml.Node{utils.CreateStringNode("default"), defaultNode}
+	err := n.Build(context.Background(), nil, root, idx)
+	assert.NoError(t, err)
+	assert.Equal(t, "not found", n.Default.Value.Description.Value)
+	assert.True(t, n.Default.IsReference())
+	assert.Equal(t, "#/responses/NotFound", n.Default.GetReference())
+}
+
 func TestResponses_Build_WrongType(t *testing.T) {
 	yml := `- $ref: break`
 
@@ -240,6 +240,9 @@ headers:
 }
 
 func TestComponents_Build_HashEmpty(t *testing.T) {
+	// Clear hash cache to ensure deterministic results in concurrent test environments
+	low.ClearHashCache()
+
 	yml := `x-curry: seagull`
 
 	var idxNode yaml.Node
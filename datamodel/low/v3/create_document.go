@@ -151,6 +151,14 @@ func createDocument(info *datamodel.SpecInfo, config *datamodel.DocumentConfigur
 		}
 	}
 
+	// if set, extract $self (3.2)
+	_, selfLabel, selfNode := utils.FindKeyNodeFullTop(SelfLabel, info.RootNode.Content[0].Content)
+	if selfNode != nil {
+		doc.Self = low.NodeReference[string]{
+			Value: selfNode.Value, KeyNode: selfLabel, ValueNode: selfNode,
+		}
+	}
+
 	runExtraction := func(ctx context.Context, info *datamodel.SpecInfo, doc *Document, idx *index.SpecIndex,
 		runFunc func(ctx context.Context, i *datamodel.SpecInfo, d *Document, idx *index.SpecIndex) error,
 		ers *[]error,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
@@ -931,3 +932,73 @@ func TestURLWithoutTrailingSlash(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDocument_Self(t *testing.T) {
+	yml := `openapi: 3.2.0
+$self: https://pb33f.io/openapi.yaml
+components:
+  mediaTypes:
+    EventStream:
+      itemSchema:
+        type: string`
+
+	info, _ := datamodel.ExtractSpecInfo([]byte(yml))
+	d, err := CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	assert.NoError(t, err)
+	assert.Equal(t, "https://pb33f.io/openapi.yaml", d.Self.Value)
+	assert.Equal(t, 2, d.Self.KeyNode.Line)
+	assert.Equal(t, "https://pb33f.io/openapi.yaml", d.Index.GetSpecAbsolutePath())
+
+	mt := d.Components.Value.FindMediaType("EventStream")
+	assert.NotNil(t, mt)
+	assert.Equal(t, "string", mt.Value.ItemSchema.Value.Schema().Type.Value.A)
+
+	info, _ = datamodel.ExtractSpecInfo([]byte(`openapi: 3.2.0
+$self: https://pb33f.io/v2/openapi.yaml
+components:
+  mediaTypes:
+    EventStream:
+      itemSchema:
+        type: string`))
+	d2, _ := CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	assert.NotEqual(t, d.Hash(), d2.Hash())
+}
+
+// relative references are resolved against '$self', rather than the location the document was loaded from.
+func TestCreateDocument_SelfResolvesReferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/shared/pet.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`Pet:
+  type: object
+  properties:
+    name:
+      type: string`))
+	}))
+	defer server.Close()
+
+	yml := `openapi: 3.2.0
+$self: /shared/openapi.yaml
+components:
+  schemas:
+    Pet:
+      $ref: 'pet.yaml#/Pet'`
+
+	cf := datamodel.NewDocumentConfiguration()
+	cf.AllowRemoteReferences = true
+	cf.BaseURL, _ = url.Parse(server.URL + "/v1")
+
+	info, _ := datamodel.ExtractSpecInfo([]byte(yml))
+	d, err := CreateDocumentFromConfig(info, cf)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/shared/openapi.yaml", d.Index.GetSpecAbsolutePath())
+
+	pet := d.Components.Value.FindSchema("Pet")
+	require.NotNil(t, pet)
+	schema := pet.Value.Schema()
+	require.NotNil(t, schema)
+	assert.Equal(t, "object", schema.Type.Value.A)
+	assert.NotNil(t, schema.FindProperty("name"))
+}
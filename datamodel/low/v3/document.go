@@ -25,6 +25,11 @@ type Document struct {
 	// This is not a standard property of the OpenAPI model, it's a convenience mechanism only.
 	Version low.NodeReference[string]
 
+	// Self is a 3.2+ property that declares the URI of the document, it is used as the base URI when resolving
+	// relative references found in the document.
+	// - https://spec.openapis.org/oas/v3.2.0#openapi-object
+	Self low.NodeReference[string] // 3.2
+
 	// Info represents a specification Info definitions
 	// Provides metadata about the API. The metadata MAY be used by tooling as required.
 	// - https://spec.openapis.org/oas/v3.1.0#info-object
@@ -138,6 +143,10 @@ func (d *Document) Hash() [32]byte {
 		sb.WriteString(d.Version.Value)
 		sb.WriteByte('|')
 	}
+	if d.Self.Value != "" {
+		sb.WriteString(d.Self.Value)
+		sb.WriteByte('|')
+	}
 	if d.Info.Value != nil {
 		sb.WriteString(low.GenerateHashString(d.Info.Value))
 		sb.WriteByte('|')
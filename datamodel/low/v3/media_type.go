@@ -20,16 +20,22 @@ import (
 //
 // Each Media Type Object provides schema and examples for the media type identified by its key.
 //   - https://spec.openapis.org/oas/v3.1.0#media-type-object
+//
+// OpenAPI 3.2 adds ItemSchema, ItemEncoding and PrefixEncoding for sequential media types.
+//   - https://spec.openapis.org/oas/v3.2.0#media-type-object
 type MediaType struct {
-	Schema     low.NodeReference[*base.SchemaProxy]
-	Example    low.NodeReference[*yaml.Node]
-	Examples   low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*base.Example]]]
-	Encoding   low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*Encoding]]]
-	Extensions *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
-	KeyNode    *yaml.Node
-	RootNode   *yaml.Node
-	index      *index.SpecIndex
-	context    context.Context
+	Schema         low.NodeReference[*base.SchemaProxy]
+	ItemSchema     low.NodeReference[*base.SchemaProxy]
+	Example        low.NodeReference[*yaml.Node]
+	Examples       low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*base.Example]]]
+	Encoding       low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*Encoding]]]
+	PrefixEncoding low.NodeReference[[]low.ValueReference[*Encoding]]
+	ItemEncoding   low.NodeReference[*Encoding]
+	Extensions     *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode        *yaml.Node
+	RootNode       *yaml.Node
+	index          *index.SpecIndex
+	context        context.Context
 	*low.Reference
 	low.NodeMap
 }
@@ -79,13 +85,16 @@ func (mt *MediaType) GetKeyNode() *yaml.Node {
 	return mt.KeyNode
 }
 
-// Build will extract examples, extensions, schema and encoding from node.
+// Build will extract examples, extensions, schema, item schema and encodings from node.
 func (mt *MediaType) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
 	mt.KeyNode = keyNode
+	mt.Reference = new(low.Reference)
+	if ok, _, ref := utils.IsNodeRefValue(root); ok {
+		mt.SetReference(ref, root)
+	}
 	root = utils.NodeAlias(root)
 	mt.RootNode = root
 	utils.CheckForMergeNodes(root)
-	mt.Reference = new(low.Reference)
 	mt.Nodes = low.ExtractNodes(ctx, root)
 	mt.Extensions = low.ExtractExtensions(root)
 	mt.index = idx
@@ -114,6 +123,15 @@ func (mt *MediaType) Build(ctx context.Context, keyNode, root *yaml.Node, idx *i
 		mt.Schema = *sch
 	}
 
+	// handle item schema (3.2+)
+	itemSch, iErr := base.ExtractSchemaWithLabel(ctx, ItemSchemaLabel, root, idx)
+	if iErr != nil {
+		return iErr
+	}
+	if itemSch != nil {
+		mt.ItemSchema = *itemSch
+	}
+
 	// handle examples if set.
 	exps, expsL, expsN, eErr := low.ExtractMap[*base.Example](ctx, base.ExamplesLabel, root, idx)
 	if eErr != nil {
@@ -148,6 +166,30 @@ func (mt *MediaType) Build(ctx context.Context, keyNode, root *yaml.Node, idx *i
 			v.Value.Nodes.Store(k.KeyNode.Line, k.KeyNode)
 		}
 	}
+
+	// handle prefix encoding (3.2+)
+	prefixEncs, prefixL, prefixN, pErr := low.ExtractArray[*Encoding](ctx, PrefixEncodingLabel, root, idx)
+	if pErr != nil {
+		return pErr
+	}
+	if prefixEncs != nil && prefixL != nil {
+		mt.PrefixEncoding = low.NodeReference[[]low.ValueReference[*Encoding]]{
+			Value:     prefixEncs,
+			KeyNode:   prefixL,
+			ValueNode: prefixN,
+		}
+		mt.Nodes.Store(prefixL.Line, prefixL)
+	}
+
+	// handle item encoding (3.2+)
+	itemEnc, ieErr := low.ExtractObject[*Encoding](ctx, ItemEncodingLabel, root, idx)
+	if ieErr != nil {
+		return ieErr
+	}
+	if !itemEnc.IsEmpty() {
+		mt.ItemEncoding = itemEnc
+		mt.Nodes.Store(itemEnc.KeyNode.Line, itemEnc.KeyNode)
+	}
 	return nil
 }
 
@@ -161,6 +203,10 @@ func (mt *MediaType) Hash() [32]byte {
 		sb.WriteString(low.GenerateHashString(mt.Schema.Value))
 		sb.WriteByte('|')
 	}
+	if mt.ItemSchema.Value != nil {
+		sb.WriteString(low.GenerateHashString(mt.ItemSchema.Value))
+		sb.WriteByte('|')
+	}
 	if mt.Example.Value != nil && !mt.Example.Value.IsZero() {
 		sb.WriteString(low.GenerateHashString(mt.Example.Value))
 		sb.WriteByte('|')
@@ -173,6 +219,14 @@ func (mt *MediaType) Hash() [32]byte {
 		sb.WriteString(low.GenerateHashString(v.Value))
 		sb.WriteByte('|')
 	}
+	for _, v := range mt.PrefixEncoding.Value {
+		sb.WriteString(low.GenerateHashString(v.Value))
+		sb.WriteByte('|')
+	}
+	if mt.ItemEncoding.Value != nil {
+		sb.WriteString(low.GenerateHashString(mt.ItemEncoding.Value))
+		sb.WriteByte('|')
+	}
 	for _, ext := range low.HashExtensions(mt.Extensions) {
 		sb.WriteString(ext)
 		sb.WriteByte('|')
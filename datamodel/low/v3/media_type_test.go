@@ -206,3 +206,118 @@ func TestMediaType_Examples_NotFromSchema(t *testing.T) {
 
 	assert.Equal(t, 0, orderedmap.Len(n.Examples.Value))
 }
+
+func TestMediaType_Build_Streaming(t *testing.T) {
+	yml := `itemSchema:
+  type: object
+  properties:
+    data:
+      type: string
+prefixEncoding:
+  - contentType: application/json
+  - contentType: image/png
+itemEncoding:
+  contentType: text/plain`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n MediaType
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.NoError(t, err)
+
+	assert.True(t, n.Schema.IsEmpty())
+	assert.Equal(t, "object", n.ItemSchema.Value.Schema().Type.Value.A)
+	assert.Equal(t, "itemSchema", n.ItemSchema.KeyNode.Value)
+	assert.Len(t, n.PrefixEncoding.Value, 2)
+	assert.Equal(t, "application/json", n.PrefixEncoding.Value[0].Value.ContentType.Value)
+	assert.Equal(t, "image/png", n.PrefixEncoding.Value[1].Value.ContentType.Value)
+	assert.Equal(t, "text/plain", n.ItemEncoding.Value.ContentType.Value)
+}
+
+func TestMediaType_Build_Fail_ItemSchema(t *testing.T) {
+	yml := `itemSchema:
+  $ref: #bork`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n MediaType
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.Error(t, err)
+}
+
+func TestMediaType_Build_Fail_PrefixEncoding(t *testing.T) {
+	yml := `prefixEncoding:
+  contentType: application/json`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n MediaType
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.Error(t, err)
+}
+
+func TestMediaType_Build_Fail_ItemEncoding(t *testing.T) {
+	yml := `itemEncoding:
+  $ref: #bork`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n MediaType
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.Error(t, err)
+}
+
+func TestMediaType_Hash_Streaming(t *testing.T) {
+	low.ClearHashCache()
+
+	build := func(yml string) *MediaType {
+		var idxNode yaml.Node
+		_ = yaml.Unmarshal([]byte(yml), &idxNode)
+		var n MediaType
+		_ = low.BuildModel(idxNode.Content[0], &n)
+		_ = n.Build(context.Background(), nil, idxNode.Content[0], nil)
+		return &n
+	}
+
+	a := build(`itemSchema:
+  type: string
+prefixEncoding:
+  - contentType: application/json
+itemEncoding:
+  contentType: text/plain`)
+	b := build(`itemSchema:
+  type: string
+prefixEncoding:
+  - contentType: application/json
+itemEncoding:
+  contentType: text/plain`)
+	c := build(`itemSchema:
+  type: string
+prefixEncoding:
+  - contentType: application/xml
+itemEncoding:
+  contentType: text/plain`)
+
+	assert.Equal(t, a.Hash(), b.Hash())
+	assert.NotEqual(t, a.Hash(), c.Hash())
+}
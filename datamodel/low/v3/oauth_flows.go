@@ -17,16 +17,20 @@ import (
 
 // OAuthFlows represents a low-level OpenAPI 3+ OAuthFlows object.
 //   - https://spec.openapis.org/oas/v3.1.0#oauth-flows-object
+//
+// DeviceAuthorization was added in OpenAPI 3.2
+//   - https://spec.openapis.org/oas/v3.2.0#oauth-flows-object
 type OAuthFlows struct {
-	Implicit          low.NodeReference[*OAuthFlow]
-	Password          low.NodeReference[*OAuthFlow]
-	ClientCredentials low.NodeReference[*OAuthFlow]
-	AuthorizationCode low.NodeReference[*OAuthFlow]
-	Extensions        *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
-	KeyNode           *yaml.Node
-	RootNode          *yaml.Node
-	index             *index.SpecIndex
-	context           context.Context
+	Implicit            low.NodeReference[*OAuthFlow]
+	Password            low.NodeReference[*OAuthFlow]
+	ClientCredentials   low.NodeReference[*OAuthFlow]
+	AuthorizationCode   low.NodeReference[*OAuthFlow]
+	DeviceAuthorization low.NodeReference[*OAuthFlow]
+	Extensions          *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode             *yaml.Node
+	RootNode            *yaml.Node
+	index               *index.SpecIndex
+	context             context.Context
 	*low.Reference
 	low.NodeMap
 }
@@ -96,6 +100,12 @@ func (o *OAuthFlows) Build(ctx context.Context, keyNode, root *yaml.Node, idx *i
 		return vErr
 	}
 	o.AuthorizationCode = v
+
+	v, vErr = low.ExtractObject[*OAuthFlow](ctx, DeviceAuthorizationLabel, root, idx)
+	if vErr != nil {
+		return vErr
+	}
+	o.DeviceAuthorization = v
 	return nil
 }
 
@@ -121,6 +131,10 @@ func (o *OAuthFlows) Hash() [32]byte {
 		sb.WriteString(low.GenerateHashString(o.AuthorizationCode.Value))
 		sb.WriteByte('|')
 	}
+	if !o.DeviceAuthorization.IsEmpty() {
+		sb.WriteString(low.GenerateHashString(o.DeviceAuthorization.Value))
+		sb.WriteByte('|')
+	}
 	for _, ext := range low.HashExtensions(o.Extensions) {
 		sb.WriteString(ext)
 		sb.WriteByte('|')
@@ -131,14 +145,15 @@ func (o *OAuthFlows) Hash() [32]byte {
 // OAuthFlow represents a low-level OpenAPI 3+ OAuthFlow object.
 //   - https://spec.openapis.org/oas/v3.1.0#oauth-flow-object
 type OAuthFlow struct {
-	AuthorizationUrl low.NodeReference[string]
-	TokenUrl         low.NodeReference[string]
-	RefreshUrl       low.NodeReference[string]
-	Scopes           low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[string]]]
-	Extensions       *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
-	RootNode         *yaml.Node
-	index            *index.SpecIndex
-	context          context.Context
+	AuthorizationUrl       low.NodeReference[string]
+	DeviceAuthorizationUrl low.NodeReference[string] // 3.2
+	TokenUrl               low.NodeReference[string]
+	RefreshUrl             low.NodeReference[string]
+	Scopes                 low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[string]]]
+	Extensions             *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	RootNode               *yaml.Node
+	index                  *index.SpecIndex
+	context                context.Context
 	*low.Reference
 	low.NodeMap
 }
@@ -202,6 +217,10 @@ func (o *OAuthFlow) Hash() [32]byte {
 		sb.WriteString(o.AuthorizationUrl.Value)
 		sb.WriteByte('|')
 	}
+	if !o.DeviceAuthorizationUrl.IsEmpty() {
+		sb.WriteString(o.DeviceAuthorizationUrl.Value)
+		sb.WriteByte('|')
+	}
 	if !o.TokenUrl.IsEmpty() {
 		sb.WriteString(o.TokenUrl.Value)
 		sb.WriteByte('|')
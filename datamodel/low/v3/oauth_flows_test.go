@@ -269,3 +269,64 @@ password:
 	// hash
 	assert.Equal(t, n.Hash(), n2.Hash())
 }
+
+func TestOAuthFlow_Build_DeviceAuthorization(t *testing.T) {
+	yml := `deviceAuthorization:
+  deviceAuthorizationUrl: https://pb33f.io/device
+  tokenUrl: https://pb33f.io/token`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n OAuthFlows
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://pb33f.io/device", n.DeviceAuthorization.Value.DeviceAuthorizationUrl.Value)
+	assert.Equal(t, "https://pb33f.io/token", n.DeviceAuthorization.Value.TokenUrl.Value)
+}
+
+func TestOAuthFlow_Build_DeviceAuthorization_Fail(t *testing.T) {
+	yml := `deviceAuthorization:
+  $ref: #bork"`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n OAuthFlows
+	err := low.BuildModel(&idxNode, &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.Error(t, err)
+}
+
+func TestOAuthFlows_Hash_DeviceAuthorization(t *testing.T) {
+	yml := `deviceAuthorization:
+  deviceAuthorizationUrl: https://pb33f.io/device`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n OAuthFlows
+	_ = low.BuildModel(idxNode.Content[0], &n)
+	_ = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+
+	yml2 := `deviceAuthorization:
+  deviceAuthorizationUrl: https://pb33f.io/other-device`
+
+	var idxNode2 yaml.Node
+	_ = yaml.Unmarshal([]byte(yml2), &idxNode2)
+	idx2 := index.NewSpecIndex(&idxNode2)
+
+	var n2 OAuthFlows
+	_ = low.BuildModel(idxNode2.Content[0], &n2)
+	_ = n2.Build(context.Background(), nil, idxNode2.Content[0], idx2)
+
+	assert.NotEqual(t, n.Hash(), n2.Hash())
+}
@@ -24,25 +24,30 @@ import (
 // Describes the operations available on a single path. A Path Item MAY be empty, due to ACL constraints.
 // The path itself is still exposed to the documentation viewer, but they will not know which operations and parameters
 // are available.
+//
+// OpenAPI 3.2 adds the 'query' method and an 'additionalOperations' map for any other HTTP method.
 //   - https://spec.openapis.org/oas/v3.1.0#path-item-object
+//   - https://spec.openapis.org/oas/v3.2.0#path-item-object
 type PathItem struct {
-	Description low.NodeReference[string]
-	Summary     low.NodeReference[string]
-	Get         low.NodeReference[*Operation]
-	Put         low.NodeReference[*Operation]
-	Post        low.NodeReference[*Operation]
-	Delete      low.NodeReference[*Operation]
-	Options     low.NodeReference[*Operation]
-	Head        low.NodeReference[*Operation]
-	Patch       low.NodeReference[*Operation]
-	Trace       low.NodeReference[*Operation]
-	Servers     low.NodeReference[[]low.ValueReference[*Server]]
-	Parameters  low.NodeReference[[]low.ValueReference[*Parameter]]
-	Extensions  *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
-	KeyNode     *yaml.Node
-	RootNode    *yaml.Node
-	index       *index.SpecIndex
-	context     context.Context
+	Description          low.NodeReference[string]
+	Summary              low.NodeReference[string]
+	Get                  low.NodeReference[*Operation]
+	Put                  low.NodeReference[*Operation]
+	Post                 low.NodeReference[*Operation]
+	Delete               low.NodeReference[*Operation]
+	Options              low.NodeReference[*Operation]
+	Head                 low.NodeReference[*Operation]
+	Patch                low.NodeReference[*Operation]
+	Trace                low.NodeReference[*Operation]
+	Query                low.NodeReference[*Operation]
+	AdditionalOperations low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*Operation]]]
+	Servers              low.NodeReference[[]low.ValueReference[*Server]]
+	Parameters           low.NodeReference[[]low.ValueReference[*Parameter]]
+	Extensions           *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode              *yaml.Node
+	RootNode             *yaml.Node
+	index                *index.SpecIndex
+	context              context.Context
 	*low.Reference
 	low.NodeMap
 }
@@ -103,6 +108,14 @@ func (p *PathItem) Hash() [32]byte {
 		sb.WriteString(fmt.Sprintf("%s-%s", TraceLabel, low.GenerateHashString(p.Trace.Value)))
 		sb.WriteByte('|')
 	}
+	if !p.Query.IsEmpty() {
+		sb.WriteString(fmt.Sprintf("%s-%s", QueryLabel, low.GenerateHashString(p.Query.Value)))
+		sb.WriteByte('|')
+	}
+	for k, v := range orderedmap.SortAlpha(p.AdditionalOperations.Value).FromOldest() {
+		sb.WriteString(fmt.Sprintf("%s-%s", k.Value, low.GenerateHashString(v.Value)))
+		sb.WriteByte('|')
+	}
 
 	// Process Parameters with pre-allocation and sorting
 	if len(p.Parameters.Value) > 0 {
@@ -147,6 +160,11 @@ func (p *PathItem) GetKeyNode() *yaml.Node {
 	return p.KeyNode
 }
 
+// FindAdditionalOperation will attempt to locate an additional operation by the supplied HTTP method.
+func (p *PathItem) FindAdditionalOperation(method string) *low.ValueReference[*Operation] {
+	return low.FindItemInOrderedMap(method, p.AdditionalOperations.GetValue())
+}
+
 // FindExtension attempts to find an extension
 func (p *PathItem) FindExtension(ext string) *low.ValueReference[*yaml.Node] {
 	return low.FindItemInOrderedMap(ext, p.Extensions)
@@ -157,7 +175,7 @@ func (p *PathItem) GetExtensions() *orderedmap.Map[low.KeyReference[string], low
 	return p.Extensions
 }
 
-// Build extracts extensions, parameters, servers and each http method defined.
+// Build extracts extensions, parameters, servers, additional operations and each http method defined.
 // everything is extracted asynchronously for speed.
 func (p *PathItem) Build(ctx context.Context, keyNode, root *yaml.Node, idx *index.SpecIndex) error {
 	p.Reference = new(low.Reference)
@@ -218,6 +236,24 @@ func (p *PathItem) Build(ctx context.Context, keyNode, root *yaml.Node, idx *ind
 			p.Nodes.Store(ln.Line, ln)
 		}
 	}
+
+	// extract additional operations (OpenAPI 3.2+)
+	addOps, aoL, aoN, aoErr := low.ExtractMap[*Operation](ctx, AdditionalOperationsLabel, root, idx)
+	if aoErr != nil {
+		return aoErr
+	}
+	if addOps != nil {
+		p.AdditionalOperations = low.NodeReference[*orderedmap.Map[low.KeyReference[string], low.ValueReference[*Operation]]]{
+			Value:     addOps,
+			KeyNode:   aoL,
+			ValueNode: aoN,
+		}
+		p.Nodes.Store(aoL.Line, aoL)
+		for k, v := range addOps.FromOldest() {
+			v.Value.Nodes.Store(k.KeyNode.Line, k.KeyNode)
+		}
+	}
+
 	prevExt := false
 	for i, pathNode := range root.Content {
 		if strings.HasPrefix(strings.ToLower(pathNode.Value), "x-") {
@@ -255,6 +291,7 @@ func (p *PathItem) Build(ctx context.Context, keyNode, root *yaml.Node, idx *ind
 		case HeadLabel:
 		case OptionsLabel:
 		case TraceLabel:
+		case QueryLabel:
 		default:
 			continue // ignore everything else.
 		}
@@ -335,6 +372,8 @@ func (p *PathItem) Build(ctx context.Context, keyNode, root *yaml.Node, idx *ind
 			p.Options = opRef
 		case TraceLabel:
 			p.Trace = opRef
+		case QueryLabel:
+			p.Query = opRef
 		}
 	}
 
@@ -105,3 +105,65 @@ get:
 
 	assert.NotNil(t, n.RootNode)
 }
+
+func TestPathItem_Build_QueryAndAdditionalOperations(t *testing.T) {
+	yml := `query:
+  operationId: searchPets
+  description: search for pets
+additionalOperations:
+  LINK:
+    operationId: linkPet
+  COPY:
+    operationId: copyPet`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n PathItem
+	_ = low.BuildModel(idxNode.Content[0], &n)
+	err := n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "searchPets", n.Query.Value.OperationId.Value)
+	assert.Equal(t, 2, orderedmap.Len(n.AdditionalOperations.Value))
+	assert.Equal(t, "linkPet", n.FindAdditionalOperation("LINK").Value.OperationId.Value)
+	assert.Equal(t, "copyPet", n.FindAdditionalOperation("COPY").Value.OperationId.Value)
+	assert.Nil(t, n.FindAdditionalOperation("PURGE"))
+
+	yml2 := `additionalOperations:
+  COPY:
+    operationId: copyPet
+  LINK:
+    operationId: linkPet
+query:
+  description: search for pets
+  operationId: searchPets`
+
+	var idxNode2 yaml.Node
+	_ = yaml.Unmarshal([]byte(yml2), &idxNode2)
+	idx2 := index.NewSpecIndex(&idxNode2)
+
+	var n2 PathItem
+	_ = low.BuildModel(idxNode2.Content[0], &n2)
+	_ = n2.Build(context.Background(), nil, idxNode2.Content[0], idx2)
+
+	assert.Equal(t, n.Hash(), n2.Hash())
+
+	yml3 := `query:
+  operationId: searchPets
+  description: search for pets
+additionalOperations:
+  LINK:
+    operationId: linkPets`
+
+	var idxNode3 yaml.Node
+	_ = yaml.Unmarshal([]byte(yml3), &idxNode3)
+	idx3 := index.NewSpecIndex(&idxNode3)
+
+	var n3 PathItem
+	_ = low.BuildModel(idxNode3.Content[0], &n3)
+	_ = n3.Build(context.Background(), nil, idxNode3.Content[0], idx3)
+
+	assert.NotEqual(t, n.Hash(), n3.Hash())
+}
@@ -6,6 +6,7 @@ package v3
 import (
 	"context"
 	"crypto/sha256"
+	"strconv"
 
 	"github.com/pb33f/libopenapi/datamodel/low"
 	"github.com/pb33f/libopenapi/index"
@@ -24,20 +25,25 @@ import (
 // Please note that as of 2020, the implicit  flow is about to be deprecated by OAuth 2.0 Security Best Current Practice.
 // Recommended for most use case is Authorization Code Grant flow with PKCE.
 //   - https://spec.openapis.org/oas/v3.1.0#security-scheme-object
+//
+// OAuth2MetadataUrl and Deprecated were added in OpenAPI 3.2
+//   - https://spec.openapis.org/oas/v3.2.0#security-scheme-object
 type SecurityScheme struct {
-	Type             low.NodeReference[string]
-	Description      low.NodeReference[string]
-	Name             low.NodeReference[string]
-	In               low.NodeReference[string]
-	Scheme           low.NodeReference[string]
-	BearerFormat     low.NodeReference[string]
-	Flows            low.NodeReference[*OAuthFlows]
-	OpenIdConnectUrl low.NodeReference[string]
-	Extensions       *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
-	KeyNode          *yaml.Node
-	RootNode         *yaml.Node
-	index            *index.SpecIndex
-	context          context.Context
+	Type              low.NodeReference[string]
+	Description       low.NodeReference[string]
+	Name              low.NodeReference[string]
+	In                low.NodeReference[string]
+	Scheme            low.NodeReference[string]
+	BearerFormat      low.NodeReference[string]
+	Flows             low.NodeReference[*OAuthFlows]
+	OpenIdConnectUrl  low.NodeReference[string]
+	OAuth2MetadataUrl low.NodeReference[string]
+	Deprecated        low.NodeReference[bool]
+	Extensions        *orderedmap.Map[low.KeyReference[string], low.ValueReference[*yaml.Node]]
+	KeyNode           *yaml.Node
+	RootNode          *yaml.Node
+	index             *index.SpecIndex
+	context           context.Context
 	*low.Reference
 	low.NodeMap
 }
@@ -137,6 +143,14 @@ func (ss *SecurityScheme) Hash() [32]byte {
 		sb.WriteString(ss.OpenIdConnectUrl.Value)
 		sb.WriteByte('|')
 	}
+	if !ss.OAuth2MetadataUrl.IsEmpty() {
+		sb.WriteString(ss.OAuth2MetadataUrl.Value)
+		sb.WriteByte('|')
+	}
+	if !ss.Deprecated.IsEmpty() {
+		sb.WriteString(strconv.FormatBool(ss.Deprecated.Value))
+		sb.WriteByte('|')
+	}
 	for _, ext := range low.HashExtensions(ss.Extensions) {
 		sb.WriteString(ext)
 		sb.WriteByte('|')
@@ -100,3 +100,47 @@ func TestSecurityScheme_Build_Fail(t *testing.T) {
 	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
 	assert.Error(t, err)
 }
+
+func TestSecurityScheme_Build_OAuth2Metadata(t *testing.T) {
+	yml := `type: oauth2
+oauth2MetadataUrl: https://pb33f.io/.well-known/oauth-authorization-server
+deprecated: true
+flows:
+  deviceAuthorization:
+    deviceAuthorizationUrl: https://pb33f.io/device
+    tokenUrl: https://pb33f.io/token`
+
+	var idxNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &idxNode)
+	idx := index.NewSpecIndex(&idxNode)
+
+	var n SecurityScheme
+	err := low.BuildModel(idxNode.Content[0], &n)
+	assert.NoError(t, err)
+
+	err = n.Build(context.Background(), nil, idxNode.Content[0], idx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://pb33f.io/.well-known/oauth-authorization-server", n.OAuth2MetadataUrl.Value)
+	assert.True(t, n.Deprecated.Value)
+	assert.Equal(t, "https://pb33f.io/device",
+		n.Flows.Value.DeviceAuthorization.Value.DeviceAuthorizationUrl.Value)
+
+	yml2 := `type: oauth2
+oauth2MetadataUrl: https://pb33f.io/.well-known/oauth-authorization-server
+flows:
+  deviceAuthorization:
+    deviceAuthorizationUrl: https://pb33f.io/device
+    tokenUrl: https://pb33f.io/token`
+
+	var idxNode2 yaml.Node
+	_ = yaml.Unmarshal([]byte(yml2), &idxNode2)
+	idx2 := index.NewSpecIndex(&idxNode2)
+
+	var n2 SecurityScheme
+	_ = low.BuildModel(idxNode2.Content[0], &n2)
+	_ = n2.Build(context.Background(), nil, idxNode2.Content[0], idx2)
+
+	// deprecating a scheme changes the hash.
+	assert.NotEqual(t, n.Hash(), n2.Hash())
+}
@@ -103,6 +103,7 @@ func ExtractSpecInfoWithDocumentCheck(spec []byte, bypass bool) (*SpecInfo, erro
 	_, openAPI3 := utils.FindKeyNode(utils.OpenApi3, parsedSpec.Content)
 	_, openAPI2 := utils.FindKeyNode(utils.OpenApi2, parsedSpec.Content)
 	_, asyncAPI := utils.FindKeyNode(utils.AsyncApi, parsedSpec.Content)
+	_, arazzo := utils.FindKeyNode(utils.Arazzo, parsedSpec.Content)
 
 	parseJSON := func(bytes []byte, spec *SpecInfo, parsedNode *yaml.Node) {
 		var jsonSpec map[string]interface{}
@@ -212,6 +213,32 @@ func ExtractSpecInfoWithDocumentCheck(spec []byte, bypass bool) (*SpecInfo, erro
 		}
 	}
 
+	if arazzo != nil && specInfo.SpecType == "" {
+		version, majorVersion, versionErr := parseVersionTypeData(arazzo.Value)
+		if versionErr != nil {
+			if !bypass {
+				return nil, versionErr
+			}
+		}
+
+		specInfo.SpecType = utils.Arazzo
+		specInfo.Version = version
+		specInfo.SpecFormat = Arazzo1
+		specInfo.VersionNumeric = 1.0
+
+		// parse JSON
+		parseJSON(spec, specInfo, &parsedSpec)
+		parsed = true
+
+		// only version 1 of Arazzo exists.
+		if majorVersion != 1 {
+			if !bypass {
+				specInfo.Error = errors.New("spec is defined as arazzo, but has a major version that is not supported")
+				return specInfo, specInfo.Error
+			}
+		}
+	}
+
 	if specInfo.SpecType == "" {
 		// parse JSON
 		if !bypass {
@@ -257,6 +257,32 @@ func TestExtractSpecInfo_AsyncAPI_OddVersion(t *testing.T) {
 		"spec is defined as asyncapi, but has a major version that is invalid", e.Error())
 }
 
+func TestExtractSpecInfo_Arazzo(t *testing.T) {
+	yml := `arazzo: 1.0.1
+info:
+  title: workflows
+  version: 1.0.0`
+	r, e := ExtractSpecInfo([]byte(yml))
+	assert.Nil(t, e)
+	assert.Equal(t, utils.Arazzo, r.SpecType)
+	assert.Equal(t, Arazzo1, r.SpecFormat)
+	assert.Equal(t, "1.0.1", r.Version)
+	assert.Greater(t, len(*r.SpecJSONBytes), 0)
+}
+
+func TestExtractSpecInfo_Arazzo_OddVersion(t *testing.T) {
+	_, e := ExtractSpecInfo([]byte(`arazzo: 2.0.0`))
+	assert.NotNil(t, e)
+	assert.Equal(t,
+		"spec is defined as arazzo, but has a major version that is not supported", e.Error())
+}
+
+func TestExtractSpecInfoWithDocumentCheck_Arazzo_Bypass(t *testing.T) {
+	r, e := ExtractSpecInfoWithDocumentCheck([]byte(`arazzo: 2.0.0`), true)
+	assert.Nil(t, e)
+	assert.Equal(t, utils.Arazzo, r.SpecType)
+}
+
 func TestExtractSpecInfo_BadVersion_OpenAPI3(t *testing.T) {
 	yml := `openapi:
  should: fail`
@@ -16,13 +16,18 @@ package libopenapi
 import (
 	"errors"
 	"fmt"
-	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"net/url"
+	"path"
+	"path/filepath"
 
 	"github.com/pb33f/libopenapi/index"
 
 	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/high/arazzo"
 	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	arazzolow "github.com/pb33f/libopenapi/datamodel/low/arazzo"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
 	v2low "github.com/pb33f/libopenapi/datamodel/low/v2"
 	v3low "github.com/pb33f/libopenapi/datamodel/low/v3"
 	"github.com/pb33f/libopenapi/utils"
@@ -112,11 +117,12 @@ type document struct {
 	config            *datamodel.DocumentConfiguration
 	highOpenAPI3Model *DocumentModel[v3high.Document]
 	highSwaggerModel  *DocumentModel[v2high.Swagger]
+	highArazzoModel   *DocumentModel[arazzo.Arazzo]
 }
 
-// DocumentModel represents either a Swagger document (version 2) or an OpenAPI document (version 3) that is
-// built from a parent Document.
-type DocumentModel[T v2high.Swagger | v3high.Document] struct {
+// DocumentModel represents either a Swagger document (version 2), an OpenAPI document (version 3) or an Arazzo
+// document that is built from a parent Document.
+type DocumentModel[T v2high.Swagger | v3high.Document | arazzo.Arazzo] struct {
 	Model T
 	Index *index.SpecIndex // index created from the document.
 }
@@ -350,6 +356,136 @@ func (d *document) BuildV3Model() (*DocumentModel[v3high.Document], []error) {
 	return d.highOpenAPI3Model, errs
 }
 
+// BuildArazzoModel will build out an Arazzo (version 1) workflow model from the specification used to create the
+// supplied Document. If there are any issues, then no model will be returned, instead a slice of errors will explain
+// all the problems that occurred. This function will only support Arazzo specifications and will throw an error for
+// any other types.
+//
+// Documents created by NewDocument keep the model, so it's only built once, and their rolodex is the rolodex of the
+// Arazzo document.
+func BuildArazzoModel(doc Document) (*DocumentModel[arazzo.Arazzo], []error) {
+	if d, ok := doc.(*document); ok {
+		return d.buildArazzoModel()
+	}
+	if doc == nil {
+		return nil, []error{fmt.Errorf("unable to build arazzo document, no specification has been loaded")}
+	}
+	m, _, errs := buildArazzoModel(doc.GetSpecInfo(), doc.GetConfiguration())
+	return m, errs
+}
+
+func (d *document) buildArazzoModel() (*DocumentModel[arazzo.Arazzo], []error) {
+	if d.highArazzoModel != nil {
+		return d.highArazzoModel, nil
+	}
+	if d.config == nil {
+		d.config = datamodel.NewDocumentConfiguration()
+	}
+	m, rolodex, errs := buildArazzoModel(d.info, d.config)
+	if m == nil {
+		return nil, errs
+	}
+	d.rolodex = rolodex
+	d.highArazzoModel = m
+	return m, errs
+}
+
+// buildArazzoModel builds an Arazzo model and the rolodex it was built with.
+func buildArazzoModel(info *datamodel.SpecInfo, config *datamodel.DocumentConfiguration,
+) (*DocumentModel[arazzo.Arazzo], *index.Rolodex, []error) {
+	var errs []error
+	if info == nil {
+		errs = append(errs, fmt.Errorf("unable to build arazzo document, no specification has been loaded"))
+		return nil, nil, errs
+	}
+	if info.SpecFormat != datamodel.Arazzo1 {
+		errs = append(errs, fmt.Errorf("unable to build arazzo document, "+
+			"supplied spec is a different version (%v). Try 'BuildV3Model()'", info.SpecFormat))
+		return nil, nil, errs
+	}
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+
+	lowDoc, docErr := arazzolow.CreateDocumentFromConfig(info, config)
+	if lowDoc == nil {
+		return nil, nil, append(errs, docErr)
+	}
+	if docErr != nil {
+		errs = append(errs, utils.UnwrapErrors(docErr)...)
+	}
+
+	highDoc := arazzo.NewArazzo(lowDoc)
+	lowbase.SchemaQuickHashMap.Clear()
+	return &DocumentModel[arazzo.Arazzo]{
+		Model: *highDoc,
+		Index: lowDoc.Index,
+	}, lowDoc.Rolodex, errs
+}
+
+// LoadArazzoSources will build the Arazzo model of the supplied Document, and then load and build an OpenAPI model
+// for every OpenAPI source description of the workflow. The returned map is keyed by source description name,
+// and can be passed to arazzo.Arazzo.ResolveOperations to locate the operations called by each step.
+//
+// Source descriptions are opened using the rolodex of the Arazzo document, so the configuration of the document
+// must allow file references (or set a BasePath) for local sources, and remote references (or set a BaseURL) for
+// remote sources. Each OpenAPI document is built using the same configuration, relative to its own location.
+// Sources that cannot be loaded or built are left out of the map, and the problems are returned as errors.
+func LoadArazzoSources(doc Document) (map[string]*v3high.Document, []error) {
+	m, errs := BuildArazzoModel(doc)
+	if m == nil {
+		return nil, errs
+	}
+	errs = nil
+	sources := make(map[string]*v3high.Document)
+	rolodex := doc.GetRolodex()
+	if rolodex == nil && m.Index != nil {
+		rolodex = m.Index.GetRolodex()
+	}
+	if rolodex == nil {
+		return nil, []error{fmt.Errorf("unable to load source descriptions, the arazzo document has no rolodex")}
+	}
+	for _, source := range m.Model.SourceDescriptions {
+		if !source.IsOpenAPI() {
+			continue
+		}
+		f, err := rolodex.Open(source.URL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to load source description '%s': %w", source.Name, err))
+			continue
+		}
+
+		config := datamodel.NewDocumentConfiguration()
+		if doc.GetConfiguration() != nil {
+			c := *doc.GetConfiguration()
+			config = &c
+		}
+		location := f.GetFullPath()
+		if u, uErr := url.Parse(location); uErr == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			u.Path = path.Dir(u.Path)
+			config.BaseURL = u
+		} else {
+			config.BasePath = filepath.Dir(location)
+			config.SpecFilePath = location
+		}
+
+		sourceDoc, err := NewDocumentWithConfiguration([]byte(f.GetContent()), config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to load source description '%s': %w", source.Name, err))
+			continue
+		}
+		v3Model, v3Errs := sourceDoc.BuildV3Model()
+		for _, e := range v3Errs {
+			errs = append(errs, fmt.Errorf("source description '%s': %w", source.Name, e))
+		}
+		if v3Model == nil {
+			continue
+		}
+		sources[source.Name] = &v3Model.Model
+	}
+	return sources, errs
+}
+
 // CompareDocuments will accept a left and right Document implementing struct, build a model for the correct
 // version and then compare model documents for changes.
 //
@@ -357,6 +493,14 @@ func (d *document) BuildV3Model() (*DocumentModel[v3high.Document], []error) {
 // model.DocumentChanges. If there are any changes found however between either Document, then a pointer to
 // model.DocumentChanges is returned containing every single change, broken down, model by model.
 func CompareDocuments(original, updated Document) (*model.DocumentChanges, []error) {
+	return CompareDocumentsWithOptions(original, updated, nil)
+}
+
+// CompareDocumentsWithOptions compares two Document implementing structs like CompareDocuments, using the supplied
+// options (for example a breaking change ruleset). Nil options are the same as CompareDocuments.
+func CompareDocumentsWithOptions(original, updated Document,
+	options *model.CompareOptions,
+) (*model.DocumentChanges, []error) {
 	var errs []error
 	if original.GetSpecInfo().SpecType == utils.OpenApi3 && updated.GetSpecInfo().SpecType == utils.OpenApi3 {
 		v3ModelLeft, oErrs := original.BuildV3Model()
@@ -368,7 +512,8 @@ func CompareDocuments(original, updated Document) (*model.DocumentChanges, []err
 			errs = append(errs, uErrs...)
 		}
 		if v3ModelLeft != nil && v3ModelRight != nil {
-			return what_changed.CompareOpenAPIDocuments(v3ModelLeft.Model.GoLow(), v3ModelRight.Model.GoLow()), errs
+			return what_changed.CompareOpenAPIDocumentsWithOptions(v3ModelLeft.Model.GoLow(),
+				v3ModelRight.Model.GoLow(), options), errs
 		} else {
 			return nil, errs
 		}
@@ -382,7 +527,8 @@ func CompareDocuments(original, updated Document) (*model.DocumentChanges, []err
 		if len(uErrs) > 0 {
 			errs = append(errs, uErrs...)
 		}
-		return what_changed.CompareSwaggerDocuments(v2ModelLeft.Model.GoLow(), v2ModelRight.Model.GoLow()), errs
+		return what_changed.CompareSwaggerDocumentsWithOptions(v2ModelLeft.Model.GoLow(), v2ModelRight.Model.GoLow(),
+			options), errs
 	}
 	return nil, []error{fmt.Errorf("unable to compare documents, one or both documents are not of the same version")}
 }
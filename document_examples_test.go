@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	what_changed "github.com/pb33f/libopenapi/what-changed"
+	"github.com/pb33f/libopenapi/what-changed/model"
 
 	"github.com/pb33f/libopenapi/datamodel"
 	"github.com/pb33f/libopenapi/index"
@@ -329,8 +330,20 @@ func TestExampleCompareDocuments_openAPI(t *testing.T) {
 	schemaChanges := documentChanges.ComponentsChanges.SchemaChanges
 
 	// Print out some interesting stats about the OpenAPI document changes.
-	assert.Equal(t, `There are 75 changes, of which 20 are breaking. 6 schemas have changes.`, fmt.Sprintf("There are %d changes, of which %d are breaking. %v schemas have changes.",
+	assert.Equal(t, `There are 75 changes, of which 21 are breaking. 6 schemas have changes.`, fmt.Sprintf("There are %d changes, of which %d are breaking. %v schemas have changes.",
 		documentChanges.TotalChanges(), documentChanges.TotalBreakingChanges(), len(schemaChanges)))
+
+	// Drink is returned in responses, so adding an enum value to it breaks clients.
+	var enumChange *model.Change
+	for _, change := range schemaChanges["Drink"].GetAllChanges() {
+		if change.Property == v3.EnumLabel {
+			enumChange = change
+		}
+	}
+	if assert.NotNil(t, enumChange) {
+		assert.Equal(t, "sprite", enumChange.New)
+		assert.True(t, enumChange.Breaking)
+	}
 }
 
 func TestExampleCompareDocuments_swagger(t *testing.T) {
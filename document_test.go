@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -21,6 +22,7 @@ import (
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
+	"github.com/pb33f/libopenapi/validation"
 	"github.com/pb33f/libopenapi/what-changed/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -530,6 +532,125 @@ func TestDocument_BuildModelPreBuild(t *testing.T) {
 	assert.Len(t, er, 0)
 }
 
+func TestDocument_BuildArazzoModel(t *testing.T) {
+	workflows, _ := os.ReadFile("test_specs/petstore.arazzo.yaml")
+	doc, err := NewDocument(workflows)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.1", doc.GetVersion())
+
+	m, errs := BuildArazzoModel(doc)
+	assert.Empty(t, errs)
+	assert.Equal(t, "Petstore workflows", m.Model.Info.Title)
+	assert.NotNil(t, m.Index)
+	assert.NotNil(t, doc.GetRolodex())
+
+	again, _ := BuildArazzoModel(doc)
+	assert.Same(t, m, again)
+
+	_, errs = doc.BuildV3Model()
+	assert.Len(t, errs, 1)
+}
+
+func TestDocument_BuildArazzoModel_WrongVersion(t *testing.T) {
+	petstore, _ := os.ReadFile("test_specs/petstorev3.json")
+	doc, _ := NewDocument(petstore)
+	m, errs := BuildArazzoModel(doc)
+	assert.Nil(t, m)
+	assert.Equal(t, "unable to build arazzo document, supplied spec is a different version (oas3). "+
+		"Try 'BuildV3Model()'", errs[0].Error())
+
+	doc = &document{}
+	m, errs = BuildArazzoModel(doc)
+	assert.Nil(t, m)
+	assert.Equal(t, "unable to build arazzo document, no specification has been loaded", errs[0].Error())
+}
+
+func TestLoadArazzoSources(t *testing.T) {
+	workflows, _ := os.ReadFile("test_specs/petstore.arazzo.yaml")
+	doc, err := NewDocumentWithConfiguration(workflows, &datamodel.DocumentConfiguration{
+		BasePath: "test_specs",
+	})
+	require.NoError(t, err)
+
+	sources, errs := LoadArazzoSources(doc)
+	assert.Empty(t, errs)
+	assert.Len(t, sources, 1)
+	assert.Equal(t, "Swagger Petstore - OpenAPI 3.0", sources["petstore"].Info.Title)
+
+	m, _ := BuildArazzoModel(doc)
+	assert.NoError(t, m.Model.ResolveOperations(sources))
+	step := m.Model.Workflows[0].FindStep("findPets")
+	assert.Equal(t, "findPetsByStatus", step.Operation().Operation.OperationId)
+}
+
+// a local file with a name that starts with 'http' is not a URL.
+func TestLoadArazzoSources_LocalHttpName(t *testing.T) {
+	dir := t.TempDir()
+	petstore, _ := os.ReadFile("test_specs/petstorev3.json")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "httpbin.json"), petstore, 0o644))
+
+	yml := `arazzo: 1.0.1
+sourceDescriptions:
+  - name: petstore
+    url: httpbin.json`
+	doc, err := NewDocumentWithConfiguration([]byte(yml), &datamodel.DocumentConfiguration{BasePath: dir})
+	require.NoError(t, err)
+
+	sources, errs := LoadArazzoSources(doc)
+	assert.Empty(t, errs)
+	assert.Equal(t, "Swagger Petstore - OpenAPI 3.0", sources["petstore"].Info.Title)
+}
+
+// documents that are not created by NewDocument can build Arazzo models too.
+type wrappedDocument struct {
+	Document
+}
+
+func TestLoadArazzoSources_WrappedDocument(t *testing.T) {
+	workflows, _ := os.ReadFile("test_specs/petstore.arazzo.yaml")
+	doc, err := NewDocumentWithConfiguration(workflows, &datamodel.DocumentConfiguration{
+		BasePath: "test_specs",
+	})
+	require.NoError(t, err)
+
+	wrapped := &wrappedDocument{Document: doc}
+	m, errs := BuildArazzoModel(wrapped)
+	assert.Empty(t, errs)
+	assert.Equal(t, "Petstore workflows", m.Model.Info.Title)
+
+	sources, errs := LoadArazzoSources(wrapped)
+	assert.Empty(t, errs)
+	assert.Len(t, sources, 1)
+}
+
+func TestLoadArazzoSources_Missing(t *testing.T) {
+	yml := `arazzo: 1.0.1
+sourceDescriptions:
+  - name: petstore
+    url: not-here.yaml
+  - name: flows
+    url: flows.arazzo.yaml
+    type: arazzo`
+
+	// without a base path, the rolodex cannot open anything.
+	doc, _ := NewDocument([]byte(yml))
+	sources, errs := LoadArazzoSources(doc)
+	assert.Empty(t, sources)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "unable to load source description 'petstore'")
+
+	doc, _ = NewDocumentWithConfiguration([]byte(yml), &datamodel.DocumentConfiguration{BasePath: "test_specs"})
+	sources, errs = LoadArazzoSources(doc)
+	assert.Empty(t, sources)
+	assert.Len(t, errs, 1)
+
+	petstore, _ := os.ReadFile("test_specs/petstorev3.json")
+	doc, _ = NewDocument(petstore)
+	sources, errs = LoadArazzoSources(doc)
+	assert.Nil(t, sources)
+	assert.Len(t, errs, 1)
+}
+
 func TestDocument_AnyDoc(t *testing.T) {
 	anything := []byte(`{"chickens": "3.0.0", "burgers": {"title": "hello"}}`)
 	_, e := NewDocumentWithTypeCheck(anything, true)
@@ -624,6 +745,25 @@ func TestDocument_BuildModel_CompareDocsV3_LeftError(t *testing.T) {
 	assert.Nil(t, changes)
 }
 
+func TestDocument_CompareDocumentsWithOptions(t *testing.T) {
+	spec := "openapi: 3.1.0\npaths:\n  /burgers:\n    get:\n      parameters:\n        - name: size\n" +
+		"          in: query\n          style: %s"
+	originalDoc, _ := NewDocument([]byte(fmt.Sprintf(spec, "form")))
+	updatedDoc, _ := NewDocument([]byte(fmt.Sprintf(spec, "spaceDelimited")))
+
+	changes, errs := CompareDocuments(originalDoc, updatedDoc)
+	assert.Empty(t, errs)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	breaking := true
+	rules := model.NewBreakingRules()
+	rules.SetRule(model.ObjectParameter, "style", &model.BreakingRule{Modified: &breaking})
+	changes, errs = CompareDocumentsWithOptions(originalDoc, updatedDoc, &model.CompareOptions{BreakingRules: rules})
+	assert.Empty(t, errs)
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+}
+
 func TestDocument_BuildModel_CompareDocsV3_RightError(t *testing.T) {
 	burgerShopOriginal, _ := os.ReadFile("test_specs/badref-burgershop.openapi.yaml")
 	burgerShopUpdated, _ := os.ReadFile("test_specs/burgershop.openapi-modified.yaml")
@@ -1397,6 +1537,27 @@ func TestDocument_TestNestedFiles(t *testing.T) {
 
 	_, errs := doc.BuildV3Model()
 	require.Empty(t, errs)
+
+	// every file is valid.
+	validationErrs, err := validation.ValidateDocument(doc.GetSpecInfo(), doc.GetRolodex())
+	require.NoError(t, err)
+	assert.Empty(t, validationErrs)
+}
+
+func TestDocument_Validate(t *testing.T) {
+	doc, err := NewDocument([]byte("openapi: 3.1.0\ninfo:\n  title: pets\npaths: {}"))
+	require.NoError(t, err)
+
+	errs, err := validation.ValidateDocument(doc.GetSpecInfo(), doc.GetRolodex())
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "/info (line 2, column 1): missing required property 'version'", errs[0].Error())
+
+	spec, _ := os.ReadFile("test_specs/petstore.arazzo.yaml")
+	doc, err = NewDocument(spec)
+	require.NoError(t, err)
+	_, err = validation.ValidateDocument(doc.GetSpecInfo(), doc.GetRolodex())
+	assert.ErrorIs(t, err, validation.ErrNoMetaSchema)
 }
 
 func TestDocument_MinimalRemoteRefs(t *testing.T) {
@@ -1567,3 +1728,48 @@ components:
 
 	assert.Equal(t, expected, strings.TrimSpace(string(rend)))
 }
+
+func TestDocument_SchemaAnchorsAndIds(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: anchors
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      $anchor: pet
+      description: a pet
+      type: object
+    Customer:
+      $id: https://example.com/schemas/customer
+      type: object
+      properties:
+        pet:
+          $ref: "#/components/schemas/Pet"
+        address:
+          $ref: address
+      $defs:
+        address:
+          $id: address
+          description: an address
+          type: object
+    Owner:
+      type: object
+      properties:
+        pet:
+          $ref: "#pet"`
+
+	doc, err := NewDocument([]byte(spec))
+	assert.NoError(t, err)
+
+	model, errs := doc.BuildV3Model()
+	assert.Empty(t, errs)
+
+	owner, _ := model.Model.Components.Schemas.Get("Owner")
+	pet, _ := owner.Schema().Properties.Get("pet")
+	assert.Equal(t, "a pet", pet.Schema().Description)
+
+	customer, _ := model.Model.Components.Schemas.Get("Customer")
+	address, _ := customer.Schema().Properties.Get("address")
+	assert.Equal(t, "an address", address.Schema().Description)
+}
@@ -45,7 +45,7 @@ func (index *SpecIndex) ExtractRefs(ctx context.Context, node, parent *yaml.Node
 			// check if we're dealing with an inline schema definition, that isn't part of an array
 			// (which means it's being used as a value in an array, and it's not a label)
 			// https://github.com/pb33f/libopenapi/issues/76
-			schemaContainingNodes := []string{"schema", "items", "additionalProperties", "contains", "not", "unevaluatedItems", "unevaluatedProperties"}
+			schemaContainingNodes := []string{"schema", "itemSchema", "items", "additionalProperties", "contains", "not", "unevaluatedItems", "unevaluatedProperties"}
 			if i%2 == 0 && slices.Contains(schemaContainingNodes, n.Value) && !utils.IsNodeArray(node) && (i+1 < len(node.Content)) {
 
 				var jsonPath, definitionPath, fullDefinitionPath string
@@ -360,6 +360,12 @@ func (index *SpecIndex) ExtractRefs(ctx context.Context, node, parent *yaml.Node
 						}
 					}
 
+					// JSON Schema '$id' scopes and plain name '#anchor' fragments take precedence over file paths.
+					if resolved, ok := index.resolveSchemaIdentifierRef(node, value); ok {
+						fullDefinitionPath = resolved
+						componentName = value
+					}
+
 					_, p := utils.ConvertComponentIdIntoFriendlyPathSearch(componentName)
 
 					ref := &Reference{
@@ -213,3 +213,37 @@ components:
 	assert.Len(t, idx.allRefs, 0)
 	assert.Len(t, idx.refErrors, 0)
 }
+
+func TestSpecIndex_ExtractRefs_CheckItemSchemaForInlineSchema(t *testing.T) {
+	yml := `openapi: 3.2.0
+paths:
+  /events:
+    get:
+      responses:
+        '200':
+          description: OK
+          content:
+            text/event-stream:
+              itemSchema:
+                type: object
+                properties:
+                  data:
+                    type: string
+            application/jsonl:
+              itemSchema:
+                $ref: '#/components/schemas/Event'
+components:
+  schemas:
+    Event:
+      type: string`
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &rootNode)
+	c := CreateOpenAPIIndexConfig()
+	idx := NewSpecIndexWithConfig(&rootNode, c)
+
+	schemas := idx.GetAllInlineSchemas()
+	assert.Len(t, schemas, 2)
+	assert.Equal(t, "$.paths['/events'].get.responses['200'].content['text/event-stream'].itemSchema", schemas[0].Path)
+	assert.Len(t, idx.GetAllInlineSchemaObjects(), 1)
+	assert.Len(t, idx.GetAllReferenceSchemas(), 1)
+}
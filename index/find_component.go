@@ -25,6 +25,11 @@ func (index *SpecIndex) FindComponent(ctx context.Context, componentId string) *
 		return nil
 	}
 
+	// components identified by a JSON Schema '$id' or '$anchor' are not located by file path.
+	if ref := index.findSchemaIdentifierComponent(ctx, componentId); ref != nil {
+		return ref
+	}
+
 	uri := strings.Split(componentId, "#/")
 	if len(uri) == 2 {
 		if uri[0] != "" {
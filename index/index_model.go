@@ -335,6 +335,12 @@ type SpecIndex struct {
 	allSummaries                        []*DescriptionReference                       // every single summary found in the spec.
 	allEnums                            []*EnumReference                              // every single enum found in the spec.
 	allObjectsWithProperties            []*ObjectReference                            // every single object with properties found in the spec.
+	schemaIds                           map[string]*SchemaIdReference                 // every '$id' declared in the spec, keyed by resolved URI.
+	schemaAnchors                       map[string]*SchemaAnchorReference             // every '$anchor' declared in the spec, keyed by base URI and name.
+	dynamicAnchors                      map[string]*SchemaAnchorReference             // every '$dynamicAnchor' declared in the spec, keyed by base URI and name.
+	dynamicRefs                         []*DynamicReference                           // every '$dynamicRef' found in the spec.
+	schemaRefBases                      map[*yaml.Node]string                         // base URIs of $ref nodes found inside an '$id' scope.
+	schemaIdentifierRefs                map[*yaml.Node]string                         // $ref nodes resolved using '$id' scopes or anchors.
 	enumCount                           int
 	descriptionCount                    int
 	summaryCount                        int
@@ -429,6 +435,46 @@ type EnumReference struct {
 	ParentNode *yaml.Node
 }
 
+// SchemaIdReference holds data about a JSON Schema '$id' declaration, which establishes a new base URI for
+// every relative reference made by the schema and its sub-schemas.
+type SchemaIdReference struct {
+	Id          string     // the '$id' value, as declared.
+	ResolvedURI string     // the '$id' resolved against the base URI of the enclosing schema resource.
+	BaseURI     string     // the base URI the '$id' was resolved against.
+	Node        *yaml.Node // the schema declaring the '$id'.
+	KeyNode     *yaml.Node // the '$id' key node.
+	Path        string
+	ParentNode  *yaml.Node
+}
+
+// SchemaAnchorReference holds data about a JSON Schema '$anchor' or '$dynamicAnchor' declaration.
+type SchemaAnchorReference struct {
+	Name       string     // the plain name of the anchor.
+	BaseURI    string     // the base URI of the schema resource that the anchor belongs to.
+	Dynamic    bool       // true if the anchor was declared using '$dynamicAnchor'.
+	Node       *yaml.Node // the schema declaring the anchor.
+	KeyNode    *yaml.Node // the '$anchor' or '$dynamicAnchor' key node.
+	Path       string
+	ParentNode *yaml.Node
+}
+
+// URI returns the full URI of the anchor, which is the base URI and the name as a fragment.
+func (s *SchemaAnchorReference) URI() string {
+	return s.BaseURI + "#" + s.Name
+}
+
+// DynamicReference holds data about a JSON Schema '$dynamicRef'. Scope contains the base URIs of every schema resource
+// enclosing the reference (outermost first), which is the dynamic scope when the document is read top-down.
+type DynamicReference struct {
+	Value    string     // the '$dynamicRef' value, as declared.
+	BaseURI  string     // the base URI the value is resolved against.
+	Scope    []string   // the base URIs of every enclosing schema resource, outermost first.
+	Node     *yaml.Node // the schema containing the '$dynamicRef'.
+	KeyNode  *yaml.Node // the '$dynamicRef' key node.
+	Path     string
+	Resolved *Reference // the schema the reference resolved to using Scope, nil if it cannot be resolved.
+}
+
 type ObjectReference struct {
 	Node       *yaml.Node
 	KeyNode    *yaml.Node
@@ -436,4 +482,4 @@ type ObjectReference struct {
 	ParentNode *yaml.Node
 }
 
-var methodTypes = []string{"get", "post", "put", "patch", "options", "head", "delete"}
+var methodTypes = []string{"get", "post", "put", "patch", "options", "head", "delete", "query"}
@@ -6,6 +6,8 @@ package index
 import (
 	"strings"
 	"sync"
+
+	"gopkg.in/yaml.v3"
 )
 
 func isHttpMethod(val string) bool {
@@ -24,6 +26,8 @@ func isHttpMethod(val string) bool {
 		return true
 	case methodTypes[6]:
 		return true
+	case methodTypes[7]:
+		return true
 	}
 	return false
 }
@@ -68,4 +72,9 @@ func boostrapIndexCollections(index *SpecIndex) {
 	index.componentIndexChan = make(chan struct{})
 	index.polyComponentIndexChan = make(chan struct{})
 	index.allComponentPathItems = make(map[string]*Reference)
+	index.schemaIds = make(map[string]*SchemaIdReference)
+	index.schemaAnchors = make(map[string]*SchemaAnchorReference)
+	index.dynamicAnchors = make(map[string]*SchemaAnchorReference)
+	index.schemaRefBases = make(map[*yaml.Node]string)
+	index.schemaIdentifierRefs = make(map[*yaml.Node]string)
 }
@@ -607,6 +607,16 @@ func (resolver *Resolver) extractRelatives(ref *Reference, node, parent *yaml.No
 					}
 				}
 
+				// JSON Schema '$id' scopes and plain name '#anchor' fragments were resolved when indexing.
+				refIndex := ref.Index
+				if refIndex == nil {
+					refIndex = resolver.specIndex
+				}
+				if resolved, ok := refIndex.findSchemaIdentifierRef(node); ok {
+					fullDef = resolved
+					definition = value
+				}
+
 				searchRef := &Reference{
 					Definition:     definition,
 					FullDefinition: fullDef,
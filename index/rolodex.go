@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"maps"
 	"math"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -403,6 +404,13 @@ func (r *Rolodex) IndexTheRolodex(ctx context.Context) error {
 			}
 		}
 
+		// OpenAPI 3.2 documents can declare their own URI using '$self', when present it becomes the base
+		// used to resolve relative references.
+		if self := resolveDocumentSelf(r.rootNode, r.indexConfig); self != "" {
+			r.logger.Debug("[rolodex] using $self as document base", "$self", self)
+			r.indexConfig.SpecAbsolutePath = self
+		}
+
 		// Here we take the root node and also build the index for it.
 		// This involves extracting references.
 		index := NewSpecIndexWithConfigAndContext(ctx, r.rootNode, r.indexConfig)
@@ -448,6 +456,58 @@ func (r *Rolodex) IndexTheRolodex(ctx context.Context) error {
 	return errors.Join(caughtErrors...)
 }
 
+// resolveDocumentSelf extracts the '$self' URI (OpenAPI 3.2+) from the root of a document and resolves it against
+// the location the document was loaded from (RFC 3986). Local locations are returned as file paths. An empty string
+// is returned if there is no '$self' value, or if a relative '$self' cannot be resolved.
+func resolveDocumentSelf(root *yaml.Node, config *SpecIndexConfig) string {
+	if root == nil || len(root.Content) == 0 {
+		return ""
+	}
+	_, _, selfNode := utils.FindKeyNodeFullTop("$self", root.Content[0].Content)
+	if selfNode == nil || !utils.IsNodeStringValue(selfNode) || selfNode.Value == "" {
+		return ""
+	}
+	self, err := url.Parse(selfNode.Value)
+	if err != nil {
+		return ""
+	}
+	base := retrievalURI(config)
+	if base == nil {
+		if self.IsAbs() {
+			return self.String()
+		}
+		return ""
+	}
+	resolved := base.ResolveReference(self)
+	if resolved.Scheme == "file" {
+		return filepath.FromSlash(resolved.Path)
+	}
+	return resolved.String()
+}
+
+// retrievalURI returns the URI a root document was loaded from, local files use the file scheme. Nil is returned if
+// the location of the document is not known.
+func retrievalURI(config *SpecIndexConfig) *url.URL {
+	if u, err := url.Parse(config.SpecAbsolutePath); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return u
+	}
+	if config.BaseURL != nil {
+		base := *config.BaseURL
+		if !strings.HasSuffix(base.Path, "/") {
+			base.Path += "/"
+		}
+		return &base
+	}
+	if config.SpecAbsolutePath != "" {
+		return &url.URL{Scheme: "file", Path: filepath.ToSlash(config.SpecAbsolutePath)}
+	}
+	if config.BasePath != "" {
+		basePath, _ := filepath.Abs(config.BasePath)
+		return &url.URL{Scheme: "file", Path: filepath.ToSlash(basePath) + "/"}
+	}
+	return nil
+}
+
 // CheckForCircularReferences checks for circular references in the rolodex.
 func (r *Rolodex) CheckForCircularReferences() {
 	if !r.circChecked {
@@ -571,7 +631,7 @@ func (r *Rolodex) OpenWithContext(ctx context.Context, location string) (Rolodex
 	var remoteFile *RemoteFile
 	fileLookup := location
 	isUrl := false
-	if strings.HasPrefix(location, "http") {
+	if u, err := url.Parse(location); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
 		isUrl = true
 	}
 
@@ -2178,3 +2178,54 @@ func (tfi *testFileInfo) Mode() fs.FileMode  { return 0644 }
 func (tfi *testFileInfo) ModTime() time.Time { return time.Now() }
 func (tfi *testFileInfo) IsDir() bool        { return false }
 func (tfi *testFileInfo) Sys() any           { return nil }
+
+func TestResolveDocumentSelf(t *testing.T) {
+	parse := func(spec string) *yaml.Node {
+		var n yaml.Node
+		_ = yaml.Unmarshal([]byte(spec), &n)
+		return &n
+	}
+
+	cf := CreateOpenAPIIndexConfig()
+	assert.Equal(t, "", resolveDocumentSelf(nil, cf))
+	assert.Equal(t, "", resolveDocumentSelf(parse("openapi: 3.2.0"), cf))
+	assert.Equal(t, "", resolveDocumentSelf(parse("$self:\n  nope: true"), cf))
+	assert.Equal(t, "", resolveDocumentSelf(parse("$self: \"%zz\""), cf))
+	assert.Equal(t, "", resolveDocumentSelf(parse("$self: openapi.yaml"), cf))
+
+	assert.Equal(t, "https://example.com/openapi.yaml",
+		resolveDocumentSelf(parse("$self: https://example.com/openapi.yaml"), cf))
+
+	// without a location, a path can't be resolved.
+	assert.Equal(t, "", resolveDocumentSelf(parse("$self: /specs/openapi.yaml"), cf))
+
+	cf.SpecAbsolutePath = "https://example.com/specs/root.yaml"
+	assert.Equal(t, "https://example.com/specs/v2/openapi.yaml",
+		resolveDocumentSelf(parse("$self: v2/openapi.yaml"), cf))
+	assert.Equal(t, "https://example.com/v2/openapi.yaml",
+		resolveDocumentSelf(parse("$self: ../v2/openapi.yaml"), cf))
+	assert.Equal(t, "https://example.com/shared/openapi.yaml",
+		resolveDocumentSelf(parse("$self: /shared/openapi.yaml"), cf))
+	assert.Equal(t, "https://pb33f.io/openapi.yaml",
+		resolveDocumentSelf(parse("$self: //pb33f.io/openapi.yaml"), cf))
+
+	cf.SpecAbsolutePath = "/tmp/specs/root.yaml"
+	assert.Equal(t, filepath.FromSlash("/tmp/specs/v2/openapi.yaml"),
+		resolveDocumentSelf(parse("$self: v2/openapi.yaml"), cf))
+	assert.Equal(t, filepath.FromSlash("/shared/openapi.yaml"),
+		resolveDocumentSelf(parse("$self: /shared/openapi.yaml"), cf))
+
+	cf.SpecAbsolutePath = ""
+	cf.BaseURL, _ = url.Parse("https://example.com/specs")
+	assert.Equal(t, "https://example.com/specs/v2/openapi.yaml",
+		resolveDocumentSelf(parse("$self: v2/openapi.yaml"), cf))
+
+	// a path is resolved against the host of the base URL, not the local file system.
+	assert.Equal(t, "https://example.com/shared/openapi.yaml",
+		resolveDocumentSelf(parse("$self: /shared/openapi.yaml"), cf))
+
+	cf.BaseURL = nil
+	cf.BasePath = "/tmp/base"
+	assert.Equal(t, "/tmp/base/openapi.yaml",
+		resolveDocumentSelf(parse("$self: openapi.yaml"), cf))
+}
@@ -0,0 +1,375 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// JSON Schema 2020-12 keywords used to identify schema resources and locations within them.
+const (
+	schemaIdLabel            = "$id"
+	schemaAnchorLabel        = "$anchor"
+	schemaDynamicAnchorLabel = "$dynamicAnchor"
+	schemaDynamicRefLabel    = "$dynamicRef"
+)
+
+// values of these keys are instance data, not schemas, so identifiers found inside them are ignored.
+var schemaIdentifierDataKeys = []string{"example", "examples", "default", "const", "enum"}
+
+// GetAllSchemaIds returns every '$id' declared in the specification, keyed by the fully resolved URI.
+func (index *SpecIndex) GetAllSchemaIds() map[string]*SchemaIdReference {
+	return index.schemaIds
+}
+
+// GetAllSchemaAnchors returns every '$anchor' declared in the specification, keyed by the full URI of the
+// anchor (the base URI of the schema resource and the anchor name as the fragment).
+func (index *SpecIndex) GetAllSchemaAnchors() map[string]*SchemaAnchorReference {
+	return index.schemaAnchors
+}
+
+// GetAllDynamicAnchors returns every '$dynamicAnchor' declared in the specification, keyed by the full URI of the
+// anchor (the base URI of the schema resource and the anchor name as the fragment).
+func (index *SpecIndex) GetAllDynamicAnchors() map[string]*SchemaAnchorReference {
+	return index.dynamicAnchors
+}
+
+// GetAllDynamicReferences returns every '$dynamicRef' found in the specification.
+func (index *SpecIndex) GetAllDynamicReferences() []*DynamicReference {
+	return index.dynamicRefs
+}
+
+// GetSchemaIdentifierReference will return the mapped reference for a $ref node that was resolved using a
+// JSON Schema '$id' base URI, or a plain name '#anchor' fragment. Returns nil if the node was resolved using file
+// paths and JSON pointers, or was never indexed.
+func (index *SpecIndex) GetSchemaIdentifierReference(refNode *yaml.Node) *Reference {
+	if index == nil || refNode == nil {
+		return nil
+	}
+	if fullDef, ok := index.schemaIdentifierRefs[refNode]; ok {
+		index.refLock.Lock()
+		defer index.refLock.Unlock()
+		return index.allMappedRefs[fullDef]
+	}
+	return nil
+}
+
+// ResolveDynamicReference resolves a '$dynamicRef' using the supplied dynamic scope (base URIs of every schema
+// resource entered during evaluation, outermost first). If scope is nil, the lexical scope captured when the
+// reference was indexed is used. Identifiers declared in any other index of the rolodex are also searched.
+//
+// The reference is first resolved like a $ref. If the target is a '$dynamicAnchor' with the same name, the outermost
+// schema resource in the dynamic scope declaring a '$dynamicAnchor' with that name is used instead.
+func (index *SpecIndex) ResolveDynamicReference(dynamicRef *DynamicReference, scope []string) *Reference {
+	if dynamicRef == nil {
+		return nil
+	}
+	if scope == nil {
+		scope = dynamicRef.Scope
+	}
+	resolved := resolveSchemaURI(dynamicRef.BaseURI, dynamicRef.Value)
+	initial := index.findSchemaIdentifierComponent(context.Background(), resolved)
+	if initial == nil {
+		resource, fragment, _ := strings.Cut(resolved, "#")
+		if resource == index.schemaDocumentBase() && strings.HasPrefix(fragment, "/") {
+			initial = index.FindComponentInRoot(context.Background(), "#"+fragment)
+		}
+	}
+	if initial == nil {
+		return nil
+	}
+
+	// only a '$dynamicAnchor' target (the 'bookend') enables dynamic resolution.
+	resource, fragment, _ := strings.Cut(resolved, "#")
+	if !isSchemaAnchorFragment(fragment) {
+		return initial
+	}
+	if a, _ := index.findDynamicAnchor(resource + "#" + fragment); a == nil {
+		return initial
+	}
+	for _, s := range scope {
+		if a, owner := index.findDynamicAnchor(s + "#" + fragment); a != nil {
+			return owner.schemaAnchorReference(a, a.URI())
+		}
+	}
+	return initial
+}
+
+// schemaIdentifierIndexes returns this index, followed by every other index in the rolodex. Schema identifiers
+// are not bound to a file, so a '$id' or an anchor declared in one file can be referenced from any other.
+func (index *SpecIndex) schemaIdentifierIndexes() []*SpecIndex {
+	indexes := []*SpecIndex{index}
+	if index.rolodex == nil {
+		return indexes
+	}
+	for _, idx := range append([]*SpecIndex{index.rolodex.GetRootIndex()}, index.rolodex.GetIndexes()...) {
+		if idx != nil && !slices.Contains(indexes, idx) {
+			indexes = append(indexes, idx)
+		}
+	}
+	return indexes
+}
+
+// findSchemaIdentifierRef returns the fully resolved URI recorded for a $ref node by any index in the rolodex.
+// A node being resolved may belong to a file other than the one that referenced it.
+func (index *SpecIndex) findSchemaIdentifierRef(refNode *yaml.Node) (string, bool) {
+	for _, idx := range index.schemaIdentifierIndexes() {
+		if resolved, ok := idx.schemaIdentifierRefs[refNode]; ok {
+			return resolved, true
+		}
+	}
+	return "", false
+}
+
+// findDynamicAnchor locates a '$dynamicAnchor' by its full URI across the rolodex, returning the anchor and
+// the index it was declared in.
+func (index *SpecIndex) findDynamicAnchor(uri string) (*SchemaAnchorReference, *SpecIndex) {
+	for _, idx := range index.schemaIdentifierIndexes() {
+		if a, ok := idx.dynamicAnchors[uri]; ok {
+			return a, idx
+		}
+	}
+	return nil, nil
+}
+
+// schemaDocumentBase returns the base URI of the document being indexed.
+func (index *SpecIndex) schemaDocumentBase() string {
+	return index.specAbsolutePath
+}
+
+// extractSchemaIdentifiers walks the document and records every '$id', '$anchor', '$dynamicAnchor' and
+// '$dynamicRef' found, along with the base URI of every $ref made from within an '$id' scope. This runs before
+// references are extracted, so relative references can be resolved against the correct base URI.
+func (index *SpecIndex) extractSchemaIdentifiers(node, parent *yaml.Node, base string, scope, seenPath []string) {
+	if node == nil {
+		return
+	}
+	node = utils.NodeAlias(node)
+	if utils.IsNodeArray(node) {
+		for i, n := range node.Content {
+			index.extractSchemaIdentifiers(n, node, base, scope, append(seenPath, strconv.Itoa(i)))
+		}
+		return
+	}
+	if !utils.IsNodeMap(node) {
+		return
+	}
+
+	_, jsonPath := utils.ConvertComponentIdIntoFriendlyPathSearch("#/" + strings.Join(seenPath, "/"))
+
+	// a new '$id' creates a new schema resource, with a new base URI.
+	if k, v := schemaKeywordNodes(schemaIdLabel, node); v != nil {
+		resolved, _, _ := strings.Cut(resolveSchemaURI(base, v.Value), "#")
+		if _, ok := index.schemaIds[resolved]; !ok {
+			index.schemaIds[resolved] = &SchemaIdReference{
+				Id:          v.Value,
+				ResolvedURI: resolved,
+				BaseURI:     base,
+				Node:        node,
+				KeyNode:     k,
+				Path:        jsonPath,
+				ParentNode:  parent,
+			}
+		}
+		base = resolved
+		scope = append(append([]string{}, scope...), resolved)
+	}
+
+	if k, v := schemaKeywordNodes(schemaAnchorLabel, node); v != nil {
+		a := &SchemaAnchorReference{Name: v.Value, BaseURI: base, Node: node, KeyNode: k, Path: jsonPath, ParentNode: parent}
+		if _, ok := index.schemaAnchors[a.URI()]; !ok {
+			index.schemaAnchors[a.URI()] = a
+		}
+	}
+	if k, v := schemaKeywordNodes(schemaDynamicAnchorLabel, node); v != nil {
+		a := &SchemaAnchorReference{Name: v.Value, BaseURI: base, Dynamic: true, Node: node, KeyNode: k, Path: jsonPath, ParentNode: parent}
+		if _, ok := index.dynamicAnchors[a.URI()]; !ok {
+			index.dynamicAnchors[a.URI()] = a
+		}
+	}
+	if _, v := schemaKeywordNodes("$ref", node); v != nil && base != index.schemaDocumentBase() {
+		index.schemaRefBases[node] = base
+	}
+	if k, v := schemaKeywordNodes(schemaDynamicRefLabel, node); v != nil {
+		index.dynamicRefs = append(index.dynamicRefs, &DynamicReference{
+			Value:   v.Value,
+			BaseURI: base,
+			Scope:   append([]string{}, scope...),
+			Node:    node,
+			KeyNode: k,
+			Path:    jsonPath,
+		})
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if slices.Contains(schemaIdentifierDataKeys, key) {
+			continue
+		}
+		index.extractSchemaIdentifiers(node.Content[i+1], node, base, scope,
+			append(seenPath, strings.ReplaceAll(key, "/", "~1")))
+	}
+}
+
+// resolveDynamicReferences resolves every '$dynamicRef' found in the document, recording an error for
+// any that cannot be resolved.
+func (index *SpecIndex) resolveDynamicReferences() {
+	for _, d := range index.dynamicRefs {
+		d.Resolved = index.ResolveDynamicReference(d, nil)
+		if d.Resolved == nil {
+			index.errorLock.Lock()
+			index.refErrors = append(index.refErrors, &IndexingError{
+				Err:     fmt.Errorf("dynamic reference `%s` cannot be resolved", d.Value),
+				Node:    d.Node,
+				KeyNode: d.KeyNode,
+				Path:    d.Path,
+			})
+			index.errorLock.Unlock()
+		}
+	}
+}
+
+// resolveSchemaIdentifierRef determines if a $ref value needs to be resolved using a JSON Schema '$id' base URI
+// or a plain name anchor. If so, the fully resolved URI is returned and recorded against the ref node.
+func (index *SpecIndex) resolveSchemaIdentifierRef(refNode *yaml.Node, value string) (string, bool) {
+	docBase := index.schemaDocumentBase()
+	base := docBase
+	if b, ok := index.schemaRefBases[refNode]; ok {
+		base = b
+	}
+	_, fragment, _ := strings.Cut(value, "#")
+	anchor := isSchemaAnchorFragment(fragment)
+	if base == docBase && !anchor {
+		return "", false
+	}
+	resolved := resolveSchemaURI(base, value)
+	resource, _, _ := strings.Cut(resolved, "#")
+	known := resource == docBase && anchor
+	for _, idx := range index.schemaIdentifierIndexes() {
+		if _, ok := idx.schemaIds[resource]; ok {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return "", false
+	}
+	resolved = strings.TrimSuffix(resolved, "#")
+	index.schemaIdentifierRefs[refNode] = resolved
+	return resolved, true
+}
+
+// findSchemaIdentifierComponent locates a component using a URI that is either an '$id' (with an optional JSON
+// pointer or anchor fragment), or a plain name anchor. The document being indexed is searched first, then every
+// other index in the rolodex.
+func (index *SpecIndex) findSchemaIdentifierComponent(ctx context.Context, componentId string) *Reference {
+	for _, idx := range index.schemaIdentifierIndexes() {
+		if ref := idx.findLocalSchemaIdentifierComponent(ctx, componentId); ref != nil {
+			return ref
+		}
+	}
+	return nil
+}
+
+// findLocalSchemaIdentifierComponent locates a component using a schema identifier declared in this index only.
+func (index *SpecIndex) findLocalSchemaIdentifierComponent(ctx context.Context, componentId string) *Reference {
+	if len(index.schemaIds) == 0 && len(index.schemaAnchors) == 0 && len(index.dynamicAnchors) == 0 {
+		return nil
+	}
+	resource, fragment, _ := strings.Cut(componentId, "#")
+	id, known := index.schemaIds[resource]
+	if !known && !(resource == index.schemaDocumentBase() && isSchemaAnchorFragment(fragment)) {
+		return nil
+	}
+
+	switch {
+	case fragment == "":
+		return &Reference{
+			FullDefinition: componentId,
+			Definition:     componentId,
+			Name:           id.Id,
+			Node:           id.Node,
+			KeyNode:        id.KeyNode,
+			ParentNode:     id.ParentNode,
+			Path:           id.Path,
+			RemoteLocation: index.specAbsolutePath,
+			Index:          index,
+		}
+	case strings.HasPrefix(fragment, "/"):
+		ref := FindComponent(ctx, id.Node, "#"+fragment, index.specAbsolutePath, index)
+		if ref != nil {
+			ref.FullDefinition = componentId
+			ref.Definition = componentId
+		}
+		return ref
+	default:
+		a := index.schemaAnchors[resource+"#"+fragment]
+		if a == nil {
+			// a '$dynamicAnchor' is also a plain name fragment.
+			a = index.dynamicAnchors[resource+"#"+fragment]
+		}
+		if a == nil {
+			return nil
+		}
+		return index.schemaAnchorReference(a, componentId)
+	}
+}
+
+func (index *SpecIndex) schemaAnchorReference(a *SchemaAnchorReference, fullDef string) *Reference {
+	return &Reference{
+		FullDefinition: fullDef,
+		Definition:     "#" + a.Name,
+		Name:           a.Name,
+		Node:           a.Node,
+		KeyNode:        a.KeyNode,
+		ParentNode:     a.ParentNode,
+		Path:           a.Path,
+		RemoteLocation: index.specAbsolutePath,
+		Index:          index,
+	}
+}
+
+// resolveSchemaURI resolves a reference against a base URI, following RFC 3986.
+func resolveSchemaURI(base, ref string) string {
+	if strings.HasPrefix(ref, "#") {
+		b, _, _ := strings.Cut(base, "#")
+		return b + ref
+	}
+	r, err := url.Parse(ref)
+	if err != nil || r.IsAbs() || base == "" {
+		return ref
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+	return b.ResolveReference(r).String()
+}
+
+// isSchemaAnchorFragment returns true if a URI fragment is a plain name (an anchor) and not a JSON pointer.
+func isSchemaAnchorFragment(fragment string) bool {
+	return fragment != "" && !strings.HasPrefix(fragment, "/")
+}
+
+// schemaKeywordNodes returns the key and value nodes of a keyword, only if the value is a string scalar. This
+// prevents properties that happen to be named '$id' or '$anchor' from being mistaken for keywords.
+func schemaKeywordNodes(keyword string, node *yaml.Node) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == keyword {
+			if utils.IsNodeStringValue(node.Content[i+1]) {
+				return node.Content[i], node.Content[i+1]
+			}
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,349 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package index
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+var schemaIdentifiersSpec = `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      $anchor: pet
+      type: object
+    Owner:
+      type: object
+      properties:
+        pet:
+          $ref: "#pet"
+    Customer:
+      $id: https://example.com/schemas/customer
+      type: object
+      properties:
+        address:
+          $ref: address
+        street:
+          $ref: "address#/properties/street"
+        self:
+          $ref: "#/properties/address"
+        zip:
+          $ref: "#zip"
+      $defs:
+        address:
+          $id: address
+          type: object
+          properties:
+            street:
+              type: string
+        zip:
+          $anchor: zip
+          type: string
+    Tree:
+      $id: https://example.com/schemas/tree
+      $dynamicAnchor: node
+      type: object
+      properties:
+        children:
+          type: array
+          items:
+            $dynamicRef: "#node"
+    StrictTree:
+      $id: https://example.com/schemas/strict-tree
+      $dynamicAnchor: node
+      $ref: tree
+      unevaluatedProperties: false`
+
+func buildSchemaIdentifiersIndex(spec string) *SpecIndex {
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &rootNode)
+	config := CreateOpenAPIIndexConfig()
+	config.SpecAbsolutePath = "/tmp/openapi.yaml"
+	return NewSpecIndexWithConfig(&rootNode, config)
+}
+
+func TestSpecIndex_SchemaIdentifiers(t *testing.T) {
+	idx := buildSchemaIdentifiersIndex(schemaIdentifiersSpec)
+
+	assert.Len(t, idx.GetReferenceIndexErrors(), 0)
+
+	ids := idx.GetAllSchemaIds()
+	assert.Len(t, ids, 4)
+	assert.Equal(t, "address", ids["https://example.com/schemas/address"].Id)
+	assert.Equal(t, "https://example.com/schemas/customer", ids["https://example.com/schemas/address"].BaseURI)
+	assert.Equal(t, "$.components.schemas['Customer']['$defs'].address", ids["https://example.com/schemas/address"].Path)
+	assert.Equal(t, 26, ids["https://example.com/schemas/address"].KeyNode.Line)
+
+	anchors := idx.GetAllSchemaAnchors()
+	assert.Len(t, anchors, 2)
+	assert.Equal(t, "pet", anchors["/tmp/openapi.yaml#pet"].Name)
+	assert.Equal(t, "https://example.com/schemas/customer", anchors["https://example.com/schemas/customer#zip"].BaseURI)
+
+	dynamic := idx.GetAllDynamicAnchors()
+	assert.Len(t, dynamic, 2)
+	assert.True(t, dynamic["https://example.com/schemas/tree#node"].Dynamic)
+
+	mapped := idx.GetMappedReferences()
+	assert.Equal(t, 5, mapped["/tmp/openapi.yaml#pet"].Node.Line)
+	assert.Equal(t, 26, mapped["https://example.com/schemas/address"].Node.Line)
+	assert.Equal(t, 30, mapped["https://example.com/schemas/address#/properties/street"].Node.Line)
+	assert.Equal(t, 17, mapped["https://example.com/schemas/customer#/properties/address"].Node.Line)
+	assert.Equal(t, 32, mapped["https://example.com/schemas/customer#zip"].Node.Line)
+	assert.Equal(t, 35, mapped["https://example.com/schemas/tree"].Node.Line)
+}
+
+func TestSpecIndex_SchemaIdentifiers_FindComponent(t *testing.T) {
+	idx := buildSchemaIdentifiersIndex(schemaIdentifiersSpec)
+
+	ref := idx.FindComponent(context.Background(), "https://example.com/schemas/customer#/$defs/zip")
+	assert.NotNil(t, ref)
+	assert.Equal(t, 32, ref.Node.Line)
+
+	ref = idx.FindComponent(context.Background(), "/tmp/openapi.yaml#pet")
+	assert.NotNil(t, ref)
+	assert.Equal(t, "pet", ref.Name)
+
+	assert.Nil(t, idx.FindComponent(context.Background(), "https://example.com/schemas/customer#nope"))
+}
+
+func TestSpecIndex_SchemaIdentifiers_GetSchemaIdentifierReference(t *testing.T) {
+	idx := buildSchemaIdentifiersIndex(schemaIdentifiersSpec)
+
+	ownerPet := idx.FindComponent(context.Background(), "#/components/schemas/Owner/properties/pet")
+	assert.NotNil(t, ownerPet)
+
+	ref := idx.GetSchemaIdentifierReference(ownerPet.Node)
+	assert.NotNil(t, ref)
+	assert.Equal(t, 5, ref.Node.Line)
+
+	// plain JSON pointer refs are not resolved using identifiers.
+	assert.Nil(t, idx.GetSchemaIdentifierReference(&yaml.Node{}))
+	var nilIndex *SpecIndex
+	assert.Nil(t, nilIndex.GetSchemaIdentifierReference(ownerPet.Node))
+}
+
+func TestSpecIndex_SchemaIdentifiers_AnchorWithoutPath(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      $anchor: pet
+      type: object
+    Owner:
+      $ref: "#pet"`
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(spec), &rootNode)
+	idx := NewSpecIndexWithConfig(&rootNode, CreateOpenAPIIndexConfig())
+
+	assert.Len(t, idx.GetReferenceIndexErrors(), 0)
+	assert.Equal(t, 5, idx.GetMappedReferences()["#pet"].Node.Line)
+}
+
+func TestSpecIndex_SchemaIdentifiers_MissingAnchor(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Owner:
+      $ref: "#pet"`
+
+	idx := buildSchemaIdentifiersIndex(spec)
+	errs := idx.GetReferenceIndexErrors()
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "component `#pet` does not exist in the specification", errs[0].Error())
+}
+
+func TestSpecIndex_SchemaIdentifiers_IgnoreDataAndProperties(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        $id:
+          type: string
+      example:
+        $id: https://example.com/not-a-schema
+        $anchor: nope`
+
+	idx := buildSchemaIdentifiersIndex(spec)
+	assert.Len(t, idx.GetAllSchemaIds(), 0)
+	assert.Len(t, idx.GetAllSchemaAnchors(), 0)
+}
+
+func TestSpecIndex_DynamicReference(t *testing.T) {
+	idx := buildSchemaIdentifiersIndex(schemaIdentifiersSpec)
+
+	dynamicRefs := idx.GetAllDynamicReferences()
+	assert.Len(t, dynamicRefs, 1)
+
+	d := dynamicRefs[0]
+	assert.Equal(t, "#node", d.Value)
+	assert.Equal(t, "https://example.com/schemas/tree", d.BaseURI)
+	assert.Equal(t, []string{"/tmp/openapi.yaml", "https://example.com/schemas/tree"}, d.Scope)
+
+	// statically, the only schema resource in scope is the tree.
+	assert.NotNil(t, d.Resolved)
+	assert.Equal(t, 35, d.Resolved.Node.Line)
+
+	// evaluating via the strict tree, it's the outermost dynamic anchor that wins.
+	r := idx.ResolveDynamicReference(d, []string{
+		"/tmp/openapi.yaml",
+		"https://example.com/schemas/strict-tree",
+		"https://example.com/schemas/tree",
+	})
+	assert.NotNil(t, r)
+	assert.Equal(t, 44, r.Node.Line)
+	assert.Equal(t, "https://example.com/schemas/strict-tree#node", r.FullDefinition)
+
+	assert.Nil(t, idx.ResolveDynamicReference(nil, nil))
+}
+
+func TestSpecIndex_DynamicReference_NoBookend(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    List:
+      $id: https://example.com/schemas/list
+      $defs:
+        item:
+          $anchor: item
+          type: string
+      type: array
+      items:
+        $dynamicRef: "#item"
+    Other:
+      $dynamicRef: "#/components/schemas/List"`
+
+	idx := buildSchemaIdentifiersIndex(spec)
+	assert.Len(t, idx.GetReferenceIndexErrors(), 0)
+
+	// a plain '$anchor' target behaves like a normal $ref, regardless of scope.
+	d := idx.GetAllDynamicReferences()[0]
+	assert.Equal(t, 8, d.Resolved.Node.Line)
+
+	// JSON pointers work just like a $ref.
+	assert.Equal(t, 5, idx.GetAllDynamicReferences()[1].Resolved.Node.Line)
+}
+
+func TestSpecIndex_DynamicReference_Unresolvable(t *testing.T) {
+	spec := `openapi: 3.1.0
+components:
+  schemas:
+    Tree:
+      type: array
+      items:
+        $dynamicRef: "#missing"`
+
+	idx := buildSchemaIdentifiersIndex(spec)
+	errs := idx.GetReferenceIndexErrors()
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "dynamic reference `#missing` cannot be resolved", errs[0].Error())
+	assert.Equal(t, "$.components.schemas['Tree'].items", errs[0].(*IndexingError).Path)
+	assert.Nil(t, idx.GetAllDynamicReferences()[0].Resolved)
+}
+
+func TestSpecIndex_SchemaIdentifiers_Rolodex(t *testing.T) {
+	root := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      $ref: pet.yaml
+    Owner:
+      type: object
+      properties:
+        pet:
+          $ref: https://example.com/schemas/pet
+    StrictPet:
+      $id: https://example.com/schemas/strict-pet
+      $dynamicAnchor: node
+      unevaluatedProperties: false`
+
+	pet := `$id: https://example.com/schemas/pet
+$dynamicAnchor: node
+type: object
+properties:
+  name:
+    $ref: "#name"
+$defs:
+  name:
+    $anchor: name
+    type: string`
+
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "pet.yaml"), []byte(pet), 0o644)
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(root), &rootNode)
+
+	cf := CreateOpenAPIIndexConfig()
+	cf.BasePath = dir
+	cf.SpecAbsolutePath = filepath.Join(dir, "openapi.yaml")
+
+	rolo := NewRolodex(cf)
+	rolo.SetRootNode(&rootNode)
+	cf.Rolodex = rolo
+
+	fileFS, err := NewLocalFSWithConfig(&LocalFSConfig{
+		BaseDirectory: dir,
+		DirFS:         os.DirFS(dir),
+	})
+	assert.NoError(t, err)
+	rolo.AddLocalFS(dir, fileFS)
+
+	assert.NoError(t, rolo.IndexTheRolodex(context.Background()))
+	rolo.Resolve()
+	assert.Len(t, rolo.GetCaughtErrors(), 0)
+	rootIdx := rolo.GetRootIndex()
+	assert.Len(t, rootIdx.GetReferenceIndexErrors(), 0)
+	assert.Len(t, rootIdx.GetResolver().GetResolvingErrors(), 0)
+	assert.Len(t, rolo.GetIndexes(), 1)
+	petIdx := rolo.GetIndexes()[0]
+
+	// the '$id' and '$anchor' are declared in pet.yaml, not in the root document.
+	assert.Len(t, rootIdx.GetAllSchemaIds(), 1)
+	ref := rootIdx.FindComponent(context.Background(), "https://example.com/schemas/pet#/properties/name")
+	assert.NotNil(t, ref)
+	assert.Equal(t, 6, ref.Node.Line)
+	assert.Equal(t, petIdx, ref.Index)
+
+	ref = rootIdx.FindComponent(context.Background(), "https://example.com/schemas/pet#name")
+	assert.NotNil(t, ref)
+	assert.Equal(t, 9, ref.Node.Line)
+	assert.Equal(t, filepath.Join(dir, "pet.yaml"), ref.RemoteLocation)
+
+	// and identifiers in the root document can be found from pet.yaml.
+	ref = petIdx.FindComponent(context.Background(), "https://example.com/schemas/strict-pet")
+	assert.NotNil(t, ref)
+	assert.Equal(t, rootIdx, ref.Index)
+
+	d := &DynamicReference{Value: "https://example.com/schemas/pet#node", BaseURI: cf.SpecAbsolutePath}
+	r := rootIdx.ResolveDynamicReference(d, nil)
+	assert.NotNil(t, r)
+	assert.Equal(t, petIdx, r.Index)
+
+	// the outermost dynamic anchor wins, even when it's in another file.
+	r = petIdx.ResolveDynamicReference(d, []string{
+		"https://example.com/schemas/strict-pet",
+		"https://example.com/schemas/pet",
+	})
+	assert.NotNil(t, r)
+	assert.Equal(t, "https://example.com/schemas/strict-pet#node", r.FullDefinition)
+	assert.Equal(t, rootIdx, r.Index)
+}
+
+func TestResolveSchemaURI(t *testing.T) {
+	assert.Equal(t, "https://example.com/a/b.json", resolveSchemaURI("https://example.com/a/root.json", "b.json"))
+	assert.Equal(t, "https://example.com/a/root.json#x", resolveSchemaURI("https://example.com/a/root.json#y", "#x"))
+	assert.Equal(t, "https://other.com/c", resolveSchemaURI("https://example.com/a/root.json", "https://other.com/c"))
+	assert.Equal(t, "b.json", resolveSchemaURI("", "b.json"))
+	assert.Equal(t, "/tmp/b.json", resolveSchemaURI("/tmp/openapi.yaml", "b.json"))
+	assert.Equal(t, "%zz", resolveSchemaURI("/tmp/openapi.yaml", "%zz"))
+}
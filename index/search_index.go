@@ -130,6 +130,12 @@ func (index *SpecIndex) SearchIndexForReferenceByReferenceWithContext(ctx contex
 		}
 	}
 
+	// a JSON Schema '$id' or anchor may be declared in any index in the rolodex.
+	if r := index.findSchemaIdentifierComponent(ctx, ref); r != nil {
+		index.cache.Store(ref, r)
+		return r, r.Index, context.WithValue(ctx, CurrentPathKey, r.RemoteLocation)
+	}
+
 	// check the rolodex for the reference.
 	if roloLookup != "" {
 
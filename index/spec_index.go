@@ -89,6 +89,9 @@ func createNewIndex(ctx context.Context, rootNode *yaml.Node, index *SpecIndex,
 
 	index.cache = new(sync.Map)
 
+	// record JSON Schema identifiers, so references can be resolved against the correct base URI.
+	index.extractSchemaIdentifiers(index.root.Content[0], index.root, index.schemaDocumentBase(), []string{index.schemaDocumentBase()}, []string{})
+
 	// boot index.
 	results := index.ExtractRefs(ctx, index.root.Content[0], index.root, []string{}, 0, false, "")
 
@@ -118,6 +121,7 @@ func createNewIndex(ctx context.Context, rootNode *yaml.Node, index *SpecIndex,
 		index.ExtractComponentsFromRefs(ctx, poly)
 	}
 
+	index.resolveDynamicReferences()
 	index.ExtractExternalDocuments(index.root)
 	index.GetPathCount()
 
@@ -1226,6 +1230,25 @@ func (index *SpecIndex) GetOperationCount() int {
 						// update
 						opCount++
 					}
+
+					// additional operations (OpenAPI 3.2+) are keyed by their HTTP method.
+					if m.Value == "additionalOperations" {
+						addOps := method.Content[y+1]
+						for a := 0; a+1 < len(addOps.Content); a += 2 {
+							ref := &Reference{
+								Definition: addOps.Content[a].Value,
+								Name:       addOps.Content[a].Value,
+								Node:       addOps.Content[a+1],
+								Path:       fmt.Sprintf("$.paths['%s'].additionalOperations['%s']", p.Value, addOps.Content[a].Value),
+								ParentNode: addOps.Content[a],
+							}
+							if locatedPathRefs[p.Value] == nil {
+								locatedPathRefs[p.Value] = make(map[string]*Reference)
+							}
+							locatedPathRefs[p.Value][ref.Name] = ref
+							opCount++
+						}
+					}
 				}
 			}
 		}
@@ -1311,86 +1334,14 @@ func (index *SpecIndex) GetOperationsParameterCount() int {
 
 					// method level params.
 					if isHttpMethod(prop.Value) {
-						for z, httpMethodProp := range pathPropertyNode.Content[y+1].Content {
-							if z%2 == 0 {
-								if httpMethodProp.Value == "parameters" {
-									params := pathPropertyNode.Content[y+1].Content[z+1].Content
-									index.scanOperationParams(params, pathPropertyNode.Content[y+1].Content[z], pathItemNode, prop.Value)
-								}
-
-								// extract operation tags if set.
-								if httpMethodProp.Value == "tags" {
-									tags := pathPropertyNode.Content[y+1].Content[z+1]
-
-									if index.operationTagsRefs[pathItemNode.Value] == nil {
-										index.operationTagsRefs[pathItemNode.Value] = make(map[string][]*Reference)
-									}
-
-									var tagRefs []*Reference
-									for _, tagRef := range tags.Content {
-										ref := &Reference{
-											Definition: tagRef.Value,
-											Name:       tagRef.Value,
-											Node:       tagRef,
-										}
-										tagRefs = append(tagRefs, ref)
-									}
-									index.operationTagsRefs[pathItemNode.Value][prop.Value] = tagRefs
-								}
-
-								// extract description and summaries
-								if httpMethodProp.Value == "description" {
-									desc := pathPropertyNode.Content[y+1].Content[z+1].Value
-									ref := &Reference{
-										Definition: desc,
-										Name:       "description",
-										Node:       pathPropertyNode.Content[y+1].Content[z+1],
-									}
-									if index.operationDescriptionRefs[pathItemNode.Value] == nil {
-										index.operationDescriptionRefs[pathItemNode.Value] = make(map[string]*Reference)
-									}
-
-									index.operationDescriptionRefs[pathItemNode.Value][prop.Value] = ref
-								}
-								if httpMethodProp.Value == "summary" {
-									summary := pathPropertyNode.Content[y+1].Content[z+1].Value
-									ref := &Reference{
-										Definition: summary,
-										Name:       "summary",
-										Node:       pathPropertyNode.Content[y+1].Content[z+1],
-									}
-
-									if index.operationSummaryRefs[pathItemNode.Value] == nil {
-										index.operationSummaryRefs[pathItemNode.Value] = make(map[string]*Reference)
-									}
-
-									index.operationSummaryRefs[pathItemNode.Value][prop.Value] = ref
-								}
-
-								// extract servers from method operation.
-								if httpMethodProp.Value == "servers" {
-									serversNode := pathPropertyNode.Content[y+1].Content[z+1]
-
-									var serverRefs []*Reference
-									for i, serverRef := range serversNode.Content {
-										ref := &Reference{
-											Definition: "servers",
-											Name:       "servers",
-											Node:       serverRef,
-											ParentNode: httpMethodProp,
-											Path:       fmt.Sprintf("$.paths['%s'].%s.servers[%d]", pathItemNode.Value, prop.Value, i),
-										}
-										serverRefs = append(serverRefs, ref)
-									}
-
-									if index.opServersRefs[pathItemNode.Value] == nil {
-										index.opServersRefs[pathItemNode.Value] = make(map[string][]*Reference)
-									}
-
-									index.opServersRefs[pathItemNode.Value][prop.Value] = serverRefs
-								}
+						index.scanOperationMetadata(pathItemNode, prop.Value, pathPropertyNode.Content[y+1])
+					}
 
-							}
+					// additional operations (OpenAPI 3.2+) are keyed by their HTTP method.
+					if prop.Value == "additionalOperations" {
+						addOps := pathPropertyNode.Content[y+1]
+						for a := 0; a+1 < len(addOps.Content); a += 2 {
+							index.scanOperationMetadata(pathItemNode, addOps.Content[a].Value, addOps.Content[a+1])
 						}
 					}
 				}
@@ -1433,6 +1384,92 @@ func (index *SpecIndex) GetOperationsParameterCount() int {
 	return index.operationParamCount
 }
 
+// scanOperationMetadata extracts parameters, tags, descriptions, summaries and servers from a single operation node
+// found under a path item. The method is the HTTP method (or additional operation name) the operation is keyed by.
+func (index *SpecIndex) scanOperationMetadata(pathItemNode *yaml.Node, method string, opNode *yaml.Node) {
+	for z, httpMethodProp := range opNode.Content {
+		if z%2 == 0 {
+			if httpMethodProp.Value == "parameters" {
+				params := opNode.Content[z+1].Content
+				index.scanOperationParams(params, opNode.Content[z], pathItemNode, method)
+			}
+
+			// extract operation tags if set.
+			if httpMethodProp.Value == "tags" {
+				tags := opNode.Content[z+1]
+
+				if index.operationTagsRefs[pathItemNode.Value] == nil {
+					index.operationTagsRefs[pathItemNode.Value] = make(map[string][]*Reference)
+				}
+
+				var tagRefs []*Reference
+				for _, tagRef := range tags.Content {
+					ref := &Reference{
+						Definition: tagRef.Value,
+						Name:       tagRef.Value,
+						Node:       tagRef,
+					}
+					tagRefs = append(tagRefs, ref)
+				}
+				index.operationTagsRefs[pathItemNode.Value][method] = tagRefs
+			}
+
+			// extract description and summaries
+			if httpMethodProp.Value == "description" {
+				desc := opNode.Content[z+1].Value
+				ref := &Reference{
+					Definition: desc,
+					Name:       "description",
+					Node:       opNode.Content[z+1],
+				}
+				if index.operationDescriptionRefs[pathItemNode.Value] == nil {
+					index.operationDescriptionRefs[pathItemNode.Value] = make(map[string]*Reference)
+				}
+
+				index.operationDescriptionRefs[pathItemNode.Value][method] = ref
+			}
+			if httpMethodProp.Value == "summary" {
+				summary := opNode.Content[z+1].Value
+				ref := &Reference{
+					Definition: summary,
+					Name:       "summary",
+					Node:       opNode.Content[z+1],
+				}
+
+				if index.operationSummaryRefs[pathItemNode.Value] == nil {
+					index.operationSummaryRefs[pathItemNode.Value] = make(map[string]*Reference)
+				}
+
+				index.operationSummaryRefs[pathItemNode.Value][method] = ref
+			}
+
+			// extract servers from method operation.
+			if httpMethodProp.Value == "servers" {
+				serversNode := opNode.Content[z+1]
+
+				var serverRefs []*Reference
+				for i, serverRef := range serversNode.Content {
+					ref := &Reference{
+						Definition: "servers",
+						Name:       "servers",
+						Node:       serverRef,
+						ParentNode: httpMethodProp,
+						Path:       fmt.Sprintf("$.paths['%s'].%s.servers[%d]", pathItemNode.Value, method, i),
+					}
+					serverRefs = append(serverRefs, ref)
+				}
+
+				if index.opServersRefs[pathItemNode.Value] == nil {
+					index.opServersRefs[pathItemNode.Value] = make(map[string][]*Reference)
+				}
+
+				index.opServersRefs[pathItemNode.Value][method] = serverRefs
+			}
+
+		}
+	}
+}
+
 // GetInlineDuplicateParamCount returns the number of inline duplicate parameters (operation params)
 func (index *SpecIndex) GetInlineDuplicateParamCount() int {
 	if index.componentsInlineParamDuplicateCount > 0 {
@@ -1976,3 +1976,36 @@ components:
 	assert.NotNil(t, index.GetRolodex())
 
 }
+
+func TestSpecIndex_QueryAndAdditionalOperations(t *testing.T) {
+	yml := `openapi: 3.2.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+    query:
+      operationId: searchPets
+      parameters:
+        - name: limit
+          in: query
+    additionalOperations:
+      LINK:
+        operationId: linkPets
+        tags:
+          - pets
+        parameters:
+          - name: target
+            in: header`
+
+	var rootNode yaml.Node
+	_ = yaml.Unmarshal([]byte(yml), &rootNode)
+
+	index := NewSpecIndexWithConfig(&rootNode, CreateOpenAPIIndexConfig())
+	assert.Equal(t, 3, index.GetOperationCount())
+	assert.Equal(t, "$.paths['/pets'].additionalOperations['LINK']", index.GetAllPaths()["/pets"]["LINK"].Path)
+
+	params := index.GetOperationParameterReferences()
+	assert.Len(t, params["/pets"]["query"]["limit"], 1)
+	assert.Len(t, params["/pets"]["LINK"]["target"], 1)
+	assert.Len(t, index.GetOperationTags()["/pets"]["LINK"], 1)
+}
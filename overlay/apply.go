@@ -0,0 +1,189 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package overlay
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Warning is returned for an action that could not be applied in full. Warnings do not stop the overlay from
+// being applied.
+type Warning struct {
+	Action  int    `json:"action" yaml:"action"` // index of the action in the overlay.
+	Target  string `json:"target" yaml:"target"`
+	Message string `json:"message" yaml:"message"`
+	Line    int    `json:"line,omitempty" yaml:"line,omitempty"`
+	Column  int    `json:"column,omitempty" yaml:"column,omitempty"`
+}
+
+// String returns a string representation of the Warning.
+func (w *Warning) String() string {
+	if w.Line > 0 {
+		return fmt.Sprintf("action %d (line %d, column %d): %s: %s", w.Action, w.Line, w.Column, w.Target, w.Message)
+	}
+	return fmt.Sprintf("action %d: %s: %s", w.Action, w.Target, w.Message)
+}
+
+// Apply applies the overlay to a copy of the root node of a document, and returns a new Document created from the
+// result, using the same configuration. The original document is not modified.
+//
+// Warnings are returned for actions that could not be applied in full, like a target that matches nothing.
+func (o *Overlay) Apply(doc libopenapi.Document) (libopenapi.Document, []*Warning, error) {
+	if doc == nil || doc.GetSpecInfo() == nil || doc.GetSpecInfo().RootNode == nil {
+		return nil, nil, errors.New("document has no root node, cannot apply overlay")
+	}
+	root := cloneNode(doc.GetSpecInfo().RootNode)
+	warnings, err := o.ApplyToNode(root)
+	if err != nil {
+		return nil, warnings, err
+	}
+	out, err := yaml.Marshal(root)
+	if err != nil {
+		return nil, warnings, err
+	}
+	applied, err := libopenapi.NewDocumentWithConfiguration(out, doc.GetConfiguration())
+	return applied, warnings, err
+}
+
+// ApplyToNode applies every action in order to a node, the node is modified in place. The overlay is validated
+// first, and an error is returned if it is not valid, or if a target is not a valid JSONPath expression.
+func (o *Overlay) ApplyToNode(root *yaml.Node) ([]*Warning, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	if root != nil && root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	var warnings []*Warning
+	for i, a := range o.Actions {
+		warn := func(message string) {
+			w := &Warning{Action: i, Target: a.Target, Message: message}
+			if a.node != nil {
+				w.Line = a.node.Line
+				w.Column = a.node.Column
+			}
+			warnings = append(warnings, w)
+		}
+
+		nodes, err := utils.FindNodesWithoutDeserializing(root, a.Target)
+		if err != nil {
+			return warnings, fmt.Errorf("action %d%s: %w", i, a.location(), err)
+		}
+		if len(nodes) == 0 {
+			warn("target does not match anything")
+			continue
+		}
+
+		if a.Remove {
+			if a.Update != nil {
+				warn("update is ignored, because the action removes its target")
+			}
+			parents := indexParents(root)
+			for _, n := range nodes {
+				if !remove(parents[n], n) {
+					warn("the root of the document cannot be removed")
+				}
+			}
+			continue
+		}
+
+		for _, n := range nodes {
+			switch {
+			case n.Kind == yaml.SequenceNode:
+				n.Content = append(n.Content, cloneNode(a.Update))
+			case n.Kind == yaml.MappingNode && a.Update.Kind == yaml.MappingNode:
+				merge(n, a.Update)
+			case n.Kind == yaml.MappingNode:
+				warn("an object can only be updated with an object")
+			default:
+				warn(fmt.Sprintf("target matches a value on line %d, only objects and arrays can be updated", n.Line))
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// merge merges an update into an object. Objects are merged recursively, anything else is replaced.
+func merge(target, update *yaml.Node) {
+	for i := 0; i < len(update.Content)-1; i += 2 {
+		key, value := update.Content[i], update.Content[i+1]
+		found := false
+		for j := 0; j < len(target.Content)-1; j += 2 {
+			if target.Content[j].Value != key.Value {
+				continue
+			}
+			found = true
+			if target.Content[j+1].Kind == yaml.MappingNode && value.Kind == yaml.MappingNode {
+				merge(target.Content[j+1], value)
+			} else {
+				target.Content[j+1] = cloneNode(value)
+			}
+			break
+		}
+		if !found {
+			target.Content = append(target.Content, cloneNode(key), cloneNode(value))
+		}
+	}
+}
+
+// remove removes a node from its parent. If the node is the value of an object, the key is removed as well.
+func remove(parent, node *yaml.Node) bool {
+	if parent == nil {
+		return false
+	}
+	for i, n := range parent.Content {
+		if n != node {
+			continue
+		}
+		if parent.Kind == yaml.MappingNode {
+			i -= i % 2
+			parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+		} else {
+			parent.Content = append(parent.Content[:i], parent.Content[i+1:]...)
+		}
+		return true
+	}
+	return false
+}
+
+// indexParents maps every node in a tree to its parent.
+func indexParents(root *yaml.Node) map[*yaml.Node]*yaml.Node {
+	parents := make(map[*yaml.Node]*yaml.Node)
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		for _, c := range n.Content {
+			if _, seen := parents[c]; seen {
+				continue
+			}
+			parents[c] = n
+			walk(c)
+		}
+	}
+	walk(root)
+	return parents
+}
+
+// cloneNode creates a deep copy of a node, aliases are expanded.
+func cloneNode(n *yaml.Node) *yaml.Node {
+	if n == nil {
+		return nil
+	}
+	if n.Kind == yaml.AliasNode && n.Alias != nil {
+		return cloneNode(n.Alias)
+	}
+	c := *n
+	c.Anchor = ""
+	if len(n.Content) > 0 {
+		c.Content = make([]*yaml.Node, len(n.Content))
+		for i := range n.Content {
+			c.Content[i] = cloneNode(n.Content[i])
+		}
+	}
+	return &c
+}
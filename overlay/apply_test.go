@@ -0,0 +1,191 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package overlay
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const burgerSpec = `openapi: 3.1.0
+info:
+  title: Burger API
+  version: 1.0.0
+tags:
+  - name: burgers
+paths:
+  /burgers:
+    get:
+      operationId: listBurgers
+      responses:
+        "200":
+          description: OK
+    delete:
+      operationId: deleteBurgers
+      responses:
+        "204":
+          description: Deleted
+`
+
+func buildDocument(t *testing.T, spec string) libopenapi.Document {
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+	return doc
+}
+
+func parseOverlay(t *testing.T, overlay string) *Overlay {
+	o, err := ParseOverlay([]byte(overlay))
+	require.NoError(t, err)
+	return o
+}
+
+func TestOverlay_Apply(t *testing.T) {
+	doc := buildDocument(t, burgerSpec)
+	o := parseOverlay(t, `overlay: 1.0.0
+info:
+  title: Burger overlay
+  version: 1.0.0
+actions:
+  - target: $.info
+    update:
+      title: Burger Shop
+      contact:
+        name: pb33f
+  - target: $.tags
+    update:
+      name: fries
+  - target: $.paths['/burgers'].delete
+    remove: true
+  - target: $.paths.*.*
+    update:
+      x-rate-limit: 100
+`)
+
+	applied, warnings, err := o.Apply(doc)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	model, errs := applied.BuildV3Model()
+	require.Empty(t, errs)
+	assert.Equal(t, "Burger Shop", model.Model.Info.Title)
+	assert.Equal(t, "1.0.0", model.Model.Info.Version)
+	assert.Equal(t, "pb33f", model.Model.Info.Contact.Name)
+	require.Len(t, model.Model.Tags, 2)
+	assert.Equal(t, "fries", model.Model.Tags[1].Name)
+
+	burgers := model.Model.Paths.PathItems.GetOrZero("/burgers")
+	assert.Nil(t, burgers.Delete)
+	require.NotNil(t, burgers.Get)
+	assert.Equal(t, "100", burgers.Get.Extensions.GetOrZero("x-rate-limit").Value)
+
+	// the original document is untouched.
+	original, errs := doc.BuildV3Model()
+	require.Empty(t, errs)
+	assert.Equal(t, "Burger API", original.Model.Info.Title)
+	assert.NotNil(t, original.Model.Paths.PathItems.GetOrZero("/burgers").Delete)
+}
+
+func TestOverlay_Apply_Merge(t *testing.T) {
+	var root yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(`info:
+  title: Burger API
+  contact:
+    name: pb33f
+    url: https://pb33f.io
+  x-tags: [a]
+`), &root))
+	o := parseOverlay(t, `overlay: 1.0.0
+info:
+  title: t
+  version: 1
+actions:
+  - target: $.info
+    update:
+      contact:
+        email: hello@pb33f.io
+      x-tags: [b]
+`)
+
+	warnings, err := o.ApplyToNode(&root)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	out, _ := yaml.Marshal(&root)
+	assert.Equal(t, `info:
+    title: Burger API
+    contact:
+        name: pb33f
+        url: https://pb33f.io
+        email: hello@pb33f.io
+    x-tags: [b]
+`, string(out))
+}
+
+func TestOverlay_Apply_Warnings(t *testing.T) {
+	doc := buildDocument(t, burgerSpec)
+	o := parseOverlay(t, `overlay: 1.0.0
+info:
+  title: Burger overlay
+  version: 1.0.0
+actions:
+  - target: $.components.schemas
+    update:
+      Burger: {}
+  - target: $.info.title
+    update:
+      nope: true
+  - target: $.info
+    update: nope
+  - target: $.tags
+    update:
+      name: ignored
+    remove: true
+  - target: $
+    remove: true
+`)
+
+	applied, warnings, err := o.Apply(doc)
+	require.NoError(t, err)
+	require.NotNil(t, applied)
+	require.Len(t, warnings, 5)
+
+	assert.Equal(t, 0, warnings[0].Action)
+	assert.Equal(t, "$.components.schemas", warnings[0].Target)
+	assert.Equal(t, "target does not match anything", warnings[0].Message)
+	assert.Equal(t, 6, warnings[0].Line)
+	assert.Equal(t, 5, warnings[0].Column)
+	assert.Equal(t, "action 0 (line 6, column 5): $.components.schemas: target does not match anything", warnings[0].String())
+
+	assert.Equal(t, "target matches a value on line 3, only objects and arrays can be updated", warnings[1].Message)
+	assert.Equal(t, "an object can only be updated with an object", warnings[2].Message)
+	assert.Equal(t, "update is ignored, because the action removes its target", warnings[3].Message)
+	assert.Equal(t, "the root of the document cannot be removed", warnings[4].Message)
+
+	assert.NotContains(t, string(*applied.GetSpecInfo().SpecBytes), "tags")
+	assert.Equal(t, "action 1: $.x: message", (&Warning{Action: 1, Target: "$.x", Message: "message"}).String())
+}
+
+func TestOverlay_Apply_Errors(t *testing.T) {
+	o := parseOverlay(t, `overlay: 1.0.0
+info:
+  title: Burger overlay
+  version: 1.0.0
+actions:
+  - target: $.info[?(@.title ==
+    remove: true
+`)
+	_, _, err := o.Apply(buildDocument(t, burgerSpec))
+	assert.ErrorContains(t, err, "action 0 (line 6, column 5)")
+
+	_, _, err = o.Apply(nil)
+	assert.Error(t, err)
+
+	o.Actions = nil
+	_, err = o.ApplyToNode(&yaml.Node{})
+	assert.ErrorIs(t, err, ErrInvalidOverlay)
+}
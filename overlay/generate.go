@@ -0,0 +1,164 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package overlay
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// GeneratedTitle is the title of an overlay created by Generate.
+const GeneratedTitle = "Generated overlay"
+
+var simpleKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Generate creates an overlay that turns the original document into the modified document, when applied to it.
+//
+// Objects are compared key by key, so each action is as small as possible. Items added to the end of an array are
+// appended, objects in arrays of the same length are compared by position, and any other change to an array
+// replaces it. If the documents are the same, ErrNoChanges is returned.
+func Generate(original, modified libopenapi.Document) (*Overlay, error) {
+	a, b := rootOf(original), rootOf(modified)
+	if a == nil || b == nil {
+		return nil, errors.New("both documents must have a root node to generate an overlay")
+	}
+	g := new(generator)
+	g.object("$", a, b)
+	if len(g.actions) == 0 {
+		return nil, ErrNoChanges
+	}
+	return &Overlay{
+		Overlay: Version,
+		Info:    &Info{Title: GeneratedTitle, Version: "1.0.0"},
+		Actions: g.actions,
+	}, nil
+}
+
+func rootOf(doc libopenapi.Document) *yaml.Node {
+	if doc == nil || doc.GetSpecInfo() == nil || doc.GetSpecInfo().RootNode == nil {
+		return nil
+	}
+	root := doc.GetSpecInfo().RootNode
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil
+	}
+	return root
+}
+
+type generator struct {
+	actions []*Action
+}
+
+// object compares two objects. Nested changes are generated first, then removals, and finally a single update
+// containing every new or replaced value.
+func (g *generator) object(path string, a, b *yaml.Node) {
+	update := utils.CreateEmptyMapNode()
+	for i := 0; i < len(b.Content)-1; i += 2 {
+		key, bv := b.Content[i], resolve(b.Content[i+1])
+		av := resolve(valueOf(a, key.Value))
+		switch {
+		case av == nil:
+		case equal(av, bv):
+			continue
+		case av.Kind == yaml.MappingNode && bv.Kind == yaml.MappingNode:
+			g.object(keyPath(path, key.Value), av, bv)
+			continue
+		case av.Kind == yaml.SequenceNode && bv.Kind == yaml.SequenceNode && g.array(keyPath(path, key.Value), av, bv):
+			continue
+		}
+		update.Content = append(update.Content, cloneNode(key), cloneNode(bv))
+	}
+	for i := 0; i < len(a.Content)-1; i += 2 {
+		if valueOf(b, a.Content[i].Value) == nil {
+			g.actions = append(g.actions, &Action{Target: keyPath(path, a.Content[i].Value), Remove: true})
+		}
+	}
+	if len(update.Content) > 0 {
+		g.actions = append(g.actions, &Action{Target: path, Update: update})
+	}
+}
+
+// array compares two arrays, false is returned if the difference can only be expressed by replacing the array.
+func (g *generator) array(path string, a, b *yaml.Node) bool {
+	if len(b.Content) > len(a.Content) && equalContent(a.Content, b.Content[:len(a.Content)]) {
+		for _, item := range b.Content[len(a.Content):] {
+			g.actions = append(g.actions, &Action{Target: path, Update: cloneNode(item)})
+		}
+		return true
+	}
+	if len(a.Content) != len(b.Content) {
+		return false
+	}
+	for i := range a.Content {
+		ai, bi := resolve(a.Content[i]), resolve(b.Content[i])
+		if !equal(ai, bi) && (ai.Kind != yaml.MappingNode || bi.Kind != yaml.MappingNode) {
+			return false
+		}
+	}
+	for i := range a.Content {
+		ai, bi := resolve(a.Content[i]), resolve(b.Content[i])
+		if !equal(ai, bi) {
+			g.object(path+"["+strconv.Itoa(i)+"]", ai, bi)
+		}
+	}
+	return true
+}
+
+// keyPath appends a key to a JSONPath expression, using bracket notation when the key is not a simple name.
+func keyPath(path, key string) string {
+	if simpleKey.MatchString(key) {
+		return path + "." + key
+	}
+	key = strings.ReplaceAll(key, `\`, `\\`)
+	return path + "['" + strings.ReplaceAll(key, "'", `\'`) + "']"
+}
+
+func valueOf(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func resolve(n *yaml.Node) *yaml.Node {
+	for n != nil && n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	return n
+}
+
+// equal compares two nodes by value, styles, comments and positions are ignored.
+func equal(a, b *yaml.Node) bool {
+	a, b = resolve(a), resolve(b)
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Kind != b.Kind || a.Value != b.Value || (a.Kind == yaml.ScalarNode && a.ShortTag() != b.ShortTag()) {
+		return false
+	}
+	return equalContent(a.Content, b.Content)
+}
+
+func equalContent(a, b []*yaml.Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package overlay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerate(t *testing.T) {
+	original := buildDocument(t, burgerSpec)
+	modified := buildDocument(t, `openapi: 3.1.0
+info:
+  title: Burger Shop
+  version: 1.0.0
+  contact:
+    name: pb33f
+tags:
+  - name: burgers
+  - name: fries
+paths:
+  /burgers:
+    get:
+      operationId: listBurgers
+      responses:
+        "200":
+          description: A list of burgers
+  /burgers/{id}.json:
+    get:
+      operationId: getBurger
+      responses:
+        "200":
+          description: OK
+`)
+
+	o, err := Generate(original, modified)
+	require.NoError(t, err)
+	require.NoError(t, o.Validate())
+	assert.Equal(t, Version, o.Overlay)
+	assert.Equal(t, GeneratedTitle, o.Info.Title)
+
+	targets := make([]string, len(o.Actions))
+	for i, a := range o.Actions {
+		targets[i] = a.Target
+	}
+	assert.Equal(t, []string{
+		"$.info",
+		"$.tags",
+		"$.paths['/burgers'].get.responses['200']",
+		"$.paths['/burgers'].delete",
+		"$.paths",
+	}, targets)
+	assert.True(t, o.Actions[3].Remove)
+
+	applied, warnings, err := o.Apply(original)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	a, b := rootOf(applied), rootOf(modified)
+	assert.True(t, equal(a, b))
+	out, _ := yaml.Marshal(a)
+	expected, _ := yaml.Marshal(b)
+	assert.Equal(t, string(expected), string(out))
+}
+
+func TestGenerate_Arrays(t *testing.T) {
+	original := buildDocument(t, `openapi: 3.1.0
+info:
+  title: Arrays
+  version: 1.0.0
+servers:
+  - url: https://pb33f.io
+    description: production
+  - url: https://staging.pb33f.io
+tags:
+  - name: a
+  - name: b
+security:
+  - {}
+  - basic: []
+`)
+	modified := buildDocument(t, `openapi: 3.1.0
+info:
+  title: Arrays
+  version: 1.0.0
+servers:
+  - url: https://pb33f.io
+    description: live
+  - url: https://staging.pb33f.io
+tags:
+  - name: b
+security:
+  - {}
+  - basic: []
+  - oauth: [read]
+`)
+
+	o, err := Generate(original, modified)
+	require.NoError(t, err)
+	require.Len(t, o.Actions, 3)
+	assert.Equal(t, "$.servers[0]", o.Actions[0].Target)
+	assert.Equal(t, "$.security", o.Actions[1].Target)
+	assert.Equal(t, yaml.MappingNode, o.Actions[1].Update.Kind)
+	assert.Equal(t, "$", o.Actions[2].Target)
+
+	applied, warnings, err := o.Apply(original)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.True(t, equal(rootOf(applied), rootOf(modified)))
+}
+
+func TestGenerate_NoChanges(t *testing.T) {
+	doc := buildDocument(t, burgerSpec)
+	o, err := Generate(doc, doc)
+	assert.ErrorIs(t, err, ErrNoChanges)
+	assert.Nil(t, o)
+
+	_, err = Generate(nil, doc)
+	assert.Error(t, err)
+}
+
+func TestKeyPath(t *testing.T) {
+	assert.Equal(t, "$.info", keyPath("$", "info"))
+	assert.Equal(t, "$['x-burger']", keyPath("$", "x-burger"))
+	assert.Equal(t, `$['it\'s']`, keyPath("$", "it's"))
+	assert.Equal(t, "$['200']", keyPath("$", "200"))
+}
@@ -0,0 +1,233 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package overlay provides support for the OpenAPI Overlay Specification 1.0. An overlay is a document containing an
+// ordered list of actions, each action targets nodes in an OpenAPI document using a JSONPath expression, and either
+// updates them, or removes them.
+//
+// Overlays can be parsed, applied to a libopenapi.Document, and generated from the difference between two documents.
+//
+//   - https://spec.openapis.org/overlay/v1.0.0.html
+package overlay
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the version of the Overlay Specification supported, and set on generated overlays.
+const Version = "1.0.0"
+
+// ErrInvalidOverlay is returned when an overlay document cannot be parsed, or is not valid.
+var ErrInvalidOverlay = errors.New("invalid overlay")
+
+// ErrNoChanges is returned by Generate when the documents are the same, an overlay needs at least one action.
+var ErrNoChanges = errors.New("no changes to generate an overlay from")
+
+// Overlay represents an Overlay document.
+type Overlay struct {
+	// Overlay is the version of the Overlay Specification the document uses.
+	Overlay string `json:"overlay,omitempty" yaml:"overlay,omitempty"`
+
+	// Info contains metadata about the overlay.
+	Info *Info `json:"info,omitempty" yaml:"info,omitempty"`
+
+	// Extends is a URL to the OpenAPI document the overlay is meant to be applied to.
+	Extends string `json:"extends,omitempty" yaml:"extends,omitempty"`
+
+	// Actions are applied in order, to the result of the previous action.
+	Actions    []*Action                           `json:"actions,omitempty" yaml:"actions,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+}
+
+// Info contains metadata about an overlay.
+type Info struct {
+	Title      string                              `json:"title,omitempty" yaml:"title,omitempty"`
+	Version    string                              `json:"version,omitempty" yaml:"version,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+}
+
+// Action represents a single change to an OpenAPI document.
+type Action struct {
+	// Target is a JSONPath expression that selects the nodes the action applies to.
+	Target      string `json:"target,omitempty" yaml:"target,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Update is merged into every object selected, or appended to every array selected.
+	Update *yaml.Node `json:"update,omitempty" yaml:"update,omitempty"`
+
+	// Remove removes every node selected from its parent. If set, Update is ignored.
+	Remove     bool                                `json:"remove,omitempty" yaml:"remove,omitempty"`
+	Extensions *orderedmap.Map[string, *yaml.Node] `json:"-" yaml:"-"`
+
+	node *yaml.Node
+}
+
+// ParseOverlay parses the bytes of an Overlay document, in YAML or JSON. The overlay is not validated, use
+// Validate to check it.
+func ParseOverlay(bytes []byte) (*Overlay, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(bytes, &root); err != nil {
+		return nil, errors.Join(ErrInvalidOverlay, err)
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: an overlay document must be an object", ErrInvalidOverlay)
+	}
+
+	o := new(Overlay)
+	m := root.Content[0]
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		key, value := m.Content[i], m.Content[i+1]
+		switch key.Value {
+		case "overlay":
+			o.Overlay = value.Value
+		case "extends":
+			o.Extends = value.Value
+		case "info":
+			o.Info = parseInfo(value)
+		case "actions":
+			for _, a := range value.Content {
+				o.Actions = append(o.Actions, parseAction(a))
+			}
+		default:
+			o.Extensions = addExtension(o.Extensions, key, value)
+		}
+	}
+	return o, nil
+}
+
+func parseInfo(m *yaml.Node) *Info {
+	info := new(Info)
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		key, value := m.Content[i], m.Content[i+1]
+		switch key.Value {
+		case "title":
+			info.Title = value.Value
+		case "version":
+			info.Version = value.Value
+		default:
+			info.Extensions = addExtension(info.Extensions, key, value)
+		}
+	}
+	return info
+}
+
+func parseAction(m *yaml.Node) *Action {
+	a := &Action{node: m}
+	for i := 0; i < len(m.Content)-1; i += 2 {
+		key, value := m.Content[i], m.Content[i+1]
+		switch key.Value {
+		case "target":
+			a.Target = value.Value
+		case "description":
+			a.Description = value.Value
+		case "update":
+			a.Update = value
+		case "remove":
+			a.Remove = value.Value == "true"
+		default:
+			a.Extensions = addExtension(a.Extensions, key, value)
+		}
+	}
+	return a
+}
+
+func addExtension(ext *orderedmap.Map[string, *yaml.Node], key, value *yaml.Node) *orderedmap.Map[string, *yaml.Node] {
+	if !strings.HasPrefix(key.Value, "x-") {
+		return ext
+	}
+	if ext == nil {
+		ext = orderedmap.New[string, *yaml.Node]()
+	}
+	ext.Set(key.Value, value)
+	return ext
+}
+
+// Validate checks the overlay against the rules of the Overlay Specification. All problems are returned as a
+// single joined error.
+func (o *Overlay) Validate() error {
+	var errs []error
+	if !strings.HasPrefix(o.Overlay, "1.") {
+		errs = append(errs, fmt.Errorf("%w: overlay version '%s' is not supported, expected 1.x", ErrInvalidOverlay, o.Overlay))
+	}
+	if o.Info == nil || o.Info.Title == "" {
+		errs = append(errs, fmt.Errorf("%w: info.title is required", ErrInvalidOverlay))
+	}
+	if o.Info == nil || o.Info.Version == "" {
+		errs = append(errs, fmt.Errorf("%w: info.version is required", ErrInvalidOverlay))
+	}
+	if len(o.Actions) == 0 {
+		errs = append(errs, fmt.Errorf("%w: at least one action is required", ErrInvalidOverlay))
+	}
+	for i, a := range o.Actions {
+		if !strings.HasPrefix(a.Target, "$") {
+			errs = append(errs, fmt.Errorf("%w: action %d%s: target must be a JSONPath expression", ErrInvalidOverlay, i, a.location()))
+		}
+		if a.Update == nil && !a.Remove {
+			errs = append(errs, fmt.Errorf("%w: action %d%s: an action must update or remove its target", ErrInvalidOverlay, i, a.location()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (a *Action) location() string {
+	if a.node == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (line %d, column %d)", a.node.Line, a.node.Column)
+}
+
+// Render renders the overlay as YAML.
+func (o *Overlay) Render() ([]byte, error) {
+	root := utils.CreateEmptyMapNode()
+	addKey(root, "overlay", stringNode(o.Overlay))
+	if o.Info != nil {
+		info := utils.CreateEmptyMapNode()
+		addKey(info, "title", stringNode(o.Info.Title))
+		addKey(info, "version", stringNode(o.Info.Version))
+		addExtensions(info, o.Info.Extensions)
+		addKey(root, "info", info)
+	}
+	addKey(root, "extends", stringNode(o.Extends))
+	actions := utils.CreateEmptySequenceNode()
+	for _, a := range o.Actions {
+		action := utils.CreateEmptyMapNode()
+		addKey(action, "target", stringNode(a.Target))
+		addKey(action, "description", stringNode(a.Description))
+		if a.Remove {
+			addKey(action, "remove", utils.CreateBoolNode("true"))
+		} else {
+			addKey(action, "update", a.Update)
+		}
+		addExtensions(action, a.Extensions)
+		actions.Content = append(actions.Content, action)
+	}
+	addKey(root, "actions", actions)
+	addExtensions(root, o.Extensions)
+	return yaml.Marshal(root)
+}
+
+func stringNode(value string) *yaml.Node {
+	if value == "" {
+		return nil
+	}
+	return utils.CreateStringNode(value)
+}
+
+func addKey(m *yaml.Node, key string, value *yaml.Node) {
+	if value == nil {
+		return
+	}
+	m.Content = append(m.Content, utils.CreateStringNode(key), value)
+}
+
+func addExtensions(m *yaml.Node, ext *orderedmap.Map[string, *yaml.Node]) {
+	for k, v := range ext.FromOldest() {
+		addKey(m, k, v)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package overlay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const burgerOverlay = `overlay: 1.0.0
+info:
+  title: Burger overlay
+  version: 1.2.0
+  x-team: kitchen
+extends: https://pb33f.io/burgers.yaml
+actions:
+  - target: $.info
+    description: Rename the API
+    update:
+      title: Burger Shop
+  - target: $.paths['/burgers'].delete
+    remove: true
+    x-reason: deprecated
+x-generated: false
+`
+
+func TestParseOverlay(t *testing.T) {
+	o, err := ParseOverlay([]byte(burgerOverlay))
+	require.NoError(t, err)
+	require.NoError(t, o.Validate())
+
+	assert.Equal(t, "1.0.0", o.Overlay)
+	assert.Equal(t, "Burger overlay", o.Info.Title)
+	assert.Equal(t, "1.2.0", o.Info.Version)
+	assert.Equal(t, "kitchen", o.Info.Extensions.GetOrZero("x-team").Value)
+	assert.Equal(t, "https://pb33f.io/burgers.yaml", o.Extends)
+	assert.Equal(t, "false", o.Extensions.GetOrZero("x-generated").Value)
+
+	require.Len(t, o.Actions, 2)
+	assert.Equal(t, "$.info", o.Actions[0].Target)
+	assert.Equal(t, "Rename the API", o.Actions[0].Description)
+	assert.False(t, o.Actions[0].Remove)
+	require.NotNil(t, o.Actions[0].Update)
+	assert.Equal(t, "Burger Shop", o.Actions[0].Update.Content[1].Value)
+	assert.Equal(t, "$.paths['/burgers'].delete", o.Actions[1].Target)
+	assert.True(t, o.Actions[1].Remove)
+	assert.Equal(t, "deprecated", o.Actions[1].Extensions.GetOrZero("x-reason").Value)
+}
+
+func TestParseOverlay_JSON(t *testing.T) {
+	o, err := ParseOverlay([]byte(`{"overlay": "1.0.0", "info": {"title": "t", "version": "1"},
+"actions": [{"target": "$.info", "update": {"title": "json"}}]}`))
+	require.NoError(t, err)
+	assert.NoError(t, o.Validate())
+	assert.Equal(t, "$.info", o.Actions[0].Target)
+}
+
+func TestParseOverlay_Invalid(t *testing.T) {
+	_, err := ParseOverlay([]byte("overlay: [1.0.0"))
+	assert.ErrorIs(t, err, ErrInvalidOverlay)
+
+	_, err = ParseOverlay([]byte("- overlay"))
+	assert.ErrorIs(t, err, ErrInvalidOverlay)
+	assert.ErrorContains(t, err, "an overlay document must be an object")
+}
+
+func TestOverlay_Validate(t *testing.T) {
+	o, err := ParseOverlay([]byte(`overlay: 2.0.0
+actions:
+  - target: info
+    update:
+      title: nope
+  - target: $.info
+`))
+	require.NoError(t, err)
+
+	err = o.Validate()
+	assert.ErrorIs(t, err, ErrInvalidOverlay)
+	assert.ErrorContains(t, err, "overlay version '2.0.0' is not supported, expected 1.x")
+	assert.ErrorContains(t, err, "info.title is required")
+	assert.ErrorContains(t, err, "info.version is required")
+	assert.ErrorContains(t, err, "action 0 (line 3, column 5): target must be a JSONPath expression")
+	assert.ErrorContains(t, err, "action 1 (line 6, column 5): an action must update or remove its target")
+
+	o, _ = ParseOverlay([]byte("overlay: 1.0.0\ninfo:\n  title: t\n  version: 1\n"))
+	assert.ErrorContains(t, o.Validate(), "at least one action is required")
+}
+
+func TestOverlay_Render(t *testing.T) {
+	o, err := ParseOverlay([]byte(burgerOverlay))
+	require.NoError(t, err)
+
+	out, err := o.Render()
+	require.NoError(t, err)
+	assert.Equal(t, `overlay: 1.0.0
+info:
+    title: Burger overlay
+    version: 1.2.0
+    x-team: kitchen
+extends: https://pb33f.io/burgers.yaml
+actions:
+    - target: $.info
+      description: Rename the API
+      update:
+        title: Burger Shop
+    - target: $.paths['/burgers'].delete
+      remove: true
+      x-reason: deprecated
+x-generated: false
+`, string(out))
+
+	reparsed, err := ParseOverlay(out)
+	require.NoError(t, err)
+	assert.NoError(t, reparsed.Validate())
+}
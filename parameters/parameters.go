@@ -0,0 +1,454 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package parameters serialises values into parameters and deserialises them back, following the style and explode
+// of OpenAPI 3+ parameters (matrix, label, form, simple, spaceDelimited, pipeDelimited and deepObject), and the
+// collectionFormat of Swagger (OpenAPI 2) parameters.
+//
+// Values are serialised into the form they take in a request, as shown by the OpenAPI specification: query and cookie
+// parameters include their names (id=3&id=4), path and header parameters are just the value (3,4 or ;id=3,4). The
+// same form is deserialised, where a query string (or a cookie header) can hold other parameters too.
+//
+// Deserialised values are typed by the schema of the parameter: integers are int64, numbers float64, booleans bool,
+// arrays []any and objects map[string]any. A value that is not of the type its schema expects is left as a string,
+// so validating it against the schema explains the problem.
+package parameters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// ErrMissing is returned when deserialising a query or cookie parameter that is not present.
+var ErrMissing = errors.New("parameter is not present")
+
+// locations are where each style can be used.
+var locations = map[string][]string{
+	"matrix":         {"path"},
+	"label":          {"path"},
+	"simple":         {"path", "header"},
+	"form":           {"query", "cookie"},
+	"spaceDelimited": {"query"},
+	"pipeDelimited":  {"query"},
+	"deepObject":     {"query"},
+}
+
+// checkLocation returns why the style of a parameter can't be used in its location, or an empty string if it can.
+// Unknown styles are reported by the caller.
+func checkLocation(style, in string) string {
+	if allowed, ok := locations[style]; ok && !slices.Contains(allowed, in) {
+		return fmt.Sprintf("the %s style can't be used for %s parameters", style, in)
+	}
+	return ""
+}
+
+// Style returns the style of a parameter and if it explodes, using the defaults for its location when they are not
+// set.
+func Style(param *v3.Parameter) (string, bool) {
+	style := param.Style
+	if style == "" {
+		switch param.In {
+		case "query", "cookie":
+			style = "form"
+		default:
+			style = "simple"
+		}
+	}
+	explode := style == "form"
+	if param.Explode != nil {
+		explode = *param.Explode
+	}
+	return style, explode
+}
+
+// Serialize turns a value into the form a parameter takes in a request, using the style of the parameter. The value
+// can be anything that can be encoded as JSON, like a map or a struct for an object. Parameters described by content
+// rather than a schema are serialised as JSON for JSON media types, and as strings otherwise. An error is returned if
+// the style can't be used in the location of the parameter, like matrix for a query parameter.
+func Serialize(param *v3.Parameter, value any) (string, error) {
+	if param == nil {
+		return "", fmt.Errorf("unable to serialise parameter: no parameter has been supplied")
+	}
+	v, err := normalise(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to serialise parameter '%s': %w", param.Name, err)
+	}
+	escape := escaper(param.In, param.AllowReserved)
+
+	if param.Schema == nil && param.Content != nil {
+		var serialised string
+		for mediaType := range param.Content.FromOldest() {
+			if parsed, _, err := mime.ParseMediaType(mediaType); err == nil && isJSON(parsed) {
+				data, err := json.Marshal(v)
+				if err != nil {
+					return "", fmt.Errorf("unable to serialise parameter '%s': %w", param.Name, err)
+				}
+				serialised = string(data)
+			} else {
+				serialised = primitive(v)
+			}
+			break
+		}
+		if param.In == "query" || param.In == "cookie" {
+			return escape(param.Name) + "=" + escape(serialised), nil
+		}
+		return escape(serialised), nil
+	}
+
+	style, explode := Style(param)
+	if problem := checkLocation(style, param.In); problem != "" {
+		return "", fmt.Errorf("unable to serialise parameter '%s': %s", param.Name, problem)
+	}
+	name := escape(param.Name)
+	switch style {
+	case "simple":
+		return delimited(v, param.Schema, escape, ",", "", explode), nil
+	case "label":
+		return "." + delimited(v, param.Schema, escape, ",", ".", explode), nil
+	case "matrix":
+		switch t := v.(type) {
+		case []any:
+			if explode {
+				return ";" + name + "=" + strings.Join(escapeAll(t, escape), ";"+name+"="), nil
+			}
+		case map[string]any:
+			if explode {
+				return ";" + strings.Join(pairs(t, param.Schema, escape), ";"), nil
+			}
+		default:
+			if s := escape(primitive(v)); s != "" {
+				return ";" + name + "=" + s, nil
+			}
+			return ";" + name, nil
+		}
+		return ";" + name + "=" + delimited(v, param.Schema, escape, ",", "", false), nil
+	case "form":
+		switch t := v.(type) {
+		case []any:
+			if explode {
+				return name + "=" + strings.Join(escapeAll(t, escape), "&"+name+"="), nil
+			}
+		case map[string]any:
+			if explode {
+				return strings.Join(pairs(t, param.Schema, escape), "&"), nil
+			}
+		}
+		return name + "=" + delimited(v, param.Schema, escape, ",", "", false), nil
+	case "spaceDelimited", "pipeDelimited":
+		delimiter := "%20"
+		if style == "pipeDelimited" {
+			delimiter = "|"
+		}
+		switch t := v.(type) {
+		case []any:
+			if explode {
+				return name + "=" + strings.Join(escapeAll(t, escape), "&"+name+"="), nil
+			}
+			return name + "=" + strings.Join(escapeAll(t, escape), delimiter), nil
+		case map[string]any:
+			return name + "=" + strings.Join(alternating(t, param.Schema, escape), delimiter), nil
+		}
+		return "", fmt.Errorf("unable to serialise parameter '%s': the %s style is for arrays and objects", param.Name,
+			style)
+	case "deepObject":
+		object, ok := v.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("unable to serialise parameter '%s': the deepObject style is for objects", param.Name)
+		}
+		var parts []string
+		for _, key := range keys(object, param.Schema) {
+			parts = append(parts, name+"%5B"+escape(key)+"%5D="+escape(primitive(object[key])))
+		}
+		return strings.Join(parts, "&"), nil
+	}
+	return "", fmt.Errorf("unable to serialise parameter '%s': unknown style '%s'", param.Name, style)
+}
+
+// Deserialize parses the form a parameter takes in a request back into a value, typed by the schema of the
+// parameter. Path parameters are the (escaped) value matched by the path template, header parameters the value of
+// the header, query parameters the query string, and cookie parameters the cookie header (or name=value pairs).
+//
+// ErrMissing is returned if a query or cookie parameter is not present. Whitespace around the commas of header values
+// is ignored.
+func Deserialize(param *v3.Parameter, raw string) (any, error) {
+	if param == nil {
+		return nil, fmt.Errorf("unable to deserialise parameter: no parameter has been supplied")
+	}
+	unescape := unescaper(param.In)
+	var values []string
+	var named map[string][]string
+	if param.In == "query" || param.In == "cookie" {
+		named = namedValues(raw, param.In == "cookie")
+		values = named[param.Name]
+	} else {
+		values = []string{raw}
+	}
+
+	if param.Schema == nil && param.Content != nil {
+		if len(values) == 0 {
+			return nil, fmt.Errorf("unable to deserialise parameter '%s': %w", param.Name, ErrMissing)
+		}
+		value := unescape(values[0])
+		for mediaType := range param.Content.FromOldest() {
+			if parsed, _, err := mime.ParseMediaType(mediaType); err == nil && isJSON(parsed) {
+				var decoded any
+				if err = json.Unmarshal([]byte(value), &decoded); err != nil {
+					return nil, fmt.Errorf("unable to deserialise parameter '%s': %w", param.Name, err)
+				}
+				return decoded, nil
+			}
+			break
+		}
+		return value, nil
+	}
+
+	style, explode := Style(param)
+	if problem := checkLocation(style, param.In); problem != "" {
+		return nil, fmt.Errorf("unable to deserialise parameter '%s': %s", param.Name, problem)
+	}
+	k := kind(param.Schema)
+	// the properties of exploded objects are parameters of their own.
+	exploded := k == "object" && ((style == "form" && explode) || style == "deepObject")
+	if len(values) == 0 && !exploded {
+		return nil, fmt.Errorf("unable to deserialise parameter '%s': %w", param.Name, ErrMissing)
+	}
+	value := ""
+	if len(values) > 0 {
+		value = values[0]
+	}
+	fail := func(format string, args ...any) (any, error) {
+		return nil, fmt.Errorf("unable to deserialise parameter '%s': %s", param.Name, fmt.Sprintf(format, args...))
+	}
+
+	var parts []string
+	switch style {
+	case "simple":
+		if k == "primitive" {
+			return typed(unescape(value), param.Schema), nil
+		}
+		parts = strings.Split(value, ",")
+	case "label":
+		if !strings.HasPrefix(value, ".") {
+			return fail("'%s' must start with '.' for the label style", value)
+		}
+		value = value[1:]
+		if k == "primitive" {
+			return typed(unescape(value), param.Schema), nil
+		}
+		if explode {
+			parts = strings.Split(value, ".")
+		} else {
+			parts = strings.Split(value, ",")
+		}
+	case "matrix":
+		if !strings.HasPrefix(value, ";") {
+			return fail("'%s' must start with ';' for the matrix style", value)
+		}
+		segments := strings.Split(value[1:], ";")
+		if k == "object" && explode {
+			parts = segments
+			break
+		}
+		for _, segment := range segments {
+			name, v, _ := strings.Cut(segment, "=")
+			if unescape(name) != param.Name {
+				return fail("'%s' must be named '%s' for the matrix style", segment, param.Name)
+			}
+			if k == "array" && explode {
+				parts = append(parts, v)
+			} else {
+				parts = strings.Split(v, ",")
+			}
+		}
+		if k == "primitive" {
+			return typed(unescape(strings.Join(parts, ",")), param.Schema), nil
+		}
+	case "form", "spaceDelimited", "pipeDelimited":
+		if k == "primitive" {
+			return typed(unescape(value), param.Schema), nil
+		}
+		switch {
+		case k == "object" && explode:
+			return present(param, explodedObject(param.Schema, named, unescape))
+		case explode:
+			parts = values
+		default:
+			parts = split(value, style)
+		}
+	case "deepObject":
+		if k != "object" {
+			return fail("the deepObject style is for objects, not %s values", k)
+		}
+		return present(param, deepObject(param.Name, param.Schema, named, unescape))
+	default:
+		return fail("unknown style '%s'", style)
+	}
+
+	if param.In == "header" {
+		// HTTP allows whitespace around the commas of a header.
+		for i, part := range parts {
+			parts[i] = strings.TrimSpace(part)
+		}
+	}
+	if k == "array" {
+		items := make([]any, 0, len(parts))
+		if len(parts) == 1 && parts[0] == "" {
+			return items, nil
+		}
+		item := itemSchema(param.Schema)
+		for _, part := range parts {
+			items = append(items, typed(unescape(part), item))
+		}
+		return items, nil
+	}
+
+	object := make(map[string]any)
+	if (style == "simple" || style == "label" || style == "matrix") && explode {
+		for _, part := range parts {
+			name, v, ok := strings.Cut(part, "=")
+			if !ok {
+				return fail("'%s' must be a name=value pair", part)
+			}
+			name = unescape(name)
+			object[name] = typed(unescape(v), propertySchema(param.Schema, name))
+		}
+		return object, nil
+	}
+	if len(parts)%2 != 0 {
+		return fail("'%s' must be a list of names and values", value)
+	}
+	for i := 0; i+1 < len(parts); i += 2 {
+		name := unescape(parts[i])
+		object[name] = typed(unescape(parts[i+1]), propertySchema(param.Schema, name))
+	}
+	return object, nil
+}
+
+// present returns an object serialised as separate parameters, or ErrMissing if none of its properties are present.
+func present(param *v3.Parameter, object map[string]any) (any, error) {
+	if len(object) == 0 {
+		return nil, fmt.Errorf("unable to deserialise parameter '%s': %w", param.Name, ErrMissing)
+	}
+	return object, nil
+}
+
+// split splits an array or object serialised in a query by the delimiter of its style, before it is unescaped.
+func split(value, style string) []string {
+	switch style {
+	case "spaceDelimited":
+		value = strings.NewReplacer("%20", " ", "+", " ").Replace(value)
+		return strings.Split(value, " ")
+	case "pipeDelimited":
+		value = strings.NewReplacer("%7C", "|", "%7c", "|").Replace(value)
+		return strings.Split(value, "|")
+	}
+	return strings.Split(value, ",")
+}
+
+// explodedObject collects the properties of an object serialised as separate parameters.
+func explodedObject(schema *base.SchemaProxy, named map[string][]string, unescape func(string) string) map[string]any {
+	object := make(map[string]any)
+	s, _ := schemaOf(schema)
+	if s == nil {
+		return object
+	}
+	for name, property := range s.Properties.FromOldest() {
+		if values := named[name]; len(values) > 0 {
+			object[name] = typed(unescape(values[0]), property)
+		}
+	}
+	return object
+}
+
+// deepObject collects the properties of an object serialised as name[property]=value parameters.
+func deepObject(name string, schema *base.SchemaProxy, named map[string][]string,
+	unescape func(string) string,
+) map[string]any {
+	object := make(map[string]any)
+	for key, values := range named {
+		if property, ok := strings.CutPrefix(key, name+"["); ok && strings.HasSuffix(property, "]") &&
+			len(values) > 0 {
+			property = strings.TrimSuffix(property, "]")
+			object[property] = typed(unescape(values[0]), propertySchema(schema, property))
+		}
+	}
+	return object
+}
+
+// delimited serialises a value as a list, where exploded objects are name=value pairs. Exploded lists are separated
+// by the explode delimiter when there is one.
+func delimited(v any, schema *base.SchemaProxy, escape func(string) string, delimiter, explodeDelimiter string,
+	explode bool,
+) string {
+	if explode && explodeDelimiter != "" {
+		delimiter = explodeDelimiter
+	}
+	switch t := v.(type) {
+	case []any:
+		return strings.Join(escapeAll(t, escape), delimiter)
+	case map[string]any:
+		if explode {
+			return strings.Join(pairs(t, schema, escape), delimiter)
+		}
+		return strings.Join(alternating(t, schema, escape), delimiter)
+	}
+	return escape(primitive(v))
+}
+
+// pairs serialises the properties of an object as name=value pairs.
+func pairs(object map[string]any, schema *base.SchemaProxy, escape func(string) string) []string {
+	var parts []string
+	for _, key := range keys(object, schema) {
+		parts = append(parts, escape(key)+"="+escape(primitive(object[key])))
+	}
+	return parts
+}
+
+// alternating serialises the properties of an object as a list of names and values.
+func alternating(object map[string]any, schema *base.SchemaProxy, escape func(string) string) []string {
+	var parts []string
+	for _, key := range keys(object, schema) {
+		parts = append(parts, escape(key), escape(primitive(object[key])))
+	}
+	return parts
+}
+
+// keys returns the keys of an object, in the order of the properties of its schema then in alphabetical order.
+func keys(object map[string]any, schema *base.SchemaProxy) []string {
+	var ordered []string
+	if s, _ := schemaOf(schema); s != nil {
+		for name := range s.Properties.FromOldest() {
+			if _, ok := object[name]; ok {
+				ordered = append(ordered, name)
+			}
+		}
+	}
+	var rest []string
+	for key := range object {
+		if !slices.Contains(ordered, key) {
+			rest = append(rest, key)
+		}
+	}
+	slices.Sort(rest)
+	return append(ordered, rest...)
+}
+
+func escapeAll(values []any, escape func(string) string) []string {
+	escaped := make([]string, len(values))
+	for i, v := range values {
+		escaped[i] = escape(primitive(v))
+	}
+	return escaped
+}
+
+// isJSON returns true for JSON media types, like 'application/json' and 'application/problem+json'.
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package parameters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func schemaOfType(t string) *base.SchemaProxy {
+	return base.CreateSchemaProxy(&base.Schema{Type: []string{t}})
+}
+
+var (
+	stringSchema = schemaOfType("string")
+	arraySchema  = base.CreateSchemaProxy(&base.Schema{
+		Type:  []string{"array"},
+		Items: &base.DynamicValue[*base.SchemaProxy, bool]{A: stringSchema},
+	})
+	objectSchema = func() *base.SchemaProxy {
+		properties := orderedmap.New[string, *base.SchemaProxy]()
+		properties.Set("R", schemaOfType("integer"))
+		properties.Set("G", schemaOfType("integer"))
+		properties.Set("B", schemaOfType("integer"))
+		return base.CreateSchemaProxy(&base.Schema{Type: []string{"object"}, Properties: properties})
+	}()
+)
+
+func colorParameter(in, style string, explode bool, schema *base.SchemaProxy) *v3.Parameter {
+	return &v3.Parameter{Name: "color", In: in, Style: style, Explode: &explode, Schema: schema}
+}
+
+// the examples of the style values table of the OpenAPI specification.
+func TestSerialize_Styles(t *testing.T) {
+	values := []any{"blue", []any{"blue", "black", "brown"}, map[string]any{"R": 100, "G": 200, "B": 150}}
+	schemas := []*base.SchemaProxy{stringSchema, arraySchema, objectSchema}
+	decoded := []any{"blue", []any{"blue", "black", "brown"}, map[string]any{"R": int64(100), "G": int64(200), "B": int64(150)}}
+
+	tests := []struct {
+		in, style string
+		explode   bool
+		expected  []string
+	}{
+		{"path", "matrix", false, []string{";color=blue", ";color=blue,black,brown", ";color=R,100,G,200,B,150"}},
+		{"path", "matrix", true, []string{";color=blue", ";color=blue;color=black;color=brown", ";R=100;G=200;B=150"}},
+		{"path", "label", false, []string{".blue", ".blue,black,brown", ".R,100,G,200,B,150"}},
+		{"path", "label", true, []string{".blue", ".blue.black.brown", ".R=100.G=200.B=150"}},
+		{"path", "simple", false, []string{"blue", "blue,black,brown", "R,100,G,200,B,150"}},
+		{"header", "simple", true, []string{"blue", "blue,black,brown", "R=100,G=200,B=150"}},
+		{"query", "form", false, []string{"color=blue", "color=blue,black,brown", "color=R,100,G,200,B,150"}},
+		{"query", "form", true, []string{"color=blue", "color=blue&color=black&color=brown", "R=100&G=200&B=150"}},
+		{"cookie", "form", false, []string{"color=blue", "color=blue,black,brown", "color=R,100,G,200,B,150"}},
+		{"query", "spaceDelimited", false, []string{"", "color=blue%20black%20brown", "color=R%20100%20G%20200%20B%20150"}},
+		{"query", "pipeDelimited", false, []string{"", "color=blue|black|brown", "color=R|100|G|200|B|150"}},
+		{"query", "deepObject", true, []string{"", "", "color%5BR%5D=100&color%5BG%5D=200&color%5BB%5D=150"}},
+	}
+	for _, test := range tests {
+		for i, expected := range test.expected {
+			if expected == "" {
+				continue
+			}
+			param := colorParameter(test.in, test.style, test.explode, schemas[i])
+			serialised, err := Serialize(param, values[i])
+			require.NoError(t, err, "%s %s %v", test.in, test.style, test.explode)
+			assert.Equal(t, expected, serialised, "%s %s %v", test.in, test.style, test.explode)
+
+			value, err := Deserialize(param, serialised)
+			require.NoError(t, err, "%s %s %v", test.in, test.style, test.explode)
+			assert.Equal(t, decoded[i], value, "%s %s %v", test.in, test.style, test.explode)
+		}
+	}
+}
+
+func TestSerialize_Defaults(t *testing.T) {
+	style, explode := Style(&v3.Parameter{In: "query"})
+	assert.Equal(t, "form", style)
+	assert.True(t, explode)
+
+	style, explode = Style(&v3.Parameter{In: "path"})
+	assert.Equal(t, "simple", style)
+	assert.False(t, explode)
+
+	// structs are serialised like the objects they encode as.
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+	serialised, err := Serialize(&v3.Parameter{Name: "p", In: "query", Schema: schemaOfType("object")}, point{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "x=1&y=2", serialised)
+}
+
+func TestSerialize_Escaping(t *testing.T) {
+	param := &v3.Parameter{Name: "q", In: "query", Schema: stringSchema}
+	serialised, err := Serialize(param, "a b/c&d")
+	require.NoError(t, err)
+	assert.Equal(t, "q=a%20b%2Fc%26d", serialised)
+	value, err := Deserialize(param, serialised)
+	require.NoError(t, err)
+	assert.Equal(t, "a b/c&d", value)
+
+	param.AllowReserved = true
+	serialised, err = Serialize(param, "a/b?c")
+	require.NoError(t, err)
+	assert.Equal(t, "q=a/b?c", serialised)
+
+	// a comma in a value is escaped, so it is not mistaken for a delimiter.
+	param = colorParameter("path", "simple", false, arraySchema)
+	serialised, err = Serialize(param, []string{"a,b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, "a%2Cb,c", serialised)
+	value, err = Deserialize(param, serialised)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a,b", "c"}, value)
+}
+
+func TestDeserialize(t *testing.T) {
+	// query strings and cookies can hold other parameters.
+	param := &v3.Parameter{Name: "limit", In: "query", Schema: schemaOfType("integer")}
+	value, err := Deserialize(param, "offset=3&limit=10")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), value)
+
+	param = &v3.Parameter{Name: "session", In: "cookie", Schema: stringSchema}
+	value, err = Deserialize(param, "theme=dark; session=abc")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", value)
+
+	_, err = Deserialize(param, "theme=dark")
+	assert.True(t, errors.Is(err, ErrMissing))
+
+	param = colorParameter("query", "form", true, objectSchema)
+	_, err = Deserialize(param, "offset=3")
+	assert.True(t, errors.Is(err, ErrMissing))
+
+	// values that are not of their type are left as strings.
+	param = &v3.Parameter{Name: "id", In: "path", Schema: schemaOfType("integer")}
+	value, err = Deserialize(param, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", value)
+
+	// without a schema, numbers and booleans are recognised.
+	param = &v3.Parameter{Name: "flag", In: "header"}
+	value, err = Deserialize(param, "true")
+	require.NoError(t, err)
+	assert.Equal(t, true, value)
+
+	value, err = Deserialize(colorParameter("path", "simple", false, arraySchema), "")
+	require.NoError(t, err)
+	assert.Equal(t, []any{}, value)
+
+	// whitespace around the commas of a header is allowed.
+	ids := &v3.Parameter{Name: "X-Ids", In: "header", Schema: base.CreateSchemaProxy(&base.Schema{
+		Type:  []string{"array"},
+		Items: &base.DynamicValue[*base.SchemaProxy, bool]{A: schemaOfType("integer")},
+	})}
+	value, err = Deserialize(ids, "1, 2,3")
+	require.NoError(t, err)
+	assert.Equal(t, []any{int64(1), int64(2), int64(3)}, value)
+
+	value, err = Deserialize(colorParameter("header", "simple", true, objectSchema), "R=100, G=200")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"R": int64(100), "G": int64(200)}, value)
+
+	// but not in a path.
+	value, err = Deserialize(colorParameter("path", "simple", false, arraySchema), "a,%20b")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", " b"}, value)
+}
+
+func TestDeserialize_Content(t *testing.T) {
+	content := orderedmap.New[string, *v3.MediaType]()
+	content.Set("application/json", &v3.MediaType{})
+	param := &v3.Parameter{Name: "filter", In: "query", Content: content}
+
+	serialised, err := Serialize(param, map[string]any{"age": 3})
+	require.NoError(t, err)
+	assert.Equal(t, "filter=%7B%22age%22%3A3%7D", serialised)
+
+	value, err := Deserialize(param, serialised)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"age": float64(3)}, value)
+
+	_, err = Deserialize(param, "filter=%7B")
+	assert.Error(t, err)
+}
+
+func TestSerialize_Errors(t *testing.T) {
+	_, err := Serialize(nil, 1)
+	assert.EqualError(t, err, "unable to serialise parameter: no parameter has been supplied")
+
+	_, err = Serialize(colorParameter("query", "deepObject", true, arraySchema), []string{"a"})
+	assert.EqualError(t, err, "unable to serialise parameter 'color': the deepObject style is for objects")
+
+	_, err = Serialize(colorParameter("query", "pipeDelimited", false, stringSchema), "a")
+	assert.EqualError(t, err, "unable to serialise parameter 'color': the pipeDelimited style is for arrays and objects")
+
+	_, err = Serialize(colorParameter("query", "fancy", false, stringSchema), "a")
+	assert.EqualError(t, err, "unable to serialise parameter 'color': unknown style 'fancy'")
+
+	_, err = Serialize(colorParameter("query", "form", false, stringSchema), func() {})
+	assert.Error(t, err)
+
+	// styles that can't be used in a location are not serialised, they couldn't be deserialised.
+	_, err = Serialize(colorParameter("query", "matrix", false, stringSchema), "blue")
+	assert.EqualError(t, err, "unable to serialise parameter 'color': the matrix style can't be used for query parameters")
+
+	_, err = Serialize(colorParameter("query", "label", false, stringSchema), "blue")
+	assert.EqualError(t, err, "unable to serialise parameter 'color': the label style can't be used for query parameters")
+
+	_, err = Serialize(colorParameter("path", "deepObject", true, objectSchema), map[string]any{"R": 1})
+	assert.EqualError(t, err,
+		"unable to serialise parameter 'color': the deepObject style can't be used for path parameters")
+
+	_, err = Serialize(colorParameter("header", "form", false, stringSchema), "blue")
+	assert.EqualError(t, err, "unable to serialise parameter 'color': the form style can't be used for header parameters")
+}
+
+func TestDeserialize_Errors(t *testing.T) {
+	_, err := Deserialize(nil, "")
+	assert.EqualError(t, err, "unable to deserialise parameter: no parameter has been supplied")
+
+	_, err = Deserialize(colorParameter("path", "label", false, stringSchema), "blue")
+	assert.EqualError(t, err, "unable to deserialise parameter 'color': 'blue' must start with '.' for the label style")
+
+	_, err = Deserialize(colorParameter("path", "matrix", false, stringSchema), "blue")
+	assert.EqualError(t, err, "unable to deserialise parameter 'color': 'blue' must start with ';' for the matrix style")
+
+	_, err = Deserialize(colorParameter("path", "matrix", false, stringSchema), ";colour=blue")
+	assert.EqualError(t, err,
+		"unable to deserialise parameter 'color': 'colour=blue' must be named 'color' for the matrix style")
+
+	_, err = Deserialize(colorParameter("path", "simple", true, objectSchema), "R")
+	assert.EqualError(t, err, "unable to deserialise parameter 'color': 'R' must be a name=value pair")
+
+	_, err = Deserialize(colorParameter("path", "simple", false, objectSchema), "R,1,G")
+	assert.EqualError(t, err, "unable to deserialise parameter 'color': 'R,1,G' must be a list of names and values")
+
+	_, err = Deserialize(colorParameter("query", "matrix", false, stringSchema), "color=blue")
+	assert.EqualError(t, err,
+		"unable to deserialise parameter 'color': the matrix style can't be used for query parameters")
+
+	_, err = Deserialize(colorParameter("query", "deepObject", true, arraySchema), "color=a")
+	assert.EqualError(t, err, "unable to deserialise parameter 'color': the deepObject style is for objects, not array values")
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package parameters
+
+import (
+	"fmt"
+	"strings"
+
+	v2 "github.com/pb33f/libopenapi/datamodel/high/v2"
+)
+
+// collectionDelimiter returns the delimiter of a Swagger collectionFormat, escaped for the location it's used in.
+// The multi format has no delimiter, each value is a parameter of its own.
+func collectionDelimiter(format, in string) string {
+	escaped := in != "header"
+	switch format {
+	case "ssv":
+		if escaped {
+			return "%20"
+		}
+		return " "
+	case "tsv":
+		if escaped {
+			return "%09"
+		}
+		return "\t"
+	case "pipes":
+		return "|"
+	}
+	return ","
+}
+
+// splitCollection splits a value serialised with a Swagger collectionFormat, before it is unescaped.
+func splitCollection(value, format string) []string {
+	switch format {
+	case "ssv":
+		value = strings.NewReplacer("%20", " ", "+", " ").Replace(value)
+		return strings.Split(value, " ")
+	case "tsv":
+		value = strings.ReplaceAll(value, "%09", "\t")
+		return strings.Split(value, "\t")
+	case "pipes":
+		value = strings.NewReplacer("%7C", "|", "%7c", "|").Replace(value)
+		return strings.Split(value, "|")
+	}
+	return strings.Split(value, ",")
+}
+
+// location returns the OpenAPI 3 location that a Swagger parameter is escaped like, form data is escaped like a query.
+func location(in string) string {
+	if in == "formData" {
+		return "query"
+	}
+	return in
+}
+
+// SerializeV2 turns a value into the form a Swagger parameter takes in a request, using the collectionFormat of the
+// parameter (and of its items, for arrays of arrays). Query and form data parameters include their names, path and
+// header parameters are just the value. Body parameters are not serialised, they are the body of the request.
+func SerializeV2(param *v2.Parameter, value any) (string, error) {
+	if param == nil {
+		return "", fmt.Errorf("unable to serialise parameter: no parameter has been supplied")
+	}
+	if param.In == "body" {
+		return "", fmt.Errorf("unable to serialise parameter '%s': body parameters are not serialised", param.Name)
+	}
+	v, err := normalise(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to serialise parameter '%s': %w", param.Name, err)
+	}
+	in := location(param.In)
+	escape := escaper(in, false)
+	name := escape(param.Name)
+	named := in == "query"
+
+	items, ok := v.([]any)
+	if !ok {
+		if named {
+			return name + "=" + escape(primitive(v)), nil
+		}
+		return escape(primitive(v)), nil
+	}
+	if param.CollectionFormat == "multi" {
+		if !named {
+			return "", fmt.Errorf("unable to serialise parameter '%s': the multi collectionFormat is for query and "+
+				"form data parameters", param.Name)
+		}
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = name + "=" + serializeItem(item, param.Items, in, escape)
+		}
+		return strings.Join(parts, "&"), nil
+	}
+	serialised := serializeCollection(items, param.CollectionFormat, param.Items, in, escape)
+	if named {
+		return name + "=" + serialised, nil
+	}
+	return serialised, nil
+}
+
+func serializeCollection(items []any, format string, schema *v2.Items, in string, escape func(string) string) string {
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = serializeItem(item, schema, in, escape)
+	}
+	return strings.Join(parts, collectionDelimiter(format, in))
+}
+
+// serializeItem serialises an item of an array, which can be an array of its own.
+func serializeItem(item any, schema *v2.Items, in string, escape func(string) string) string {
+	if nested, ok := item.([]any); ok && schema != nil {
+		return serializeCollection(nested, schema.CollectionFormat, schema.Items, in, escape)
+	}
+	return escape(primitive(item))
+}
+
+// DeserializeV2 parses the form a Swagger parameter takes in a request back into a value, typed by the type of the
+// parameter (and of its items). Path parameters are the (escaped) value matched by the path template, header
+// parameters the value of the header, and query and form data parameters the query string or form body.
+//
+// ErrMissing is returned if a query or form data parameter is not present.
+func DeserializeV2(param *v2.Parameter, raw string) (any, error) {
+	if param == nil {
+		return nil, fmt.Errorf("unable to deserialise parameter: no parameter has been supplied")
+	}
+	if param.In == "body" {
+		return nil, fmt.Errorf("unable to deserialise parameter '%s': body parameters are not serialised", param.Name)
+	}
+	in := location(param.In)
+	unescape := unescaper(in)
+	values := []string{raw}
+	if in == "query" {
+		values = namedValues(raw, false)[param.Name]
+		if len(values) == 0 {
+			return nil, fmt.Errorf("unable to deserialise parameter '%s': %w", param.Name, ErrMissing)
+		}
+	}
+	if param.Type != "array" {
+		return typedAs(unescape(values[0]), []string{param.Type}), nil
+	}
+	parts := values
+	if param.CollectionFormat != "multi" {
+		parts = splitCollection(values[0], param.CollectionFormat)
+	}
+	if in == "header" && (param.CollectionFormat == "" || param.CollectionFormat == "csv") {
+		// HTTP allows whitespace around the commas of a header.
+		for i, part := range parts {
+			parts[i] = strings.TrimSpace(part)
+		}
+	}
+	return deserializeCollection(parts, param.Items, unescape), nil
+}
+
+func deserializeCollection(parts []string, schema *v2.Items, unescape func(string) string) []any {
+	items := make([]any, 0, len(parts))
+	if len(parts) == 1 && parts[0] == "" {
+		return items
+	}
+	for _, part := range parts {
+		switch {
+		case schema == nil:
+			items = append(items, typedAs(unescape(part), nil))
+		case schema.Type == "array":
+			items = append(items, deserializeCollection(splitCollection(part, schema.CollectionFormat), schema.Items,
+				unescape))
+		default:
+			items = append(items, typedAs(unescape(part), []string{schema.Type}))
+		}
+	}
+	return items
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package parameters
+
+import (
+	"errors"
+	"testing"
+
+	v2 "github.com/pb33f/libopenapi/datamodel/high/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializeV2_CollectionFormats(t *testing.T) {
+	tests := []struct {
+		in, format, expected string
+	}{
+		{"query", "", "ids=1,2,3"},
+		{"query", "csv", "ids=1,2,3"},
+		{"query", "ssv", "ids=1%202%203"},
+		{"query", "tsv", "ids=1%092%093"},
+		{"query", "pipes", "ids=1|2|3"},
+		{"query", "multi", "ids=1&ids=2&ids=3"},
+		{"formData", "multi", "ids=1&ids=2&ids=3"},
+		{"path", "ssv", "1%202%203"},
+		{"header", "ssv", "1 2 3"},
+		{"header", "tsv", "1\t2\t3"},
+	}
+	for _, test := range tests {
+		param := &v2.Parameter{Name: "ids", In: test.in, Type: "array", CollectionFormat: test.format,
+			Items: &v2.Items{Type: "integer"}}
+		serialised, err := SerializeV2(param, []int{1, 2, 3})
+		require.NoError(t, err, "%s %s", test.in, test.format)
+		assert.Equal(t, test.expected, serialised, "%s %s", test.in, test.format)
+
+		value, err := DeserializeV2(param, serialised)
+		require.NoError(t, err, "%s %s", test.in, test.format)
+		assert.Equal(t, []any{int64(1), int64(2), int64(3)}, value, "%s %s", test.in, test.format)
+	}
+}
+
+func TestSerializeV2(t *testing.T) {
+	// nested items use their own collectionFormat.
+	param := &v2.Parameter{Name: "grid", In: "query", Type: "array", CollectionFormat: "pipes",
+		Items: &v2.Items{Type: "array", CollectionFormat: "csv", Items: &v2.Items{Type: "string"}}}
+	serialised, err := SerializeV2(param, [][]string{{"a", "b"}, {"c"}})
+	require.NoError(t, err)
+	assert.Equal(t, "grid=a,b|c", serialised)
+	value, err := DeserializeV2(param, serialised)
+	require.NoError(t, err)
+	assert.Equal(t, []any{[]any{"a", "b"}, []any{"c"}}, value)
+
+	param = &v2.Parameter{Name: "name", In: "formData", Type: "string"}
+	serialised, err = SerializeV2(param, "rex the dog")
+	require.NoError(t, err)
+	assert.Equal(t, "name=rex%20the%20dog", serialised)
+	value, err = DeserializeV2(param, "age=3&name=rex+the+dog")
+	require.NoError(t, err)
+	assert.Equal(t, "rex the dog", value)
+
+	param = &v2.Parameter{Name: "id", In: "path", Type: "integer"}
+	value, err = DeserializeV2(param, "42")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), value)
+
+	param = &v2.Parameter{Name: "X-Ids", In: "header", Type: "array", Items: &v2.Items{Type: "integer"}}
+	value, err = DeserializeV2(param, "1, 2,3")
+	require.NoError(t, err)
+	assert.Equal(t, []any{int64(1), int64(2), int64(3)}, value)
+
+	param = &v2.Parameter{Name: "limit", In: "query", Type: "integer"}
+	_, err = DeserializeV2(param, "offset=1")
+	assert.True(t, errors.Is(err, ErrMissing))
+}
+
+func TestSerializeV2_Errors(t *testing.T) {
+	_, err := SerializeV2(nil, 1)
+	assert.EqualError(t, err, "unable to serialise parameter: no parameter has been supplied")
+
+	_, err = DeserializeV2(nil, "")
+	assert.EqualError(t, err, "unable to deserialise parameter: no parameter has been supplied")
+
+	param := &v2.Parameter{Name: "pet", In: "body"}
+	_, err = SerializeV2(param, 1)
+	assert.EqualError(t, err, "unable to serialise parameter 'pet': body parameters are not serialised")
+	_, err = DeserializeV2(param, "")
+	assert.EqualError(t, err, "unable to deserialise parameter 'pet': body parameters are not serialised")
+
+	param = &v2.Parameter{Name: "ids", In: "path", Type: "array", CollectionFormat: "multi"}
+	_, err = SerializeV2(param, []int{1})
+	assert.EqualError(t, err,
+		"unable to serialise parameter 'ids': the multi collectionFormat is for query and form data parameters")
+}
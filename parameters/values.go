@@ -0,0 +1,220 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package parameters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// normalise turns a value into the types it would be decoded as from JSON, so maps, slices and structs are
+// serialised the same way.
+func normalise(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v any
+	if err = decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// primitive returns the string form of a primitive value, nested arrays and objects are serialised as JSON.
+func primitive(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case json.Number:
+		return t.String()
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// unreserved characters are never escaped, reserved characters are not escaped for parameters that allow them.
+const (
+	unreserved = "-._~"
+	reserved   = ":/?#[]@!$&'()*+,;="
+)
+
+// escaper returns how the parts of a parameter are escaped in a location. Headers are not escaped.
+func escaper(in string, allowReserved bool) func(string) string {
+	if in == "header" {
+		return func(s string) string { return s }
+	}
+	allowReserved = allowReserved && in == "query"
+	return func(s string) string {
+		var b strings.Builder
+		for i := 0; i < len(s); i++ {
+			c := s[i]
+			switch {
+			case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', strings.IndexByte(unreserved, c) >= 0:
+				b.WriteByte(c)
+			case allowReserved && strings.IndexByte(reserved, c) >= 0:
+				b.WriteByte(c)
+			default:
+				fmt.Fprintf(&b, "%%%02X", c)
+			}
+		}
+		return b.String()
+	}
+}
+
+// unescaper returns how the parts of a parameter are unescaped in a location, parts that are not escaped correctly
+// are left as they are.
+func unescaper(in string) func(string) string {
+	return func(s string) string {
+		var unescaped string
+		var err error
+		switch in {
+		case "header":
+			return s
+		case "query":
+			unescaped, err = url.QueryUnescape(s)
+		default:
+			unescaped, err = url.PathUnescape(s)
+		}
+		if err != nil {
+			return s
+		}
+		return unescaped
+	}
+}
+
+// namedValues splits a query string (or the pairs of a cookie header) into the values of each name, which are left
+// escaped so they can be split by the delimiters of a style before they are unescaped.
+func namedValues(raw string, cookie bool) map[string][]string {
+	separators := "&"
+	if cookie {
+		separators = "&;"
+	}
+	values := make(map[string][]string)
+	for _, pair := range strings.FieldsFunc(raw, func(r rune) bool { return strings.ContainsRune(separators, r) }) {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(pair, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		values[name] = append(values[name], value)
+	}
+	return values
+}
+
+// schemaOf returns the schema of a proxy, and the types it allows (including through allOf).
+func schemaOf(proxy *base.SchemaProxy) (*base.Schema, []string) {
+	if proxy == nil {
+		return nil, nil
+	}
+	s := proxy.Schema()
+	if s == nil {
+		if s, _ = proxy.BuildSchema(); s == nil {
+			return nil, nil
+		}
+	}
+	if len(s.Type) == 0 {
+		for _, sub := range s.AllOf {
+			if _, types := schemaOf(sub); len(types) > 0 {
+				return s, types
+			}
+		}
+	}
+	return s, s.Type
+}
+
+// kind returns if a schema describes an array, an object or a primitive value.
+func kind(proxy *base.SchemaProxy) string {
+	_, types := schemaOf(proxy)
+	return kindOf(types)
+}
+
+func kindOf(types []string) string {
+	switch {
+	case slices.Contains(types, "array"):
+		return "array"
+	case slices.Contains(types, "object"):
+		return "object"
+	}
+	return "primitive"
+}
+
+// itemSchema returns the schema of the items of an array.
+func itemSchema(proxy *base.SchemaProxy) *base.SchemaProxy {
+	if s, _ := schemaOf(proxy); s != nil && s.Items != nil && s.Items.IsA() {
+		return s.Items.A
+	}
+	return nil
+}
+
+// propertySchema returns the schema of a property of an object.
+func propertySchema(proxy *base.SchemaProxy, name string) *base.SchemaProxy {
+	s, _ := schemaOf(proxy)
+	if s == nil {
+		return nil
+	}
+	if s.Properties != nil {
+		if property := s.Properties.GetOrZero(name); property != nil {
+			return property
+		}
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() {
+		return s.AdditionalProperties.A
+	}
+	return nil
+}
+
+// typed returns a primitive value as the type its schema expects.
+func typed(value string, proxy *base.SchemaProxy) any {
+	_, types := schemaOf(proxy)
+	return typedAs(value, types)
+}
+
+// typedAs returns a primitive value as the first of the types it can be, or a string. Without types, numbers and
+// booleans are recognised.
+func typedAs(value string, types []string) any {
+	if slices.Contains(types, "string") {
+		return value
+	}
+	if len(types) == 0 {
+		types = []string{"integer", "number", "boolean"}
+	}
+	for _, t := range types {
+		switch t {
+		case "integer":
+			if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return i
+			}
+		case "number":
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				return f
+			}
+		case "boolean":
+			if value == "true" || value == "false" {
+				return value == "true"
+			}
+		case "null":
+			if value == "" || value == "null" {
+				return nil
+			}
+		}
+	}
+	return value
+}
@@ -42,6 +42,9 @@ const (
 	UnknownCase
 )
 
+// Arazzo is used by all Arazzo workflow docs, declared separately so the Case values above are not shifted.
+const Arazzo = "arazzo"
+
 // FindNodes will find a node based on JSONPath, it accepts raw yaml/json as input.
 func FindNodes(yamlData []byte, jsonPath string) ([]*yaml.Node, error) {
 	jsonPath = FixContext(jsonPath)
@@ -681,7 +684,7 @@ func ConvertYAMLtoJSON(yamlData []byte) ([]byte, error) {
 
 // IsHttpVerb will check if an operation is valid or not.
 func IsHttpVerb(verb string) bool {
-	verbs := []string{"get", "post", "put", "patch", "delete", "options", "trace", "head"}
+	verbs := []string{"get", "post", "put", "patch", "delete", "options", "trace", "head", "query"}
 	for _, v := range verbs {
 		if verb == v {
 			return true
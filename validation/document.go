@@ -0,0 +1,112 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// draft04URI is the identifier of the JSON Schema draft-04 meta-schema, referenced by the Swagger meta-schema.
+const draft04URI = "http://json-schema.org/draft-04/schema"
+
+// maxFollowedReferences limits how many references are followed from a single value.
+const maxFollowedReferences = 32
+
+// ValidateDocument checks a specification against the meta-schema for its version, which is the APISchema of the
+// SpecInfo. Every problem found is returned as a ValidationError, and nil is returned if the document is valid.
+//
+// If a rolodex is supplied (like the one created when building a model), references to other files are followed and
+// the values they point at are validated where they are referenced, so every file of a multi-file document is
+// checked. Without a rolodex only the root document is validated. Nothing is loaded remotely.
+//
+// ErrNoMetaSchema is returned for documents without a meta-schema, like Arazzo documents, or OpenAPI 3.2 documents
+// (there are meta-schemas for OpenAPI 3.0 and 3.1 only).
+func ValidateDocument(info *datamodel.SpecInfo, rolodex *index.Rolodex) ([]*ValidationError, error) {
+	if info == nil || info.RootNode == nil {
+		return nil, fmt.Errorf("unable to validate document: no specification has been loaded")
+	}
+	if info.APISchema == "" {
+		return nil, fmt.Errorf("unable to validate %s document: %w", info.SpecType, ErrNoMetaSchema)
+	}
+	if !hasMetaSchema(info) {
+		return nil, fmt.Errorf("unable to validate %s %s document: %w", info.SpecType, info.Version, ErrNoMetaSchema)
+	}
+
+	e := newEvaluator()
+	metaSchema04, err := parseSchema(datamodel.JSONSchemaDraft04Data)
+	if err != nil {
+		return nil, err
+	}
+	e.addResource(metaSchema04, draft04URI, draft04)
+	schema, err := parseSchema(info.APISchema)
+	if err != nil {
+		return nil, err
+	}
+	meta := e.addResource(schema, "urn:libopenapi:meta-schema", draft2020)
+
+	in := &instance{node: unwrap(info.RootNode)}
+	if rolodex != nil && rolodex.GetRootIndex() != nil {
+		e.root = rolodex.GetRootIndex()
+		in.idx = e.root
+		e.follow = e.followReferences
+	}
+	return e.evaluate(meta.root, &scope{res: meta}, in, "").errs, nil
+}
+
+// hasMetaSchema returns false for OpenAPI versions that are not covered by the meta-schemas, SpecInfo gives them the
+// OpenAPI 3.0 meta-schema.
+func hasMetaSchema(info *datamodel.SpecInfo) bool {
+	if info.SpecType != utils.OpenApi3 {
+		return true
+	}
+	return strings.HasPrefix(info.Version, "3.0") || strings.HasPrefix(info.Version, "3.1")
+}
+
+func parseSchema(data string) (*yaml.Node, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(data), &node); err != nil {
+		return nil, fmt.Errorf("unable to parse meta-schema: %w", err)
+	}
+	return &node, nil
+}
+
+// followReferences returns the value a reference points at in another file, values in the root document are
+// validated where they are.
+func (e *evaluator) followReferences(in *instance) *instance {
+	for range maxFollowedReferences {
+		n := in.node
+		if n == nil || n.Kind != yaml.MappingNode {
+			return in
+		}
+		ref := mappingValue(n, "$ref")
+		if ref == nil || ref.Kind != yaml.ScalarNode {
+			return in
+		}
+		idx := in.idx
+		if idx == nil {
+			idx = e.root
+		}
+		if idx == e.root && strings.HasPrefix(ref.Value, "#") {
+			return in
+		}
+		found, foundIdx := idx.SearchIndexForReference(ref.Value)
+		if found == nil || found.Node == nil {
+			return in
+		}
+		if foundIdx == nil {
+			foundIdx = found.Index
+		}
+		if foundIdx == nil || foundIdx == e.root {
+			return in
+		}
+		in = &instance{node: unwrap(found.Node), key: found.KeyNode, pointer: in.pointer, idx: foundIdx}
+	}
+	return in
+}
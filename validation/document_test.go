@@ -0,0 +1,179 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func specInfo(t *testing.T, spec string) *datamodel.SpecInfo {
+	t.Helper()
+	info, err := datamodel.ExtractSpecInfo([]byte(spec))
+	require.NoError(t, err)
+	return info
+}
+
+func TestValidateDocument(t *testing.T) {
+	for _, file := range []string{"petstorev2.json", "petstorev3.json", "stripe-old.yaml"} {
+		spec, err := os.ReadFile("../test_specs/" + file)
+		require.NoError(t, err)
+		errs, err := ValidateDocument(specInfo(t, string(spec)), nil)
+		require.NoError(t, err)
+		assert.Empty(t, errs, file)
+	}
+}
+
+func TestValidateDocument_Invalid(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          content: {}
+    fetch:
+      description: not an operation`
+
+	errs, err := ValidateDocument(specInfo(t, spec), nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+
+	assert.Equal(t, "/info", errs[0].Pointer)
+	assert.Equal(t, "missing required property 'version'", errs[0].Message)
+	assert.Equal(t, 2, errs[0].Line)
+	assert.Equal(t, "/info (line 2, column 1): missing required property 'version'", errs[0].Error())
+
+	assert.Equal(t, "/paths/~1pets/get/responses/200", errs[1].Pointer)
+	assert.Equal(t, "required", errs[1].Keyword)
+	assert.Equal(t, 8, errs[1].Line)
+
+	assert.Equal(t, "/paths/~1pets/fetch", errs[2].Pointer)
+	assert.Equal(t, "property 'fetch' is not allowed", errs[2].Message)
+	assert.Equal(t, 10, errs[2].Line)
+	assert.Empty(t, errs[2].Location)
+}
+
+func TestValidateDocument_Swagger(t *testing.T) {
+	spec := `swagger: "2.0"
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          type: integer
+          maximum: 10
+          exclusiveMaximum: 1
+      responses:
+        "200":
+          description: ok`
+
+	// the Swagger meta-schema refers to the draft-04 meta-schema, which is embedded.
+	errs, err := ValidateDocument(specInfo(t, spec), nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, errs)
+	assert.Equal(t, "/paths/~1pets/get/parameters/0", errs[0].Pointer)
+}
+
+func TestValidateDocument_Rolodex(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "responses.yaml"), []byte(`Ok:
+  description: ok
+Broken:
+  $ref: '#/Missing'
+Missing:
+  content: {}`), 0o600))
+
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          $ref: 'responses.yaml#/Ok'
+        "404":
+          $ref: 'responses.yaml#/Broken'`
+
+	info := specInfo(t, spec)
+	doc, err := v3.CreateDocumentFromConfig(info, &datamodel.DocumentConfiguration{
+		BasePath:            dir,
+		AllowFileReferences: true,
+	})
+	require.NoError(t, err)
+
+	// without the rolodex, references are valid as they are.
+	errs, err := ValidateDocument(info, nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	// with it, the responses in the other file are validated.
+	errs, err = ValidateDocument(info, doc.Rolodex)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "/paths/~1pets/get/responses/404", errs[0].Pointer)
+	assert.Equal(t, "missing required property 'description'", errs[0].Message)
+	assert.Equal(t, filepath.Join(dir, "responses.yaml"), errs[0].Location)
+	assert.Equal(t, 6, errs[0].Line)
+}
+
+func TestValidateDocument_Errors(t *testing.T) {
+	_, err := ValidateDocument(nil, nil)
+	assert.EqualError(t, err, "unable to validate document: no specification has been loaded")
+
+	spec, err := os.ReadFile("../test_specs/petstore.arazzo.yaml")
+	require.NoError(t, err)
+	_, err = ValidateDocument(specInfo(t, string(spec)), nil)
+	assert.ErrorIs(t, err, ErrNoMetaSchema)
+}
+
+func TestValidateDocument_OpenAPI32(t *testing.T) {
+	// a valid OpenAPI 3.2 document, using features that are not in the OpenAPI 3.0 meta-schema.
+	spec := `openapi: 3.2.0
+$self: https://pb33f.io/openapi.yaml
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    query:
+      responses:
+        "200":
+          description: ok
+    additionalOperations:
+      LINK:
+        responses:
+          "200":
+            description: ok
+components:
+  securitySchemes:
+    oauth:
+      type: oauth2
+      deprecated: true
+      oauth2MetadataUrl: https://pb33f.io/.well-known/oauth-authorization-server
+      flows:
+        deviceAuthorization:
+          deviceAuthorizationUrl: https://pb33f.io/device
+          tokenUrl: https://pb33f.io/token
+          scopes: {}`
+
+	errs, err := ValidateDocument(specInfo(t, spec), nil)
+	assert.Empty(t, errs)
+	assert.ErrorIs(t, err, ErrNoMetaSchema)
+	assert.EqualError(t, err, "unable to validate openapi 3.2.0 document: no meta-schema available")
+}
@@ -0,0 +1,364 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// ExampleError is an example that does not match its schema, or that could not be loaded.
+type ExampleError struct {
+	// Pointer is the JSON pointer of the example in the document. Examples found through a reference are located
+	// where they are first referenced, components are checked first.
+	Pointer string `json:"pointer" yaml:"pointer"`
+
+	// Line and Column locate the example (or its externalValue) in the file that holds it.
+	Line   int `json:"line,omitempty" yaml:"line,omitempty"`
+	Column int `json:"column,omitempty" yaml:"column,omitempty"`
+
+	// Location is the absolute location of the file that holds the example, empty for the root document.
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+
+	// Errors are the problems with the value of the example, located in the example and in the schema.
+	Errors []*ValidationError `json:"errors,omitempty" yaml:"errors,omitempty"`
+
+	// Err is set when the example could not be checked, like an externalValue that could not be loaded.
+	Err error `json:"-" yaml:"-"`
+}
+
+// Error returns a string representation of the ExampleError, so it can be used as an error.
+func (e *ExampleError) Error() string {
+	at := e.Pointer
+	if e.Line > 0 {
+		at = fmt.Sprintf("%s (line %d, column %d)", e.Pointer, e.Line, e.Column)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", at, e.Err)
+	}
+	problems := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		problems[i] = err.Error()
+	}
+	return fmt.Sprintf("%s: example does not match its schema: %s", at, strings.Join(problems, "; "))
+}
+
+// ValidateExamples checks every example in a document against the schema that governs it: the examples of media
+// types, parameters and headers, and the example and examples of schemas. Examples in components are checked where
+// they are referenced, as they have no schema of their own. An externalValue is loaded through the rolodex of the
+// document, relative to the file that holds the example.
+//
+// Every example that does not match its schema is returned as an ExampleError, and nil is returned if they all
+// match. The options decide the semantics of schemas, like they do for ValidateSchema.
+func ValidateExamples(doc *v3.Document, options *SchemaOptions) ([]*ExampleError, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("unable to validate examples: no document has been supplied")
+	}
+	w := &exampleWalker{
+		options:  options,
+		rolodex:  doc.Rolodex,
+		schemas:  make(map[*yaml.Node]bool),
+		examples: make(map[[2]*yaml.Node]bool),
+	}
+	if w.rolodex == nil && doc.Index != nil {
+		w.rolodex = doc.Index.GetRolodex()
+	}
+	if c := doc.Components; c != nil {
+		for name, schema := range c.Schemas.FromOldest() {
+			w.schema("/components/schemas/"+escape(name), schema)
+		}
+		for name, p := range c.Parameters.FromOldest() {
+			w.parameter("/components/parameters/"+escape(name), p)
+		}
+		for name, h := range c.Headers.FromOldest() {
+			w.header("/components/headers/"+escape(name), h)
+		}
+		for name, body := range c.RequestBodies.FromOldest() {
+			w.content("/components/requestBodies/"+escape(name)+"/content", body.Content)
+		}
+		for name, r := range c.Responses.FromOldest() {
+			w.response("/components/responses/"+escape(name), r)
+		}
+		for name, mt := range c.MediaTypes.FromOldest() {
+			w.mediaType("/components/mediaTypes/"+escape(name), mt)
+		}
+		for name, cb := range c.Callbacks.FromOldest() {
+			w.callback("/components/callbacks/"+escape(name), cb)
+		}
+		for name, item := range c.PathItems.FromOldest() {
+			w.pathItem("/components/pathItems/"+escape(name), item)
+		}
+	}
+	if doc.Paths != nil {
+		for path, item := range doc.Paths.PathItems.FromOldest() {
+			w.pathItem("/paths/"+escape(path), item)
+		}
+	}
+	for name, item := range doc.Webhooks.FromOldest() {
+		w.pathItem("/webhooks/"+escape(name), item)
+	}
+	return w.errs, nil
+}
+
+// exampleWalker pairs the examples of a document with their schemas.
+type exampleWalker struct {
+	options  *SchemaOptions
+	rolodex  *index.Rolodex
+	schemas  map[*yaml.Node]bool    // schemas that have been walked.
+	examples map[[2]*yaml.Node]bool // examples that have been checked, with the schema they were checked against.
+	errs     []*ExampleError
+}
+
+func (w *exampleWalker) pathItem(pointer string, item *v3.PathItem) {
+	if item == nil {
+		return
+	}
+	for i, p := range item.Parameters {
+		w.parameter(fmt.Sprintf("%s/parameters/%d", pointer, i), p)
+	}
+	for method, op := range item.GetOperations().FromOldest() {
+		at := pointer + "/" + escape(method)
+		for i, p := range op.Parameters {
+			w.parameter(fmt.Sprintf("%s/parameters/%d", at, i), p)
+		}
+		if op.RequestBody != nil {
+			w.content(at+"/requestBody/content", op.RequestBody.Content)
+		}
+		if op.Responses != nil {
+			for code, r := range op.Responses.Codes.FromOldest() {
+				w.response(at+"/responses/"+escape(code), r)
+			}
+			w.response(at+"/responses/default", op.Responses.Default)
+		}
+		for name, cb := range op.Callbacks.FromOldest() {
+			w.callback(at+"/callbacks/"+escape(name), cb)
+		}
+	}
+}
+
+func (w *exampleWalker) callback(pointer string, cb *v3.Callback) {
+	if cb == nil {
+		return
+	}
+	for expression, item := range cb.Expression.FromOldest() {
+		w.pathItem(pointer+"/"+escape(expression), item)
+	}
+}
+
+func (w *exampleWalker) response(pointer string, r *v3.Response) {
+	if r == nil {
+		return
+	}
+	for name, h := range r.Headers.FromOldest() {
+		w.header(pointer+"/headers/"+escape(name), h)
+	}
+	w.content(pointer+"/content", r.Content)
+}
+
+func (w *exampleWalker) parameter(pointer string, p *v3.Parameter) {
+	if p == nil {
+		return
+	}
+	w.examplesOf(pointer, p.Schema, p.Example, p.Examples)
+	w.schema(pointer+"/schema", p.Schema)
+	w.content(pointer+"/content", p.Content)
+}
+
+func (w *exampleWalker) header(pointer string, h *v3.Header) {
+	if h == nil {
+		return
+	}
+	w.examplesOf(pointer, h.Schema, h.Example, h.Examples)
+	w.schema(pointer+"/schema", h.Schema)
+	w.content(pointer+"/content", h.Content)
+}
+
+func (w *exampleWalker) content(pointer string, content *orderedmap.Map[string, *v3.MediaType]) {
+	for name, mt := range content.FromOldest() {
+		w.mediaType(pointer+"/"+escape(name), mt)
+	}
+}
+
+func (w *exampleWalker) mediaType(pointer string, mt *v3.MediaType) {
+	if mt == nil {
+		return
+	}
+	w.examplesOf(pointer, mt.Schema, mt.Example, mt.Examples)
+	w.schema(pointer+"/schema", mt.Schema)
+}
+
+// examplesOf checks the example and the examples of a media type, parameter or header.
+func (w *exampleWalker) examplesOf(pointer string, schema *base.SchemaProxy, example *yaml.Node,
+	examples *orderedmap.Map[string, *base.Example],
+) {
+	if schema == nil {
+		return
+	}
+	w.check(pointer+"/example", example, schema)
+	for name, ex := range examples.FromOldest() {
+		if ex == nil {
+			continue
+		}
+		at := pointer + "/examples/" + escape(name)
+		if ex.Value != nil {
+			w.check(at+"/value", ex.Value, schema)
+		} else if ex.ExternalValue != "" {
+			w.external(at+"/externalValue", ex, schema)
+		}
+	}
+}
+
+// schema walks a schema and its subschemas, checking their examples. Schemas are only walked once.
+func (w *exampleWalker) schema(pointer string, proxy *base.SchemaProxy) {
+	if proxy == nil {
+		return
+	}
+	s := proxy.Schema()
+	if s == nil {
+		return
+	}
+	if low := s.GoLow(); low != nil && low.RootNode != nil {
+		if w.schemas[low.RootNode] {
+			return
+		}
+		w.schemas[low.RootNode] = true
+	}
+	w.check(pointer+"/example", s.Example, proxy)
+	for i, example := range s.Examples {
+		w.check(fmt.Sprintf("%s/examples/%d", pointer, i), example, proxy)
+	}
+
+	for _, sub := range []struct {
+		keyword string
+		schemas []*base.SchemaProxy
+	}{{"allOf", s.AllOf}, {"anyOf", s.AnyOf}, {"oneOf", s.OneOf}, {"prefixItems", s.PrefixItems}} {
+		for i, schema := range sub.schemas {
+			w.schema(fmt.Sprintf("%s/%s/%d", pointer, sub.keyword, i), schema)
+		}
+	}
+	for _, sub := range []struct {
+		keyword string
+		schemas *orderedmap.Map[string, *base.SchemaProxy]
+	}{
+		{"properties", s.Properties}, {"patternProperties", s.PatternProperties},
+		{"dependentSchemas", s.DependentSchemas}, {"$defs", s.Defs},
+	} {
+		for name, schema := range sub.schemas.FromOldest() {
+			w.schema(pointer+"/"+sub.keyword+"/"+escape(name), schema)
+		}
+	}
+	for _, sub := range []struct {
+		keyword string
+		schema  *base.SchemaProxy
+	}{
+		{"not", s.Not}, {"if", s.If}, {"then", s.Then}, {"else", s.Else}, {"contains", s.Contains},
+		{"propertyNames", s.PropertyNames}, {"unevaluatedItems", s.UnevaluatedItems},
+	} {
+		w.schema(pointer+"/"+sub.keyword, sub.schema)
+	}
+	for _, sub := range []struct {
+		keyword string
+		schema  *base.DynamicValue[*base.SchemaProxy, bool]
+	}{
+		{"items", s.Items}, {"additionalProperties", s.AdditionalProperties},
+		{"unevaluatedProperties", s.UnevaluatedProperties},
+	} {
+		if sub.schema != nil && sub.schema.IsA() {
+			w.schema(pointer+"/"+sub.keyword, sub.schema.A)
+		}
+	}
+}
+
+// check validates the value of an example against its schema.
+func (w *exampleWalker) check(pointer string, example *yaml.Node, schema *base.SchemaProxy) {
+	if example == nil || schema == nil {
+		return
+	}
+	checked := [2]*yaml.Node{example, nil}
+	if low := schema.GoLow(); low != nil {
+		checked[1] = low.GetValueNode()
+	}
+	if w.examples[checked] {
+		return
+	}
+	w.examples[checked] = true
+
+	ee := &ExampleError{Pointer: pointer, Line: example.Line, Column: example.Column}
+	idx := w.locate(example)
+	if idx != nil && idx != w.rolodex.GetRootIndex() {
+		ee.Location = idx.GetSpecAbsolutePath()
+	}
+	w.validate(ee, &instance{node: unwrap(example), idx: idx}, schema)
+}
+
+// external loads the externalValue of an example through the rolodex, and validates it against its schema.
+func (w *exampleWalker) external(pointer string, example *base.Example, schema *base.SchemaProxy) {
+	ee := &ExampleError{Pointer: pointer}
+	location := example.ExternalValue
+	if low := example.GoLow(); low != nil && low.ExternalValue.ValueNode != nil {
+		at := low.ExternalValue.ValueNode
+		ee.Line, ee.Column = at.Line, at.Column
+		if idx := w.locate(at); idx != nil {
+			if idx != w.rolodex.GetRootIndex() {
+				ee.Location = idx.GetSpecAbsolutePath()
+			}
+			if !strings.HasPrefix(location, "http") && !filepath.IsAbs(location) {
+				location = filepath.Join(filepath.Dir(idx.GetSpecAbsolutePath()), location)
+			}
+		}
+	}
+	if w.rolodex == nil {
+		ee.Err = fmt.Errorf("unable to load external value '%s': the document has no rolodex", example.ExternalValue)
+		w.errs = append(w.errs, ee)
+		return
+	}
+	file, err := w.rolodex.Open(location)
+	if err != nil {
+		ee.Err = fmt.Errorf("unable to load external value '%s': %w", example.ExternalValue, err)
+		w.errs = append(w.errs, ee)
+		return
+	}
+	value, err := file.GetContentAsYAMLNode()
+	if err != nil || value == nil {
+		// not JSON or YAML, so the value is the content of the file.
+		value = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: file.GetContent()}
+	}
+	w.validate(ee, &instance{node: unwrap(value), idx: file.GetIndex()}, schema)
+	for _, ve := range ee.Errors {
+		if ve.Location == "" {
+			ve.Location = file.GetFullPath()
+		}
+	}
+}
+
+// validate evaluates an example against its schema, and records the errors.
+func (w *exampleWalker) validate(ee *ExampleError, in *instance, schema *base.SchemaProxy) {
+	e, s, res, err := schemaEvaluator(schema, w.options)
+	if err != nil {
+		ee.Err = err
+		w.errs = append(w.errs, ee)
+		return
+	}
+	if w.rolodex != nil {
+		e.root = w.rolodex.GetRootIndex()
+	}
+	if ee.Errors = e.evaluate(s, &scope{res: res}, in, "").errs; len(ee.Errors) > 0 {
+		w.errs = append(w.errs, ee)
+	}
+}
+
+// locate finds the index of the file that holds a node.
+func (w *exampleWalker) locate(n *yaml.Node) *index.SpecIndex {
+	if w.rolodex == nil {
+		return nil
+	}
+	return indexOf(w.rolodex, n)
+}
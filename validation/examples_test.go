@@ -0,0 +1,171 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildDocument(t *testing.T, spec string, config *datamodel.DocumentConfiguration) *v3high.Document {
+	t.Helper()
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+	doc, err := v3.CreateDocumentFromConfig(specInfo(t, spec), config)
+	require.NoError(t, err)
+	return v3high.NewDocument(doc)
+}
+
+func TestValidateExamples(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+          example: ten
+      responses:
+        "200":
+          description: ok
+          headers:
+            X-Rate:
+              schema:
+                type: integer
+              examples:
+                fine:
+                  value: 10
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+              examples:
+                rex:
+                  $ref: '#/components/examples/Rex'
+components:
+  examples:
+    Rex:
+      value:
+        name: 7
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+          example: rex
+        age:
+          type: integer
+          examples: [1, two]`
+
+	errs, err := ValidateExamples(buildDocument(t, spec, nil), nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+
+	assert.Equal(t, "/components/schemas/Pet/properties/age/examples/1", errs[0].Pointer)
+	assert.Equal(t, 46, errs[0].Line)
+	assert.Equal(t, "type", errs[0].Errors[0].Keyword)
+	assert.Equal(t, 45, errs[0].Errors[0].SchemaLine)
+
+	assert.Equal(t, "/paths/~1pets/get/parameters/0/example", errs[1].Pointer)
+	assert.Equal(t, 13, errs[1].Line)
+	assert.Equal(t, "/paths/~1pets/get/parameters/0/example (line 13, column 20): example does not match its "+
+		"schema: / (line 13, column 20): expected integer, found string", errs[1].Error())
+
+	// the example is found through a reference, and checked against the referenced schema.
+	assert.Equal(t, "/paths/~1pets/get/responses/200/content/application~1json/examples/rex/value", errs[2].Pointer)
+	require.Len(t, errs[2].Errors, 1)
+	assert.Equal(t, "/name", errs[2].Errors[0].Pointer)
+	assert.Equal(t, 35, errs[2].Errors[0].Line)
+	assert.Equal(t, 42, errs[2].Errors[0].SchemaLine)
+}
+
+func TestValidateExamples_OpenAPI30(t *testing.T) {
+	spec := `openapi: 3.0.3
+info:
+  title: pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Tag:
+      type: string
+      nullable: true
+      example: null`
+
+	errs, err := ValidateExamples(buildDocument(t, spec, nil), nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+}
+
+func TestValidateExamples_ExternalValue(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "examples"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "examples", "pet.json"), []byte(`{
+  "name": 7
+}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pets.yaml"), []byte(`get:
+  responses:
+    "200":
+      description: ok
+      content:
+        application/json:
+          schema:
+            required: [name]
+            properties:
+              name:
+                type: string
+          examples:
+            rex:
+              externalValue: examples/pet.json
+            missing:
+              externalValue: examples/missing.json`), 0o600))
+
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    $ref: 'pets.yaml'`
+
+	doc := buildDocument(t, spec, &datamodel.DocumentConfiguration{BasePath: dir, AllowFileReferences: true})
+	errs, err := ValidateExamples(doc, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+
+	// the example is loaded relative to the file that holds it.
+	pointer := "/paths/~1pets/get/responses/200/content/application~1json/examples/"
+	assert.Equal(t, pointer+"rex/externalValue", errs[0].Pointer)
+	assert.Equal(t, filepath.Join(dir, "pets.yaml"), errs[0].Location)
+	assert.Equal(t, 14, errs[0].Line)
+	require.Len(t, errs[0].Errors, 1)
+	assert.Equal(t, "/name", errs[0].Errors[0].Pointer)
+	assert.Equal(t, filepath.Join(dir, "examples", "pet.json"), errs[0].Errors[0].Location)
+	assert.Equal(t, 2, errs[0].Errors[0].Line)
+	assert.Equal(t, filepath.Join(dir, "pets.yaml"), errs[0].Errors[0].SchemaLocation)
+	assert.Equal(t, 11, errs[0].Errors[0].SchemaLine)
+
+	assert.Equal(t, pointer+"missing/externalValue", errs[1].Pointer)
+	assert.Error(t, errs[1].Err)
+}
+
+func TestValidateExamples_Errors(t *testing.T) {
+	_, err := ValidateExamples(nil, nil)
+	assert.EqualError(t, err, "unable to validate examples: no document has been supplied")
+}
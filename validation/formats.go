@@ -0,0 +1,100 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"encoding/base64"
+	"math"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	hostnamePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*\.?$`)
+)
+
+// checkFormat checks a value against a format, returning what is wrong with it, or nothing if the value is valid, the
+// format does not apply to the type of the value, or the format is not known.
+func checkFormat(format string, n *yaml.Node) string {
+	switch format {
+	case "int32", "int64":
+		if typeOf(n) != "integer" {
+			return ""
+		}
+		bits := 32
+		if format == "int64" {
+			bits = 64
+		}
+		if _, err := strconv.ParseInt(n.Value, 0, bits); err != nil {
+			return "is not a valid " + format
+		}
+		return ""
+	case "float":
+		if typeOf(n) != "number" && typeOf(n) != "integer" {
+			return ""
+		}
+		if f, err := strconv.ParseFloat(n.Value, 64); err == nil && math.Abs(f) > math.MaxFloat32 {
+			return "is not a valid float"
+		}
+		return ""
+	}
+	if typeOf(n) != "string" {
+		return ""
+	}
+	v := n.Value
+	var valid bool
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, v)
+		valid = err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, v)
+		valid = err == nil
+	case "time":
+		_, err := time.Parse("15:04:05Z07:00", v)
+		if err != nil {
+			_, err = time.Parse("15:04:05.999999999Z07:00", v)
+		}
+		valid = err == nil
+	case "email":
+		addr, err := mail.ParseAddress(v)
+		valid = err == nil && addr.Address == v
+	case "uuid":
+		valid = uuidPattern.MatchString(v)
+	case "uri":
+		u, err := url.Parse(v)
+		valid = err == nil && u.Scheme != ""
+	case "uri-reference":
+		_, err := url.Parse(v)
+		valid = err == nil
+	case "ipv4":
+		ip := net.ParseIP(v)
+		valid = ip != nil && ip.To4() != nil && !strings.Contains(v, ":")
+	case "ipv6":
+		ip := net.ParseIP(v)
+		valid = ip != nil && strings.Contains(v, ":")
+	case "hostname":
+		valid = len(v) <= 253 && hostnamePattern.MatchString(v)
+	case "byte":
+		_, err := base64.StdEncoding.DecodeString(v)
+		valid = err == nil
+	case "regex":
+		_, err := regexp.Compile(v)
+		valid = err == nil
+	default:
+		return ""
+	}
+	if valid {
+		return ""
+	}
+	return "is not a valid " + format
+}
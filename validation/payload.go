@@ -0,0 +1,158 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaOptions configures how values are validated against a schema.
+type SchemaOptions struct {
+	// Version is the OpenAPI version the schema belongs to, like '3.0.3' or '3.1.0'. It decides whether 3.0 semantics
+	// (nullable, boolean exclusive bounds) or 3.1 semantics (JSON Schema 2020-12) apply. If empty, the version of the
+	// document the schema was built from is used, or 3.1 if there is no document.
+	Version string
+
+	// IgnoreFormats treats 'format' as an annotation, so values are not checked against it.
+	IgnoreFormats bool
+
+	// Direction is the way the value travels. Required properties that are readOnly are not required in requests,
+	// and required properties that are writeOnly are not required in responses.
+	Direction Direction
+}
+
+// Direction is the way a value travels between a client and a server.
+type Direction int
+
+const (
+	// DirectionAny requires every required property, readOnly and writeOnly or not.
+	DirectionAny Direction = iota
+
+	// DirectionRequest is a value sent to the server, like a request body or a parameter.
+	DirectionRequest
+
+	// DirectionResponse is a value returned by the server, like a response body or header.
+	DirectionResponse
+)
+
+// ValidateSchema checks a value against a schema. The value can be anything that can be encoded as JSON, like the
+// result of decoding JSON (numbers decoded as json.Number are kept exact). Every problem found is returned as a
+// ValidationError, and nil is returned if the value is valid.
+func ValidateSchema(schema *base.SchemaProxy, value any, options *SchemaOptions) ([]*ValidationError, error) {
+	node, err := valueNode(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate value: %w", err)
+	}
+	return ValidateSchemaNode(schema, node, options)
+}
+
+// valueNode turns a value into a node through JSON, so it is typed the way it would be decoded from JSON.
+func valueNode(value any) (*yaml.Node, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var node yaml.Node
+	if err = yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	return unwrap(&node), nil
+}
+
+// ValidateSchemaNode checks a YAML node against a schema, errors are located at the lines of the node.
+//
+// Schemas built from a document are evaluated from the nodes they were read from, references are resolved through
+// the index of the document (and its rolodex), and errors are located in the schema as well as in the value.
+// Schemas created in code are rendered first, with their references inlined.
+func ValidateSchemaNode(schema *base.SchemaProxy, node *yaml.Node, options *SchemaOptions) ([]*ValidationError, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("unable to validate value: no schema has been supplied")
+	}
+	if node == nil {
+		return nil, fmt.Errorf("unable to validate value: no value has been supplied")
+	}
+	e, s, res, err := schemaEvaluator(schema, options)
+	if err != nil {
+		return nil, err
+	}
+	return e.evaluate(s, &scope{res: res}, &instance{node: unwrap(node)}, "").errs, nil
+}
+
+// schemaEvaluator creates an evaluator for the OpenAPI keywords, and returns the node and resource of the schema.
+func schemaEvaluator(schema *base.SchemaProxy, options *SchemaOptions) (*evaluator, *yaml.Node, *resource, error) {
+	if options == nil {
+		options = new(SchemaOptions)
+	}
+	e := newEvaluator()
+	e.openapi = true
+	e.formats = !options.IgnoreFormats
+	e.direction = options.Direction
+
+	var idx *index.SpecIndex
+	var s *yaml.Node
+	if low := schema.GoLow(); low != nil && low.GetValueNode() != nil {
+		idx, s = low.GetIndex(), low.GetValueNode()
+	}
+	if idx != nil {
+		e.root = idx
+		if rolodex := idx.GetRolodex(); rolodex != nil && rolodex.GetRootIndex() != nil {
+			e.root = rolodex.GetRootIndex()
+			// schemas read from another file can be built with the index of the file that referenced them.
+			if holder := indexOf(rolodex, s); holder != nil {
+				idx = holder
+			}
+		}
+		return e, s, e.indexResource(idx, schemaVersion(options.Version, e.root)), nil
+	}
+	if s == nil {
+		rendered, err := schema.MarshalYAMLInline()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to validate value: %w", err)
+		}
+		if s, _ = rendered.(*yaml.Node); s == nil {
+			s = new(yaml.Node)
+			if err = s.Encode(rendered); err != nil {
+				return nil, nil, nil, fmt.Errorf("unable to validate value: %w", err)
+			}
+		}
+	}
+	return e, s, e.addResource(s, "urn:libopenapi:schema", schemaVersion(options.Version, nil)), nil
+}
+
+// indexOf finds the index of the file that holds a node.
+func indexOf(rolodex *index.Rolodex, n *yaml.Node) *index.SpecIndex {
+	if root := rolodex.GetRootIndex(); root != nil && root.FindNodeOrigin(n) != nil {
+		return root
+	}
+	for _, idx := range rolodex.GetIndexes() {
+		if idx.FindNodeOrigin(n) != nil {
+			return idx
+		}
+	}
+	return nil
+}
+
+// schemaVersion returns the dialect of schemas for an OpenAPI version, or the version of the document of an index.
+func schemaVersion(version string, idx *index.SpecIndex) dialect {
+	switch {
+	case version != "":
+		if strings.HasPrefix(version, "2") || strings.HasPrefix(version, "3.0") {
+			return draft04
+		}
+		return draft2020
+	case idx != nil && idx.GetConfig() != nil && idx.GetConfig().SpecInfo != nil:
+		switch idx.GetConfig().SpecInfo.SpecFormat {
+		case datamodel.OAS2, datamodel.OAS3:
+			return draft04
+		}
+	}
+	return draft2020
+}
@@ -0,0 +1,289 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func buildSchemas(t *testing.T, spec string, config *datamodel.DocumentConfiguration) map[string]*base.SchemaProxy {
+	t.Helper()
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+	doc, err := v3.CreateDocumentFromConfig(specInfo(t, spec), config)
+	require.NoError(t, err)
+	schemas := make(map[string]*base.SchemaProxy)
+	for name, schema := range v3high.NewDocument(doc).Components.Schemas.FromOldest() {
+		schemas[name] = schema
+	}
+	return schemas
+}
+
+func validateYAML(t *testing.T, schema *base.SchemaProxy, value string, options *SchemaOptions) []*ValidationError {
+	t.Helper()
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(value), &node))
+	errs, err := ValidateSchemaNode(schema, &node, options)
+	require.NoError(t, err)
+	return errs
+}
+
+func TestValidateSchema_OpenAPI30(t *testing.T) {
+	spec := `openapi: 3.0.3
+info:
+  title: pets
+  version: 1.0.0
+components:
+  schemas:
+    Count:
+      type: integer
+      nullable: true
+      minimum: 0
+      exclusiveMinimum: true
+      enum: [1, 2, 3]`
+
+	count := buildSchemas(t, spec, nil)["Count"]
+	assert.Empty(t, validateYAML(t, count, "null", nil))
+	assert.Empty(t, validateYAML(t, count, "1", nil))
+
+	errs := validateYAML(t, count, "0", nil)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "enum", errs[0].Keyword)
+	assert.Equal(t, 12, errs[0].SchemaLine)
+	assert.Equal(t, "minimum", errs[1].Keyword)
+	assert.Equal(t, "0 must be greater than 0", errs[1].Message)
+	assert.Equal(t, 10, errs[1].SchemaLine)
+
+	// with 3.1 semantics, nullable is not a keyword.
+	errs = validateYAML(t, count, "null", &SchemaOptions{Version: "3.1.0"})
+	require.Len(t, errs, 2)
+	assert.Equal(t, "type", errs[0].Keyword)
+}
+
+func TestValidateSchema_OpenAPI31(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+components:
+  schemas:
+    Base:
+      properties:
+        name:
+          type: string
+    Pet:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+      properties:
+        tag:
+          type: [string, 'null']
+      dependentSchemas:
+        tag:
+          required: [name]
+      unevaluatedProperties: false`
+
+	pet := buildSchemas(t, spec, nil)["Pet"]
+	assert.Empty(t, validateYAML(t, pet, "{name: rex, tag: null}", nil))
+
+	errs := validateYAML(t, pet, "name: rex\nage: 3", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "unevaluatedProperties", errs[0].Keyword)
+	assert.Equal(t, "/age", errs[0].Pointer)
+	assert.Equal(t, 2, errs[0].Line)
+	assert.Equal(t, 20, errs[0].SchemaLine)
+
+	errs = validateYAML(t, pet, "tag: a", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'name'", errs[0].Message)
+	assert.Equal(t, "/dependentSchemas/tag/required", errs[0].SchemaPath)
+
+	// the base schema is found through the index, and the property it fails to evaluate is not allowed.
+	errs = validateYAML(t, pet, "name: 1", nil)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "/allOf/0/$ref/properties/name/type", errs[0].SchemaPath)
+	assert.Equal(t, 10, errs[0].SchemaLine)
+}
+
+func TestValidateSchema_Discriminator(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          hound: '#/components/schemas/Dog'
+    Cat:
+      properties:
+        kind: {type: string}
+        lives: {type: integer}
+      required: [lives]
+    Dog:
+      properties:
+        kind: {type: string}
+        bark: {type: boolean}
+      required: [bark]`
+
+	var logged bytes.Buffer
+	config := datamodel.NewDocumentConfiguration()
+	config.Logger = slog.New(slog.NewTextHandler(&logged, nil))
+	pet := buildSchemas(t, spec, config)["Pet"]
+	assert.Empty(t, validateYAML(t, pet, "{kind: Cat, lives: 9}", nil))
+	assert.Empty(t, validateYAML(t, pet, "{kind: hound, bark: true}", nil))
+
+	// only the schema named by the discriminator is used.
+	errs := validateYAML(t, pet, "{kind: Dog, lives: 9}", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'bark'", errs[0].Message)
+
+	errs = validateYAML(t, pet, "{lives: 9}", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "discriminator", errs[0].Keyword)
+	assert.Equal(t, "missing discriminator property 'kind'", errs[0].Message)
+
+	errs = validateYAML(t, pet, "{kind: Fish}", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "'Fish' does not identify a schema", errs[0].Message)
+	assert.Empty(t, logged.String())
+}
+
+func TestValidateSchema_OneOfRepeats(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      type: object
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+    Cat:
+      type: object
+      required: [lives]
+    Dog:
+      type: object
+      required: [bark]`
+
+	// the alternatives agree with the type of the schema, which is only reported once.
+	errs := validateYAML(t, buildSchemas(t, spec, nil)["Pet"], "null", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "expected object, found null", errs[0].Message)
+	assert.Equal(t, "/type", errs[0].SchemaPath)
+}
+
+func TestValidateSchema_Formats(t *testing.T) {
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+	properties.Set("id", base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}, Format: "uuid"}))
+	properties.Set("created", base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}, Format: "date-time"}))
+	properties.Set("count", base.CreateSchemaProxy(&base.Schema{Type: []string{"integer"}, Format: "int32"}))
+	schema := base.CreateSchemaProxy(&base.Schema{Type: []string{"object"}, Properties: properties})
+
+	var value any
+	decoder := json.NewDecoder(strings.NewReader(`{"id": "nope", "created": "2025-01-02T03:04:05Z", "count": 3000000000}`))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&value))
+	errs, err := ValidateSchema(schema, value, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	messages := []string{errs[0].Message, errs[1].Message}
+	assert.Contains(t, messages, "'nope' is not a valid uuid")
+	assert.Contains(t, messages, "3000000000 is not a valid int32")
+	assert.Zero(t, errs[0].SchemaLine)
+
+	errs, err = ValidateSchema(schema, value, &SchemaOptions{IgnoreFormats: true})
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+}
+
+func TestValidateSchema_Rolodex(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pet.yaml"), []byte(`type: object
+required: [name]`), 0o600))
+
+	spec := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      $ref: 'pet.yaml'`
+
+	pet := buildSchemas(t, spec, &datamodel.DocumentConfiguration{BasePath: dir, AllowFileReferences: true})["Pet"]
+	errs := validateYAML(t, pet, "{}", nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'name'", errs[0].Message)
+	assert.Equal(t, filepath.Join(dir, "pet.yaml"), errs[0].SchemaLocation)
+	assert.Equal(t, 2, errs[0].SchemaLine)
+}
+
+func TestValidateSchema_Direction(t *testing.T) {
+	schemas := buildSchemas(t, `openapi: 3.1.0
+info:
+  title: accounts
+  version: 1.0.0
+components:
+  schemas:
+    Account:
+      type: object
+      required: [id, password]
+      properties:
+        id:
+          type: integer
+          readOnly: true
+        password:
+          type: string
+          writeOnly: true`, nil)
+	account := schemas["Account"]
+
+	errs, err := ValidateSchema(account, map[string]any{}, nil)
+	require.NoError(t, err)
+	assert.Len(t, errs, 2)
+
+	errs, err = ValidateSchema(account, map[string]any{}, &SchemaOptions{Direction: DirectionRequest})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'password'", errs[0].Message)
+
+	errs, err = ValidateSchema(account, map[string]any{}, &SchemaOptions{Direction: DirectionResponse})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'id'", errs[0].Message)
+}
+
+func TestValidateSchema_Errors(t *testing.T) {
+	_, err := ValidateSchema(nil, 1, nil)
+	assert.EqualError(t, err, "unable to validate value: no schema has been supplied")
+
+	schema := base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}})
+	_, err = ValidateSchemaNode(schema, nil, nil)
+	assert.EqualError(t, err, "unable to validate value: no value has been supplied")
+
+	_, err = ValidateSchema(schema, func() {}, nil)
+	assert.ErrorContains(t, err, "unable to validate value")
+}
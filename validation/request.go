@@ -0,0 +1,653 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/parameters"
+	"gopkg.in/yaml.v3"
+)
+
+// HTTPError is a part of a request or a response that does not match the operation it was matched to.
+type HTTPError struct {
+	// Message explains what is wrong.
+	Message string `json:"message" yaml:"message"`
+
+	// In is the part of the request or response that is wrong: 'path', 'query', 'header', 'cookie', 'body' or
+	// 'security'.
+	In string `json:"in" yaml:"in"`
+
+	// Name is the name of the parameter or header that is wrong, or the media type of the body.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Pointer is the JSON pointer of the object in the document that the request or response does not match, like
+	// a parameter, a media type or the operation.
+	Pointer string `json:"pointer" yaml:"pointer"`
+
+	// Line and Column locate the object in the file that holds it.
+	Line   int `json:"line,omitempty" yaml:"line,omitempty"`
+	Column int `json:"column,omitempty" yaml:"column,omitempty"`
+
+	// Location is the absolute location of the file that holds the object, empty for the root document.
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+
+	// Errors are the problems with a value that does not match its schema.
+	Errors []*ValidationError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// Error returns a string representation of the HTTPError, so it can be used as an error.
+func (e *HTTPError) Error() string {
+	at := e.Pointer
+	if e.Line > 0 {
+		at = fmt.Sprintf("%s (line %d, column %d)", e.Pointer, e.Line, e.Column)
+	}
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("%s: %s", at, e.Message)
+	}
+	problems := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		problems[i] = err.Error()
+	}
+	return fmt.Sprintf("%s: %s: %s", at, e.Message, strings.Join(problems, "; "))
+}
+
+// ValidateRequest checks an HTTP request against the operation of a document it matches. The path of the request is
+// matched to a path template of the document, after the base path of a server (a server variable takes its default
+// value). Parameters are decoded by their style and checked against their schemas, the content type and body are
+// checked against the request body, and at least one of the security requirements must be met by the credentials
+// present (they are not verified). Required properties that are readOnly are not required in a request.
+//
+// Every problem found is returned as an HTTPError, and nil is returned if the request is valid. The body of the
+// request is read and replaced, so it can be read again. JSON, text and form bodies are validated.
+func ValidateRequest(doc *v3.Document, request *http.Request, options *SchemaOptions) ([]*HTTPError, error) {
+	if doc == nil || request == nil || request.URL == nil {
+		return nil, fmt.Errorf("unable to validate request: a document and a request are required")
+	}
+	v := newHTTPValidator(doc, options, DirectionRequest)
+	r, err := v.match(request)
+	if err != nil {
+		return []*HTTPError{err}, nil
+	}
+	v.parameters(r, request)
+	v.requestBody(r, request)
+	v.security(r, request)
+	return v.errs, nil
+}
+
+// ValidateResponse checks an HTTP response against the operation of a document that the request it answers matches.
+// The status code is matched to a response (or a range, like 2XX, or the default response), then the headers,
+// content type and body are checked against it. Required properties that are writeOnly are not required in a
+// response.
+//
+// Every problem found is returned as an HTTPError, and nil is returned if the response is valid. The body of the
+// response is read and replaced, so it can be read again.
+func ValidateResponse(doc *v3.Document, request *http.Request, response *http.Response,
+	options *SchemaOptions,
+) ([]*HTTPError, error) {
+	if doc == nil || request == nil || request.URL == nil || response == nil {
+		return nil, fmt.Errorf("unable to validate response: a document, a request and a response are required")
+	}
+	v := newHTTPValidator(doc, options, DirectionResponse)
+	r, err := v.match(request)
+	if err != nil {
+		return []*HTTPError{err}, nil
+	}
+	v.response(r, response)
+	return v.errs, nil
+}
+
+// httpValidator collects the problems with a request or response.
+type httpValidator struct {
+	doc     *v3.Document
+	options *SchemaOptions
+	rolodex *index.Rolodex
+	errs    []*HTTPError
+}
+
+// route is the operation a request matched.
+type route struct {
+	pointer   string // of the operation.
+	item      *v3.PathItem
+	operation *v3.Operation
+	params    map[string]string // path parameters, still escaped.
+}
+
+func newHTTPValidator(doc *v3.Document, options *SchemaOptions, direction Direction) *httpValidator {
+	directed := SchemaOptions{Direction: direction}
+	if options != nil {
+		directed = *options
+		directed.Direction = direction
+	}
+	v := &httpValidator{doc: doc, options: &directed, rolodex: doc.Rolodex}
+	if v.rolodex == nil && doc.Index != nil {
+		v.rolodex = doc.Index.GetRolodex()
+	}
+	return v
+}
+
+// fail records a problem, located at the node of the object in the document.
+func (v *httpValidator) fail(in, name, pointer string, n *yaml.Node, errs []*ValidationError, format string,
+	args ...any,
+) {
+	he := &HTTPError{Message: fmt.Sprintf(format, args...), In: in, Name: name, Pointer: pointer, Errors: errs}
+	if n != nil {
+		he.Line, he.Column = n.Line, n.Column
+		if v.rolodex != nil {
+			if idx := indexOf(v.rolodex, n); idx != nil && idx != v.rolodex.GetRootIndex() {
+				he.Location = idx.GetSpecAbsolutePath()
+			}
+		}
+	}
+	v.errs = append(v.errs, he)
+}
+
+// rootNode returns the node a low-level object was read from, if there is one.
+func rootNode[T interface {
+	comparable
+	GetRootNode() *yaml.Node
+}](low T) *yaml.Node {
+	var none T
+	if low == none {
+		return nil
+	}
+	return low.GetRootNode()
+}
+
+// match finds the path template and operation of a request.
+func (v *httpValidator) match(request *http.Request) (*route, *HTTPError) {
+	path := request.URL.EscapedPath()
+	var best *route
+	bestParams := -1
+	if v.doc.Paths != nil {
+		for template, item := range v.doc.Paths.PathItems.FromOldest() {
+			servers := v.doc.Servers
+			if item != nil && len(item.Servers) > 0 {
+				servers = item.Servers
+			}
+			for _, base := range basePaths(servers) {
+				rest, ok := strings.CutPrefix(path, base)
+				if !ok || (rest != "" && !strings.HasPrefix(rest, "/")) {
+					continue
+				}
+				params, ok := matchTemplate(template, rest)
+				if !ok {
+					continue
+				}
+				// concrete paths are matched before templated ones.
+				if best == nil || len(params) < bestParams {
+					best = &route{pointer: "/paths/" + escape(template), item: item, params: params}
+					bestParams = len(params)
+				}
+			}
+		}
+	}
+	if best == nil {
+		return nil, &HTTPError{In: "path", Pointer: "/paths", Message: fmt.Sprintf("no path matches '%s'", path)}
+	}
+	method := strings.ToLower(request.Method)
+	if best.item != nil {
+		best.operation = best.item.GetOperations().GetOrZero(method)
+	}
+	if best.operation == nil {
+		he := &HTTPError{In: "path", Pointer: best.pointer,
+			Message: fmt.Sprintf("method %s is not allowed for '%s'", request.Method, path)}
+		if best.item != nil {
+			if n := rootNode(best.item.GoLow()); n != nil {
+				he.Line, he.Column = n.Line, n.Column
+			}
+		}
+		return nil, he
+	}
+	best.pointer += "/" + method
+	return best, nil
+}
+
+// basePaths returns the paths of servers that a path template follows, without a trailing slash.
+func basePaths(servers []*v3.Server) []string {
+	if len(servers) == 0 {
+		return []string{""}
+	}
+	var paths []string
+	for _, server := range servers {
+		u := server.URL
+		for name, variable := range server.Variables.FromOldest() {
+			if variable != nil {
+				u = strings.ReplaceAll(u, "{"+name+"}", variable.Default)
+			}
+		}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			continue
+		}
+		paths = append(paths, strings.TrimSuffix(parsed.EscapedPath(), "/"))
+	}
+	return paths
+}
+
+var templateParameter = regexp.MustCompile(`\{([^}/]+)}`)
+
+// matchTemplate matches a path to a path template, returning the (escaped) values of its parameters.
+func matchTemplate(template, path string) (map[string]string, bool) {
+	if path == "" {
+		path = "/"
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if len(template) > 1 {
+		template = strings.TrimSuffix(template, "/")
+	}
+	var pattern strings.Builder
+	var names []string
+	last := 0
+	pattern.WriteString("^")
+	for _, m := range templateParameter.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:m[0]]))
+		pattern.WriteString("([^/]+)")
+		names = append(names, template[m[2]:m[3]])
+		last = m[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, false
+	}
+	found := re.FindStringSubmatch(path)
+	if found == nil {
+		return nil, false
+	}
+	params := make(map[string]string, len(names))
+	for i, name := range names {
+		params[name] = found[i+1]
+	}
+	return params, true
+}
+
+// parameters checks the parameters of the path item and the operation, the operation overriding the path item.
+func (v *httpValidator) parameters(r *route, request *http.Request) {
+	type located struct {
+		pointer string
+		param   *v3.Parameter
+	}
+	var params []located
+	itemPointer := strings.TrimSuffix(r.pointer, "/"+strings.ToLower(request.Method))
+	for i, p := range r.operation.Parameters {
+		params = append(params, located{fmt.Sprintf("%s/parameters/%d", r.pointer, i), p})
+	}
+	for i, p := range r.item.Parameters {
+		overridden := false
+		for _, op := range r.operation.Parameters {
+			overridden = overridden || (op != nil && p != nil && op.Name == p.Name && op.In == p.In)
+		}
+		if !overridden {
+			params = append(params, located{fmt.Sprintf("%s/parameters/%d", itemPointer, i), p})
+		}
+	}
+
+	for _, l := range params {
+		p := l.param
+		if p == nil {
+			continue
+		}
+		var raw string
+		present := true
+		switch p.In {
+		case "path":
+			raw, present = r.params[p.Name]
+		case "query":
+			raw = request.URL.RawQuery
+		case "header":
+			switch http.CanonicalHeaderKey(p.Name) {
+			case "Accept", "Content-Type", "Authorization":
+				continue // described by the content and security of the operation instead.
+			}
+			header := request.Header.Values(p.Name)
+			raw, present = strings.Join(header, ","), len(header) > 0
+		case "cookie":
+			raw = strings.Join(request.Header.Values("Cookie"), "; ")
+		}
+		v.parameter(l.pointer, p, raw, present)
+	}
+}
+
+// parameter deserialises a parameter from its raw form in a request, and checks it against its schema.
+func (v *httpValidator) parameter(pointer string, p *v3.Parameter, raw string, present bool) {
+	n := rootNode(p.GoLow())
+	var value any
+	err := parameters.ErrMissing
+	if present {
+		value, err = parameters.Deserialize(p, raw)
+	}
+	if errors.Is(err, parameters.ErrMissing) {
+		if p.In == "path" || (p.Required != nil && *p.Required) {
+			v.fail(p.In, p.Name, pointer, n, nil, "missing required %s parameter '%s'", p.In, p.Name)
+		}
+		return
+	}
+	if err != nil {
+		v.fail(p.In, p.Name, pointer, n, nil, "%v", err)
+		return
+	}
+	schema := p.Schema
+	if schema == nil {
+		// the value is serialised as the content of a media type.
+		for _, mt := range p.Content.FromOldest() {
+			if mt != nil {
+				schema = mt.Schema
+			}
+			break
+		}
+	}
+	if schema == nil {
+		return
+	}
+	node, err := valueNode(value)
+	if err != nil {
+		v.fail(p.In, p.Name, pointer, n, nil, "%v", err)
+		return
+	}
+	v.value(p.In, p.Name, pointer, n, schema, node, "%s parameter '%s' is not valid", p.In, p.Name)
+}
+
+// value checks a value against a schema, recording a problem if it does not match.
+func (v *httpValidator) value(in, name, pointer string, n *yaml.Node, schema *base.SchemaProxy, value *yaml.Node,
+	format string, args ...any,
+) {
+	errs, err := ValidateSchemaNode(schema, value, v.options)
+	if err != nil {
+		v.fail(in, name, pointer, n, nil, "%v", err)
+		return
+	}
+	if len(errs) > 0 {
+		v.fail(in, name, pointer, n, errs, format, args...)
+	}
+}
+
+// requestBody checks the content type and body of a request.
+func (v *httpValidator) requestBody(r *route, request *http.Request) {
+	body, err := readBody(&request.Body)
+	if err != nil {
+		v.fail("body", "", r.pointer, nil, nil, "unable to read request body: %v", err)
+		return
+	}
+	rb := r.operation.RequestBody
+	if rb == nil {
+		return
+	}
+	pointer := r.pointer + "/requestBody"
+	if len(body) == 0 {
+		if rb.Required != nil && *rb.Required {
+			v.fail("body", "", pointer, rootNode(rb.GoLow()), nil, "missing required request body")
+		}
+		return
+	}
+	v.content("body", pointer+"/content", rootNode(rb.GoLow()), rb.Content, request.Header.Get("Content-Type"),
+		body)
+}
+
+// response checks the status, headers, content type and body of a response.
+func (v *httpValidator) response(r *route, response *http.Response) {
+	body, err := readBody(&response.Body)
+	if err != nil {
+		v.fail("body", "", r.pointer, nil, nil, "unable to read response body: %v", err)
+		return
+	}
+	responses := r.operation.Responses
+	if responses == nil {
+		return
+	}
+	code := strconv.Itoa(response.StatusCode)
+	var res *v3.Response
+	pointer := r.pointer + "/responses/"
+	for status, candidate := range responses.Codes.FromOldest() {
+		if status == code {
+			res, pointer = candidate, pointer+escape(status)
+			break
+		}
+	}
+	if res == nil {
+		for status, candidate := range responses.Codes.FromOldest() {
+			if len(status) == 3 && strings.EqualFold(status[1:], "XX") && status[0] == code[0] {
+				res, pointer = candidate, pointer+escape(status)
+				break
+			}
+		}
+	}
+	if res == nil && responses.Default != nil {
+		res, pointer = responses.Default, pointer+"default"
+	}
+	if res == nil {
+		v.fail("status", code, r.pointer+"/responses", rootNode(responses.GoLow()), nil,
+			"status %d is not a documented response", response.StatusCode)
+		return
+	}
+
+	for name, h := range res.Headers.FromOldest() {
+		if h == nil || strings.EqualFold(name, "Content-Type") {
+			continue
+		}
+		hp := pointer + "/headers/" + escape(name)
+		values := response.Header.Values(name)
+		if len(values) == 0 {
+			if h.Required {
+				v.fail("header", name, hp, rootNode(h.GoLow()), nil, "missing required header '%s'", name)
+			}
+			continue
+		}
+		if h.Schema == nil {
+			continue
+		}
+		p := &v3.Parameter{Name: name, In: "header", Style: h.Style, Explode: &h.Explode, Schema: h.Schema}
+		value, err := parameters.Deserialize(p, strings.Join(values, ","))
+		var node *yaml.Node
+		if err == nil {
+			node, err = valueNode(value)
+		}
+		if err != nil {
+			v.fail("header", name, hp, rootNode(h.GoLow()), nil, "%v", err)
+			continue
+		}
+		v.value("header", name, hp, rootNode(h.GoLow()), h.Schema, node, "header '%s' is not valid", name)
+	}
+	if len(body) > 0 && res.Content != nil {
+		v.content("body", pointer+"/content", rootNode(res.GoLow()), res.Content, response.Header.Get("Content-Type"),
+			body)
+	}
+}
+
+// content checks the content type of a body is allowed, and validates the body against the schema of its media type.
+func (v *httpValidator) content(in, pointer string, n *yaml.Node, content *orderedmap.Map[string, *v3.MediaType],
+	contentType string, body []byte,
+) {
+	if content == nil || content.Len() == 0 {
+		return
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		v.fail(in, contentType, pointer, n, nil, "missing or invalid content type '%s'", contentType)
+		return
+	}
+	name, mt := matchMediaType(content, mediaType)
+	if name == "" {
+		v.fail(in, mediaType, pointer, n, nil, "content type '%s' is not allowed", mediaType)
+		return
+	}
+	pointer += "/" + escape(name)
+	if mt == nil || mt.Schema == nil {
+		return
+	}
+	var node *yaml.Node
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		node, err = parseForm(string(body), mt)
+	case isJSON(mediaType), strings.HasPrefix(mediaType, "text/"):
+		node, err = parseBody(mediaType, body)
+	default:
+		return // the content is not a value that can be validated, like an image.
+	}
+	if err != nil {
+		v.fail(in, mediaType, pointer, rootNode(mt.GoLow()), nil, "body is not valid %s: %v", mediaType, err)
+		return
+	}
+	v.value(in, mediaType, pointer, rootNode(mt.GoLow()), mt.Schema, node, "body does not match its schema")
+}
+
+// matchMediaType finds the media type of content that matches a content type, exact matches first, then ranges
+// like 'application/*' and '*/*'.
+func matchMediaType(content *orderedmap.Map[string, *v3.MediaType], mediaType string) (string, *v3.MediaType) {
+	kind, _, _ := strings.Cut(mediaType, "/")
+	for _, candidate := range []string{mediaType, kind + "/*", "*/*"} {
+		for name, mt := range content.FromOldest() {
+			if parsed, _, err := mime.ParseMediaType(name); err == nil && strings.EqualFold(parsed, candidate) {
+				return name, mt
+			}
+		}
+	}
+	return "", nil
+}
+
+// isJSON returns true for JSON media types, like 'application/json' and 'application/problem+json'.
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// parseBody parses a JSON body into a node, other bodies are strings.
+func parseBody(mediaType string, body []byte) (*yaml.Node, error) {
+	if !isJSON(mediaType) {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: string(body)}, nil
+	}
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("the JSON is malformed")
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(body, &node); err != nil {
+		return nil, err
+	}
+	return unwrap(&node), nil
+}
+
+// parseForm parses a form body into an object, the values of properties are deserialised by their encoding (form
+// by default) and typed by their schemas.
+func parseForm(body string, mt *v3.MediaType) (*yaml.Node, error) {
+	if _, err := url.ParseQuery(body); err != nil {
+		return nil, err
+	}
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+	if s := mt.Schema.Schema(); s != nil && s.Properties != nil {
+		properties = s.Properties
+	}
+	encodings := mt.Encoding
+	if encodings == nil {
+		encodings = orderedmap.New[string, *v3.Encoding]()
+	}
+	object := make(map[string]any)
+	for _, pair := range strings.Split(body, "&") {
+		name, _, _ := strings.Cut(pair, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if _, ok := object[name]; ok || name == "" {
+			continue
+		}
+		p := &v3.Parameter{Name: name, In: "query", Schema: properties.GetOrZero(name)}
+		if encoding := encodings.GetOrZero(name); encoding != nil {
+			p.Style, p.Explode, p.AllowReserved = encoding.Style, encoding.Explode, encoding.AllowReserved
+		}
+		value, err := parameters.Deserialize(p, body)
+		if err != nil {
+			return nil, err
+		}
+		object[name] = value
+	}
+	return valueNode(object)
+}
+
+// readBody reads a body, and replaces it so it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+// security checks that the credentials of at least one security requirement are present in a request.
+func (v *httpValidator) security(r *route, request *http.Request) {
+	requirements, pointer := r.operation.Security, r.pointer+"/security"
+	if requirements == nil {
+		requirements, pointer = v.doc.Security, "/security"
+	}
+	if len(requirements) == 0 {
+		return
+	}
+	for _, requirement := range requirements {
+		if requirement == nil || requirement.ContainsEmptyRequirement || requirement.Requirements.Len() == 0 {
+			return
+		}
+		met := true
+		for name := range requirement.Requirements.FromOldest() {
+			met = met && v.credentials(name, request)
+		}
+		if met {
+			return
+		}
+	}
+	var n *yaml.Node
+	if pointer != "/security" {
+		n = rootNode(r.operation.GoLow())
+	}
+	v.fail("security", "", pointer, n, nil, "none of the security requirements are met")
+}
+
+// credentials returns true if the credentials of a security scheme are present in a request.
+func (v *httpValidator) credentials(name string, request *http.Request) bool {
+	if v.doc.Components == nil || v.doc.Components.SecuritySchemes == nil {
+		return false
+	}
+	scheme := v.doc.Components.SecuritySchemes.GetOrZero(name)
+	if scheme == nil {
+		return false
+	}
+	authorization := request.Header.Get("Authorization")
+	hasScheme := func(s string) bool {
+		return len(authorization) > len(s) && strings.EqualFold(authorization[:len(s)+1], s+" ")
+	}
+	switch strings.ToLower(scheme.Type) {
+	case "apikey":
+		switch scheme.In {
+		case "query":
+			return request.URL.Query().Has(scheme.Name)
+		case "header":
+			return request.Header.Get(scheme.Name) != ""
+		case "cookie":
+			_, err := request.Cookie(scheme.Name)
+			return err == nil
+		}
+	case "http":
+		return hasScheme(scheme.Scheme)
+	case "oauth2", "openidconnect":
+		return hasScheme("Bearer")
+	case "mutualtls":
+		return request.TLS != nil && len(request.TLS.PeerCertificates) > 0
+	}
+	return false
+}
@@ -0,0 +1,404 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petsSpec = `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+servers:
+  - url: https://{region}.example.com/api/{version}
+    variables:
+      region:
+        default: eu
+      version:
+        default: v1
+security:
+  - key: []
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            maximum: 100
+        - name: tags
+          in: query
+          style: pipeDelimited
+          explode: false
+          schema:
+            type: array
+            items:
+              type: string
+        - name: filter
+          in: query
+          style: deepObject
+          schema:
+            type: object
+            properties:
+              age:
+                type: integer
+            additionalProperties: false
+      responses:
+        "200":
+          description: ok
+          headers:
+            X-Total:
+              required: true
+              schema:
+                type: integer
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+        4XX:
+          description: bad request
+    post:
+      security:
+        - bearer: []
+        - {}
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+          application/x-www-form-urlencoded:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "201":
+          description: created
+  /pets/mine:
+    get:
+      responses:
+        "200":
+          description: ok
+  /pets/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: integer
+    get:
+      parameters:
+        - name: X-Trace
+          in: header
+          required: true
+          schema:
+            type: string
+            format: uuid
+      responses:
+        default:
+          description: anything
+  /places/{coords}:
+    get:
+      security: []
+      parameters:
+        - name: coords
+          in: path
+          required: true
+          style: matrix
+          explode: true
+          schema:
+            type: object
+            properties:
+              lat:
+                type: number
+      responses:
+        "200":
+          description: ok
+components:
+  securitySchemes:
+    key:
+      type: apiKey
+      in: header
+      name: X-Key
+    bearer:
+      type: http
+      scheme: bearer
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        age:
+          type: integer`
+
+func petsDocument(t *testing.T) *v3high.Document {
+	t.Helper()
+	return buildDocument(t, petsSpec, nil)
+}
+
+func TestValidateRequest(t *testing.T) {
+	doc := petsDocument(t)
+
+	request := httptest.NewRequest(http.MethodGet,
+		"https://eu.example.com/api/v1/pets?limit=10&tags=a|b&filter[age]=3", nil)
+	request.Header.Set("X-Key", "secret")
+	errs, err := ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	// the body can be read again after validation.
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/pets", strings.NewReader(`{"name": "rex"}`))
+	request.Header.Set("Content-Type", "application/json; charset=utf-8")
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+	body, err := io.ReadAll(request.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name": "rex"}`, string(body))
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/pets", strings.NewReader(`name=rex&age=3`))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+}
+
+func TestValidateRequest_Parameters(t *testing.T) {
+	doc := petsDocument(t)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/pets?limit=500&filter[age]=old&filter[size]=1", nil)
+	errs, err := ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+
+	assert.Equal(t, "query", errs[0].In)
+	assert.Equal(t, "limit", errs[0].Name)
+	assert.Equal(t, "/paths/~1pets/get/parameters/0", errs[0].Pointer)
+	assert.Equal(t, 18, errs[0].Line)
+	assert.Equal(t, "maximum", errs[0].Errors[0].Keyword)
+	assert.Equal(t, 22, errs[0].Errors[0].SchemaLine)
+
+	assert.Equal(t, "filter", errs[1].Name)
+	require.Len(t, errs[1].Errors, 2)
+	assert.Equal(t, "/age", errs[1].Errors[0].Pointer)
+	assert.Equal(t, "property 'size' is not allowed", errs[1].Errors[1].Message)
+
+	assert.Equal(t, "security", errs[2].In)
+	assert.Equal(t, "/security", errs[2].Pointer)
+
+	// path parameters come from the path item and the operation.
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/pets/abc", nil)
+	request.Header.Set("X-Key", "secret")
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "missing required header parameter 'X-Trace'", errs[0].Message)
+	assert.Equal(t, "/paths/~1pets~1{id}/get/parameters/0", errs[0].Pointer)
+	assert.Equal(t, "id", errs[1].Name)
+	assert.Equal(t, "/paths/~1pets~1{id}/parameters/0", errs[1].Pointer)
+	assert.Equal(t, "expected integer, found string", errs[1].Errors[0].Message)
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/places/;lat=north;long=2", nil)
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "/lat", errs[0].Errors[0].Pointer)
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/places/lat=1", nil)
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "unable to deserialise parameter 'coords': 'lat=1' must start with ';' for the matrix style",
+		errs[0].Message)
+}
+
+func TestValidateRequest_Matching(t *testing.T) {
+	doc := petsDocument(t)
+
+	// the concrete path is matched before the template.
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/pets/mine", nil)
+	request.Header.Set("X-Key", "secret")
+	errs, err := ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v2/pets", nil)
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "no path matches '/api/v2/pets'", errs[0].Message)
+
+	request = httptest.NewRequest(http.MethodDelete, "/api/v1/pets", nil)
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "/paths/~1pets", errs[0].Pointer)
+	assert.Equal(t, "method DELETE is not allowed for '/api/v1/pets'", errs[0].Message)
+}
+
+func TestValidateRequest_Body(t *testing.T) {
+	doc := petsDocument(t)
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/pets", strings.NewReader("{\n  \"age\": \"two\"\n}"))
+	request.Header.Set("Content-Type", "application/json")
+	errs, err := ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "body", errs[0].In)
+	assert.Equal(t, "/paths/~1pets/post/requestBody/content/application~1json", errs[0].Pointer)
+	require.Len(t, errs[0].Errors, 2)
+	assert.Equal(t, "missing required property 'name'", errs[0].Errors[0].Message)
+	assert.Equal(t, "/age", errs[0].Errors[1].Pointer)
+	assert.Equal(t, 2, errs[0].Errors[1].Line)
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/pets", strings.NewReader("<pet/>"))
+	request.Header.Set("Content-Type", "application/xml")
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "content type 'application/xml' is not allowed", errs[0].Message)
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/pets", strings.NewReader("name=rex&age=two"))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "/age", errs[0].Errors[0].Pointer)
+
+	request = httptest.NewRequest(http.MethodPost, "/api/v1/pets", nil)
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required request body", errs[0].Message)
+}
+
+func TestValidateResponse(t *testing.T) {
+	doc := petsDocument(t)
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/pets", nil)
+
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.Header().Set("X-Total", "1")
+	recorder.WriteHeader(http.StatusOK)
+	_, _ = recorder.WriteString(`[{"name": "rex"}]`)
+	errs, err := ValidateResponse(doc, request, recorder.Result(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	recorder = httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.WriteHeader(http.StatusOK)
+	_, _ = recorder.WriteString(`[{"age": 1}]`)
+	errs, err = ValidateResponse(doc, request, recorder.Result(), nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "missing required header 'X-Total'", errs[0].Message)
+	assert.Equal(t, "/paths/~1pets/get/responses/200/headers/X-Total", errs[0].Pointer)
+	assert.Equal(t, "/0", errs[1].Errors[0].Pointer)
+
+	// status codes are matched to ranges.
+	recorder = httptest.NewRecorder()
+	recorder.WriteHeader(http.StatusNotFound)
+	errs, err = ValidateResponse(doc, request, recorder.Result(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	recorder = httptest.NewRecorder()
+	recorder.WriteHeader(http.StatusInternalServerError)
+	errs, err = ValidateResponse(doc, request, recorder.Result(), nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "status 500 is not a documented response", errs[0].Message)
+}
+
+func TestValidateRequest_Errors(t *testing.T) {
+	_, err := ValidateRequest(nil, nil, nil)
+	assert.EqualError(t, err, "unable to validate request: a document and a request are required")
+
+	_, err = ValidateResponse(nil, nil, nil, nil)
+	assert.EqualError(t, err, "unable to validate response: a document, a request and a response are required")
+}
+
+func TestValidateRequest_ReadWriteOnly(t *testing.T) {
+	doc := buildDocument(t, `openapi: 3.0.3
+info:
+  title: accounts
+  version: 1.0.0
+paths:
+  /accounts:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Account'
+      responses:
+        "201":
+          description: created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Account'
+components:
+  schemas:
+    Id:
+      type: integer
+      readOnly: true
+    Account:
+      type: object
+      required: [id, name, password]
+      properties:
+        id:
+          $ref: '#/components/schemas/Id'
+        name:
+          type: string
+        password:
+          type: string
+          writeOnly: true`, nil)
+
+	// the id is assigned by the server.
+	request := httptest.NewRequest(http.MethodPost, "/accounts", strings.NewReader(`{"name": "a", "password": "b"}`))
+	request.Header.Set("Content-Type", "application/json")
+	errs, err := ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	request = httptest.NewRequest(http.MethodPost, "/accounts", strings.NewReader(`{"id": 1, "name": "a"}`))
+	request.Header.Set("Content-Type", "application/json")
+	errs, err = ValidateRequest(doc, request, nil)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'password'", errs[0].Errors[0].Message)
+
+	// the password is never returned.
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.WriteHeader(http.StatusCreated)
+	_, _ = recorder.WriteString(`{"id": 1, "name": "a"}`)
+	errs, err = ValidateResponse(doc, request, recorder.Result(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	recorder = httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.WriteHeader(http.StatusCreated)
+	_, _ = recorder.WriteString(`{"name": "a"}`)
+	errs, err = ValidateResponse(doc, request, recorder.Result(), &SchemaOptions{Direction: DirectionRequest})
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'id'", errs[0].Errors[0].Message)
+}
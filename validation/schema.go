@@ -0,0 +1,1152 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// dialect is the version of JSON Schema a schema is written in.
+type dialect int
+
+const (
+	draft2020 dialect = iota
+	draft04
+)
+
+// resource is a schema document (or a schema with its own identifier) that references are resolved against.
+type resource struct {
+	uri            string
+	root           *yaml.Node
+	dialect        dialect
+	anchors        map[string]*yaml.Node
+	dynamicAnchors map[string]*yaml.Node
+	idx            *index.SpecIndex // set for documents, references are resolved through the index.
+}
+
+// scope is the resource a schema is evaluated in, linked to the resources entered before it for dynamic references.
+type scope struct {
+	res    *resource
+	parent *scope
+}
+
+// instance is a value being validated.
+type instance struct {
+	node    *yaml.Node
+	key     *yaml.Node // the key of a property, used to locate errors about the property itself.
+	pointer string
+	idx     *index.SpecIndex // the index of the file holding the value, if known.
+}
+
+// result is the outcome of evaluating a schema, along with the annotations used by unevaluatedProperties and
+// unevaluatedItems.
+type result struct {
+	errs     []*ValidationError
+	props    map[string]bool
+	items    map[int]bool
+	allItems bool
+	matched  int // properties the schema recognised, used to pick the alternative a value was closest to matching.
+}
+
+// dropRepeated removes the errors of subschemas (before the first of the schema's own errors) that the schema's own
+// keywords already report, like the type error of every alternative of a oneOf that agrees with the schema's type.
+func (r *result) dropRepeated(own int) {
+	if own == 0 || own == len(r.errs) {
+		return
+	}
+	reported := make(map[[2]string]bool)
+	for _, ve := range r.errs[own:] {
+		reported[[2]string{ve.Pointer, ve.Message}] = true
+	}
+	errs := make([]*ValidationError, 0, len(r.errs))
+	for i, ve := range r.errs {
+		if i >= own || !reported[[2]string{ve.Pointer, ve.Message}] {
+			errs = append(errs, ve)
+		}
+	}
+	r.errs = errs
+}
+
+func (r *result) valid() bool {
+	return len(r.errs) == 0
+}
+
+// merge collects the errors of a subschema, and its annotations if it's valid.
+func (r *result) merge(sub *result) {
+	r.errs = append(r.errs, sub.errs...)
+	r.matched += sub.matched
+	if sub.valid() {
+		r.annotate(sub)
+	}
+}
+
+func (r *result) annotate(sub *result) {
+	for p := range sub.props {
+		r.evaluatedProperty(p)
+	}
+	for i := range sub.items {
+		r.evaluatedItem(i)
+	}
+	r.allItems = r.allItems || sub.allItems
+}
+
+func (r *result) evaluatedProperty(name string) {
+	if r.props == nil {
+		r.props = make(map[string]bool)
+	}
+	r.props[name] = true
+}
+
+func (r *result) evaluatedItem(i int) {
+	if r.items == nil {
+		r.items = make(map[int]bool)
+	}
+	r.items[i] = true
+}
+
+// evaluator evaluates schemas held as YAML nodes.
+type evaluator struct {
+	resources map[string]*resource
+	embedded  map[*yaml.Node]*resource
+	keywords  map[*yaml.Node]map[string]*yaml.Node
+	patterns  map[string]*regexp.Regexp
+	active    map[[2]*yaml.Node]bool
+	indexed   map[*index.SpecIndex]*resource
+	root      *index.SpecIndex
+
+	// schema is the schema being evaluated, and res the resource it belongs to, used to locate errors.
+	schema *yaml.Node
+	res    *resource
+
+	// openapi enables the OpenAPI keywords: discriminator, and nullable for draft-04 schemas.
+	openapi bool
+
+	// formats asserts the format of values, rather than treating it as an annotation.
+	formats bool
+
+	// direction exempts readOnly properties from being required in requests, and writeOnly ones in responses.
+	direction Direction
+
+	// follow returns the value a reference in the instance points to, or the instance if it's not followed.
+	follow func(in *instance) *instance
+}
+
+func newEvaluator() *evaluator {
+	return &evaluator{
+		resources: make(map[string]*resource),
+		embedded:  make(map[*yaml.Node]*resource),
+		keywords:  make(map[*yaml.Node]map[string]*yaml.Node),
+		patterns:  make(map[string]*regexp.Regexp),
+		active:    make(map[[2]*yaml.Node]bool),
+		indexed:   make(map[*index.SpecIndex]*resource),
+	}
+}
+
+// indexResource returns the resource for a document held by an index.
+func (e *evaluator) indexResource(idx *index.SpecIndex, d dialect) *resource {
+	res := e.indexed[idx]
+	if res == nil {
+		res = &resource{uri: idx.GetSpecAbsolutePath(), root: unwrap(idx.GetRootNode()), dialect: d, idx: idx}
+		e.indexed[idx] = res
+	}
+	return res
+}
+
+// addResource registers a schema document, identified by its own identifier or by the uri if it has none.
+func (e *evaluator) addResource(root *yaml.Node, uri string, fallback dialect) *resource {
+	root = unwrap(root)
+	res := &resource{uri: uri, root: root, dialect: schemaDialect(root, fallback)}
+	if id := identifier(root, res.dialect); id != "" {
+		res.uri, _ = resolveURI(uri, id)
+	}
+	e.resources[res.uri] = res
+	e.embedded[root] = res
+	e.register(root, res)
+	return res
+}
+
+// register finds the anchors and embedded resources of a schema.
+func (e *evaluator) register(n *yaml.Node, res *resource) {
+	n = unwrap(n)
+	if n == nil {
+		return
+	}
+	switch n.Kind {
+	case yaml.MappingNode:
+		if id := identifier(n, res.dialect); n != res.root && id != "" && !strings.HasPrefix(id, "#") {
+			sub := &resource{root: n, dialect: schemaDialect(n, res.dialect)}
+			sub.uri, _ = resolveURI(res.uri, id)
+			e.resources[sub.uri] = sub
+			e.embedded[n] = sub
+			res = sub
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i].Value, unwrap(n.Content[i+1])
+			switch k {
+			case "enum", "const", "default", "examples", "example":
+				continue // values, not schemas.
+			case "$anchor":
+				res.anchor(v.Value, n, false)
+			case "$dynamicAnchor":
+				res.anchor(v.Value, n, true)
+			case "id":
+				if res.dialect == draft04 && v.Kind == yaml.ScalarNode && strings.HasPrefix(v.Value, "#") {
+					res.anchor(v.Value[1:], n, false)
+				}
+			}
+			e.register(v, res)
+		}
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			e.register(c, res)
+		}
+	}
+}
+
+func (r *resource) anchor(name string, n *yaml.Node, dynamic bool) {
+	if r.anchors == nil {
+		r.anchors = make(map[string]*yaml.Node)
+		r.dynamicAnchors = make(map[string]*yaml.Node)
+	}
+	r.anchors[name] = n
+	if dynamic {
+		r.dynamicAnchors[name] = n
+	}
+}
+
+// identifier returns the identifier of a schema, without an empty fragment.
+func identifier(n *yaml.Node, d dialect) string {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return ""
+	}
+	key := "$id"
+	if d == draft04 {
+		key = "id"
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key && n.Content[i+1].Kind == yaml.ScalarNode {
+			id := n.Content[i+1].Value
+			if len(id) > 1 {
+				id = strings.TrimSuffix(id, "#")
+			}
+			return id
+		}
+	}
+	return ""
+}
+
+// schemaDialect returns the dialect declared by a schema's $schema, or the fallback.
+func schemaDialect(n *yaml.Node, fallback dialect) dialect {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return fallback
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == "$schema" {
+			switch s := n.Content[i+1].Value; {
+			case strings.Contains(s, "draft-04"):
+				return draft04
+			case strings.Contains(s, "2020-12"), strings.Contains(s, "2019-09"):
+				return draft2020
+			}
+		}
+	}
+	return fallback
+}
+
+// resolveURI resolves a reference against a base URI, returning the document and the fragment.
+func resolveURI(base, ref string) (string, string) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return base, ""
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return base, ""
+	}
+	u := b.ResolveReference(r)
+	fragment := u.Fragment
+	u.Fragment, u.RawFragment = "", ""
+	return u.String(), fragment
+}
+
+// resolve finds the schema a reference points to, and the scope to evaluate it in.
+func (e *evaluator) resolve(ref string, sc *scope) (*yaml.Node, *scope, string) {
+	if sc.res.idx != nil {
+		return e.resolveIndexed(ref, sc)
+	}
+	doc, fragment := resolveURI(sc.res.uri, ref)
+	res := e.resources[doc]
+	if res == nil {
+		return nil, nil, ""
+	}
+	var target *yaml.Node
+	if fragment == "" || strings.HasPrefix(fragment, "/") {
+		target = walkPointer(res.root, fragment)
+	} else {
+		target = res.anchors[fragment]
+	}
+	if target == nil {
+		return nil, nil, ""
+	}
+	if res != sc.res {
+		sc = &scope{res: res, parent: sc}
+	}
+	return target, sc, fragment
+}
+
+// resolveIndexed finds the schema a reference points to through the index of a document, which searches the rolodex
+// for references to other files.
+func (e *evaluator) resolveIndexed(ref string, sc *scope) (*yaml.Node, *scope, string) {
+	found, foundIdx := sc.res.idx.SearchIndexForReference(ref)
+	if found == nil || found.Node == nil {
+		return nil, nil, ""
+	}
+	if foundIdx == nil {
+		foundIdx = found.Index
+	}
+	if foundIdx == nil {
+		foundIdx = sc.res.idx
+	}
+	if res := e.indexResource(foundIdx, sc.res.dialect); res != sc.res {
+		sc = &scope{res: res, parent: sc}
+	}
+	_, fragment, _ := strings.Cut(ref, "#")
+	return unwrap(found.Node), sc, fragment
+}
+
+// walkPointer finds the node at a JSON pointer.
+func walkPointer(n *yaml.Node, pointer string) *yaml.Node {
+	if pointer == "" {
+		return n
+	}
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		n = unwrap(n)
+		if n == nil {
+			return nil
+		}
+		switch n.Kind {
+		case yaml.MappingNode:
+			n = mappingValue(n, segment)
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(n.Content) {
+				return nil
+			}
+			n = n.Content[i]
+		default:
+			return nil
+		}
+	}
+	return unwrap(n)
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// unwrap returns the content of document nodes and the target of aliases.
+func unwrap(n *yaml.Node) *yaml.Node {
+	for n != nil {
+		switch {
+		case n.Kind == yaml.DocumentNode && len(n.Content) > 0:
+			n = n.Content[0]
+		case n.Kind == yaml.AliasNode && n.Alias != nil:
+			n = n.Alias
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) keywordsOf(s *yaml.Node) map[string]*yaml.Node {
+	if kw, ok := e.keywords[s]; ok {
+		return kw
+	}
+	kw := make(map[string]*yaml.Node, len(s.Content)/2)
+	for i := 0; i+1 < len(s.Content); i += 2 {
+		kw[s.Content[i].Value] = unwrap(s.Content[i+1])
+	}
+	e.keywords[s] = kw
+	return kw
+}
+
+func (e *evaluator) pattern(p string) *regexp.Regexp {
+	re, ok := e.patterns[p]
+	if !ok {
+		re, _ = regexp.Compile(p) // patterns Go can't compile (like lookaheads) are ignored.
+		e.patterns[p] = re
+	}
+	return re
+}
+
+// fail adds an error about a node of the instance to the result.
+func (e *evaluator) fail(r *result, in *instance, n *yaml.Node, path, keyword, format string, args ...any) {
+	ve := &ValidationError{
+		Message:    fmt.Sprintf(format, args...),
+		Keyword:    keyword,
+		Pointer:    in.pointer,
+		SchemaPath: path + "/" + keyword,
+		Node:       n,
+	}
+	// objects and arrays are located by their key, rather than their first value.
+	at := n
+	if n == in.node && in.key != nil && (n.Kind == yaml.MappingNode || n.Kind == yaml.SequenceNode) {
+		at = in.key
+	}
+	if at != nil {
+		ve.Line, ve.Column = at.Line, at.Column
+	}
+	if in.idx != nil {
+		if origin := in.idx.FindNodeOrigin(at); origin != nil {
+			ve.Line, ve.Column = origin.Line, origin.Column
+		}
+		if in.idx != e.root {
+			ve.Location = in.idx.GetSpecAbsolutePath()
+		}
+	}
+	if e.res != nil && e.res.idx != nil && e.schema != nil {
+		at := e.schema
+		for i := 0; i+1 < len(e.schema.Content); i += 2 {
+			if e.schema.Content[i].Value == keyword {
+				at = e.schema.Content[i]
+				break
+			}
+		}
+		ve.SchemaLine, ve.SchemaColumn = at.Line, at.Column
+		if e.res.idx != e.root {
+			ve.SchemaLocation = e.res.idx.GetSpecAbsolutePath()
+		}
+	}
+	r.errs = append(r.errs, ve)
+}
+
+// evaluate validates an instance against a schema, path is the location of the schema.
+func (e *evaluator) evaluate(s *yaml.Node, sc *scope, in *instance, path string) *result {
+	r := new(result)
+	s = unwrap(s)
+	if s == nil {
+		return r
+	}
+	if e.follow != nil {
+		in = e.follow(in)
+	}
+	if res := e.embedded[s]; res != nil && res != sc.res {
+		sc = &scope{res: res, parent: sc}
+	}
+	schema, res := e.schema, e.res
+	e.schema, e.res = s, sc.res
+	defer func() { e.schema, e.res = schema, res }()
+
+	if s.Kind == yaml.ScalarNode {
+		if s.Value == "false" {
+			e.fail(r, in, in.node, path, "false", "no value is allowed")
+		}
+		return r
+	}
+	if s.Kind != yaml.MappingNode {
+		return r
+	}
+
+	// a reference cycle through the same value adds nothing.
+	active := [2]*yaml.Node{s, in.node}
+	if e.active[active] {
+		return r
+	}
+	e.active[active] = true
+	defer delete(e.active, active)
+
+	kw := e.keywordsOf(s)
+	if ref := kw["$ref"]; ref != nil && ref.Kind == yaml.ScalarNode {
+		e.reference(r, ref.Value, false, sc, in, path+"/$ref")
+		if sc.res.dialect == draft04 {
+			return r // siblings of $ref are ignored.
+		}
+	}
+	if ref := kw["$dynamicRef"]; ref != nil && ref.Kind == yaml.ScalarNode {
+		e.reference(r, ref.Value, true, sc, in, path+"/$dynamicRef")
+	}
+
+	e.inPlace(r, kw, sc, in, path)
+	applied := len(r.errs)
+	e.value(r, kw, sc.res.dialect, in, path)
+	switch in.node.Kind {
+	case yaml.MappingNode:
+		e.object(r, kw, sc, in, path)
+	case yaml.SequenceNode:
+		e.array(r, kw, sc, in, path)
+	}
+	r.dropRepeated(applied)
+	if sc.res.dialect != draft04 {
+		e.unevaluated(r, kw, sc, in, path)
+	}
+	return r
+}
+
+// reference evaluates the schema a $ref or $dynamicRef points to.
+func (e *evaluator) reference(r *result, ref string, dynamic bool, sc *scope, in *instance, path string) {
+	target, next, fragment := e.resolve(ref, sc)
+	if target == nil {
+		keyword := path[strings.LastIndex(path, "/")+1:]
+		e.fail(r, in, in.node, path[:strings.LastIndex(path, "/")], keyword, "unable to resolve reference '%s'", ref)
+		return
+	}
+	if dynamic && fragment != "" && !strings.HasPrefix(fragment, "/") {
+		if anchor := mappingValue(target, "$dynamicAnchor"); anchor != nil && anchor.Value == fragment {
+			// the outermost resource in the dynamic scope with the anchor is used.
+			var chain []*scope
+			for s := sc; s != nil; s = s.parent {
+				chain = append(chain, s)
+			}
+			for i := len(chain) - 1; i >= 0; i-- {
+				if n := chain[i].res.dynamicAnchors[fragment]; n != nil {
+					target, next = n, &scope{res: chain[i].res, parent: sc}
+					break
+				}
+			}
+		}
+	}
+	r.merge(e.evaluate(target, next, in, path))
+}
+
+// inPlace evaluates the keywords that apply subschemas to the instance itself.
+func (e *evaluator) inPlace(r *result, kw map[string]*yaml.Node, sc *scope, in *instance, path string) {
+	if all := kw["allOf"]; all != nil && all.Kind == yaml.SequenceNode {
+		for i, s := range all.Content {
+			r.merge(e.evaluate(s, sc, in, fmt.Sprintf("%s/allOf/%d", path, i)))
+		}
+	}
+	var discriminated bool
+	if disc := kw["discriminator"]; disc != nil && e.openapi && in.node.Kind == yaml.MappingNode {
+		discriminated = e.discriminator(r, disc, sc, in, path)
+	}
+	if anyOf := kw["anyOf"]; anyOf != nil && anyOf.Kind == yaml.SequenceNode && !discriminated {
+		results := e.alternatives(anyOf, sc, in, path+"/anyOf")
+		var matched bool
+		for _, sub := range results {
+			if sub.valid() {
+				matched = true
+				r.annotate(sub)
+			}
+		}
+		if !matched {
+			e.noMatch(r, results, in, path, "anyOf")
+		}
+	}
+	if oneOf := kw["oneOf"]; oneOf != nil && oneOf.Kind == yaml.SequenceNode && !discriminated {
+		results := e.alternatives(oneOf, sc, in, path+"/oneOf")
+		var valid []*result
+		for _, sub := range results {
+			if sub.valid() {
+				valid = append(valid, sub)
+			}
+		}
+		switch len(valid) {
+		case 0:
+			e.noMatch(r, results, in, path, "oneOf")
+		case 1:
+			r.annotate(valid[0])
+		default:
+			e.fail(r, in, in.node, path, "oneOf", "must match exactly one schema, but matches %d", len(valid))
+		}
+	}
+	if not := kw["not"]; not != nil {
+		if e.evaluate(not, sc, in, path+"/not").valid() {
+			e.fail(r, in, in.node, path, "not", "must not match the schema")
+		}
+	}
+	if cond := kw["if"]; cond != nil && sc.res.dialect != draft04 {
+		sub := e.evaluate(cond, sc, in, path+"/if")
+		if sub.valid() {
+			r.annotate(sub)
+			if then := kw["then"]; then != nil {
+				r.merge(e.evaluate(then, sc, in, path+"/then"))
+			}
+		} else if otherwise := kw["else"]; otherwise != nil {
+			r.merge(e.evaluate(otherwise, sc, in, path+"/else"))
+		}
+	}
+	if in.node.Kind != yaml.MappingNode {
+		return
+	}
+	for _, keyword := range []string{"dependentSchemas", "dependencies"} {
+		deps := kw[keyword]
+		if deps == nil || deps.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i+1 < len(deps.Content); i += 2 {
+			name, dep := deps.Content[i].Value, unwrap(deps.Content[i+1])
+			if mappingValue(in.node, name) == nil {
+				continue
+			}
+			if dep.Kind == yaml.SequenceNode {
+				e.dependentRequired(r, name, dep, in, path, keyword)
+				continue
+			}
+			r.merge(e.evaluate(dep, sc, in, path+"/"+keyword+"/"+escape(name)))
+		}
+	}
+}
+
+// discriminator evaluates the schema named by the discriminator property of an object, in place of the alternatives
+// of oneOf and anyOf. It returns false if the discriminator has no property name.
+func (e *evaluator) discriminator(r *result, disc *yaml.Node, sc *scope, in *instance, path string) bool {
+	property := mappingValue(disc, "propertyName")
+	if property == nil || property.Value == "" {
+		return false
+	}
+	value := mappingValue(in.node, property.Value)
+	if value == nil {
+		e.fail(r, in, in.node, path, "discriminator", "missing discriminator property '%s'", property.Value)
+		return true
+	}
+	ref := value.Value
+	if mapping := unwrap(mappingValue(disc, "mapping")); mapping != nil {
+		if mapped := mappingValue(mapping, value.Value); mapped != nil {
+			ref = mapped.Value
+		}
+	}
+	if !strings.ContainsAny(ref, "#/") {
+		ref = "#/components/schemas/" + ref // a schema name.
+	}
+	var target *yaml.Node
+	next := sc
+	// local references are looked up in the document first, values that identify no schema are not searched for.
+	if fragment, local := strings.CutPrefix(ref, "#"); !local || !strings.HasPrefix(fragment, "/") ||
+		walkPointer(sc.res.root, fragment) != nil {
+		target, next, _ = e.resolve(ref, sc)
+	}
+	if target == nil {
+		e.fail(r, in, value, path, "discriminator", "%s does not identify a schema", describe(value))
+		return true
+	}
+	r.merge(e.evaluate(target, next, in, path+"/discriminator"))
+	return true
+}
+
+func (e *evaluator) alternatives(schemas *yaml.Node, sc *scope, in *instance, path string) []*result {
+	results := make([]*result, len(schemas.Content))
+	for i, s := range schemas.Content {
+		results[i] = e.evaluate(s, sc, in, fmt.Sprintf("%s/%d", path, i))
+	}
+	return results
+}
+
+// noMatch reports that none of the alternatives of anyOf or oneOf matched, using the errors of the alternative the
+// value came closest to matching.
+func (e *evaluator) noMatch(r *result, results []*result, in *instance, path, keyword string) {
+	if len(results) == 0 {
+		return
+	}
+	best := results[0]
+	for _, sub := range results[1:] {
+		if closer(sub, best) {
+			best = sub
+		}
+	}
+	if errorDepth(best) > pointerDepth(in.pointer) || best.matched > 0 {
+		r.errs = append(r.errs, best.errs...)
+		return
+	}
+	// every alternative failed on the value itself, which only needs explaining once if they agree.
+	messages := make(map[string]bool)
+	for _, sub := range results {
+		for _, ve := range sub.errs {
+			messages[ve.Message] = true
+		}
+	}
+	if len(messages) == 1 {
+		r.errs = append(r.errs, best.errs[0])
+		return
+	}
+	e.fail(r, in, in.node, path, keyword, "does not match any of the allowed schemas")
+}
+
+func closer(a, b *result) bool {
+	if da, db := errorDepth(a), errorDepth(b); da != db {
+		return da > db
+	}
+	if a.matched != b.matched {
+		return a.matched > b.matched
+	}
+	return len(a.errs) < len(b.errs)
+}
+
+func errorDepth(r *result) int {
+	depth := 0
+	for _, ve := range r.errs {
+		depth = max(depth, pointerDepth(ve.Pointer))
+	}
+	return depth
+}
+
+func pointerDepth(pointer string) int {
+	return strings.Count(pointer, "/")
+}
+
+// value evaluates the keywords that apply to any type of value.
+func (e *evaluator) value(r *result, kw map[string]*yaml.Node, d dialect, in *instance, path string) {
+	n := in.node
+	// nullable extends the type and enum of OpenAPI 3.0 schemas.
+	nullable := e.openapi && d == draft04 && typeOf(n) == "null" && kw["nullable"] != nil && kw["nullable"].Value == "true"
+	if t := kw["type"]; t != nil && !nullable {
+		var types []string
+		switch t.Kind {
+		case yaml.ScalarNode:
+			types = []string{t.Value}
+		case yaml.SequenceNode:
+			for _, c := range t.Content {
+				types = append(types, c.Value)
+			}
+		}
+		if len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return hasType(n, t) }) {
+			e.fail(r, in, n, path, "type", "expected %s, found %s", strings.Join(types, " or "), typeOf(n))
+		}
+	}
+	if enum := kw["enum"]; enum != nil && enum.Kind == yaml.SequenceNode && !nullable {
+		if !slices.ContainsFunc(enum.Content, func(v *yaml.Node) bool { return equal(n, v) }) {
+			allowed := make([]string, len(enum.Content))
+			for i, v := range enum.Content {
+				allowed[i] = describe(v)
+			}
+			e.fail(r, in, n, path, "enum", "%s is not one of: %s", describe(n), strings.Join(allowed, ", "))
+		}
+	}
+	if c := kw["const"]; c != nil && !equal(n, c) {
+		e.fail(r, in, n, path, "const", "%s must be %s", describe(n), describe(c))
+	}
+	if f := kw["format"]; f != nil && e.formats {
+		if problem := checkFormat(f.Value, n); problem != "" {
+			e.fail(r, in, n, path, "format", "%s %s", describe(n), problem)
+		}
+	}
+
+	if num, ok := number(n); ok {
+		e.numeric(r, kw, d, num, in, path)
+	}
+	if typeOf(n) == "string" {
+		length := utf8.RuneCountInString(n.Value)
+		if limit, ok := integer(kw["minLength"]); ok && length < limit {
+			e.fail(r, in, n, path, "minLength", "length must be at least %d, found %d", limit, length)
+		}
+		if limit, ok := integer(kw["maxLength"]); ok && length > limit {
+			e.fail(r, in, n, path, "maxLength", "length must be at most %d, found %d", limit, length)
+		}
+		if p := kw["pattern"]; p != nil {
+			if re := e.pattern(p.Value); re != nil && !re.MatchString(n.Value) {
+				e.fail(r, in, n, path, "pattern", "'%s' does not match pattern '%s'", n.Value, p.Value)
+			}
+		}
+	}
+}
+
+func (e *evaluator) numeric(r *result, kw map[string]*yaml.Node, d dialect, num *big.Rat, in *instance, path string) {
+	bound := func(keyword string) (*big.Rat, bool) {
+		if kw[keyword] == nil {
+			return nil, false
+		}
+		return number(kw[keyword])
+	}
+	if m, ok := bound("multipleOf"); ok && m.Sign() > 0 {
+		if !new(big.Rat).Quo(num, m).IsInt() {
+			e.fail(r, in, in.node, path, "multipleOf", "%s must be a multiple of %s", in.node.Value, kw["multipleOf"].Value)
+		}
+	}
+	if d == draft04 {
+		exclusive := func(keyword string) bool {
+			return kw[keyword] != nil && kw[keyword].Value == "true"
+		}
+		if m, ok := bound("minimum"); ok {
+			if c := num.Cmp(m); c < 0 || (c == 0 && exclusive("exclusiveMinimum")) {
+				e.limit(r, in, path, "minimum", "greater than", exclusive("exclusiveMinimum"), kw["minimum"])
+			}
+		}
+		if m, ok := bound("maximum"); ok {
+			if c := num.Cmp(m); c > 0 || (c == 0 && exclusive("exclusiveMaximum")) {
+				e.limit(r, in, path, "maximum", "less than", exclusive("exclusiveMaximum"), kw["maximum"])
+			}
+		}
+		return
+	}
+	if m, ok := bound("minimum"); ok && num.Cmp(m) < 0 {
+		e.limit(r, in, path, "minimum", "greater than", false, kw["minimum"])
+	}
+	if m, ok := bound("exclusiveMinimum"); ok && num.Cmp(m) <= 0 {
+		e.limit(r, in, path, "exclusiveMinimum", "greater than", true, kw["exclusiveMinimum"])
+	}
+	if m, ok := bound("maximum"); ok && num.Cmp(m) > 0 {
+		e.limit(r, in, path, "maximum", "less than", false, kw["maximum"])
+	}
+	if m, ok := bound("exclusiveMaximum"); ok && num.Cmp(m) >= 0 {
+		e.limit(r, in, path, "exclusiveMaximum", "less than", true, kw["exclusiveMaximum"])
+	}
+}
+
+func (e *evaluator) limit(r *result, in *instance, path, keyword, comparison string, exclusive bool, bound *yaml.Node) {
+	if !exclusive {
+		comparison += " or equal to"
+	}
+	e.fail(r, in, in.node, path, keyword, "%s must be %s %s", in.node.Value, comparison, bound.Value)
+}
+
+// object evaluates the keywords that apply to objects.
+func (e *evaluator) object(r *result, kw map[string]*yaml.Node, sc *scope, in *instance, path string) {
+	n := in.node
+	count := len(n.Content) / 2
+	if limit, ok := integer(kw["minProperties"]); ok && count < limit {
+		e.fail(r, in, n, path, "minProperties", "must have at least %d properties, found %d", limit, count)
+	}
+	if limit, ok := integer(kw["maxProperties"]); ok && count > limit {
+		e.fail(r, in, n, path, "maxProperties", "must have at most %d properties, found %d", limit, count)
+	}
+	if required := kw["required"]; required != nil && required.Kind == yaml.SequenceNode {
+		for _, name := range required.Content {
+			if mappingValue(n, name.Value) == nil && !e.exempt(kw["properties"], name.Value, sc) {
+				e.fail(r, in, n, path, "required", "missing required property '%s'", name.Value)
+			}
+		}
+	}
+	if deps := kw["dependentRequired"]; deps != nil && deps.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(deps.Content); i += 2 {
+			if mappingValue(n, deps.Content[i].Value) != nil {
+				e.dependentRequired(r, deps.Content[i].Value, unwrap(deps.Content[i+1]), in, path, "dependentRequired")
+			}
+		}
+	}
+
+	properties, patterns := kw["properties"], kw["patternProperties"]
+	additional := kw["additionalProperties"]
+	names := kw["propertyNames"]
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key := n.Content[i]
+		name := key.Value
+		child := &instance{node: unwrap(n.Content[i+1]), key: key, pointer: in.pointer + "/" + escape(name), idx: in.idx}
+		if names != nil {
+			keyValue := &instance{node: &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name,
+				Line: key.Line, Column: key.Column}, pointer: child.pointer, idx: in.idx}
+			r.merge(e.evaluate(names, sc, keyValue, path+"/propertyNames"))
+		}
+		var evaluated bool
+		if properties != nil && properties.Kind == yaml.MappingNode {
+			if s := mappingValue(properties, name); s != nil {
+				evaluated = true
+				r.merge(e.evaluate(s, sc, child, path+"/properties/"+escape(name)))
+			}
+		}
+		if patterns != nil && patterns.Kind == yaml.MappingNode {
+			for j := 0; j+1 < len(patterns.Content); j += 2 {
+				p := patterns.Content[j].Value
+				if re := e.pattern(p); re != nil && re.MatchString(name) {
+					evaluated = true
+					r.merge(e.evaluate(patterns.Content[j+1], sc, child, path+"/patternProperties/"+escape(p)))
+				}
+			}
+		}
+		if evaluated {
+			r.matched++
+		} else if additional != nil {
+			evaluated = true
+			e.additional(r, additional, sc, child, path, "additionalProperties")
+		}
+		if evaluated {
+			r.evaluatedProperty(name)
+		}
+	}
+}
+
+// additional evaluates a property that is only covered by additionalProperties or unevaluatedProperties.
+func (e *evaluator) additional(r *result, s *yaml.Node, sc *scope, child *instance, path, keyword string) {
+	if s.Kind == yaml.ScalarNode && s.Value == "false" {
+		e.fail(r, child, child.key, path, keyword, "property '%s' is not allowed", child.key.Value)
+		return
+	}
+	r.merge(e.evaluate(s, sc, child, path+"/"+keyword))
+}
+
+// exempt returns true if a property is not required in the direction of the value, because its schema (or a schema
+// it references) is readOnly for requests or writeOnly for responses.
+func (e *evaluator) exempt(properties *yaml.Node, name string, sc *scope) bool {
+	var keyword string
+	switch e.direction {
+	case DirectionRequest:
+		keyword = "readOnly"
+	case DirectionResponse:
+		keyword = "writeOnly"
+	default:
+		return false
+	}
+	if properties == nil || properties.Kind != yaml.MappingNode {
+		return false
+	}
+	s := unwrap(mappingValue(properties, name))
+	for range 32 { // references are followed a limited number of times, in case of cycles.
+		if s == nil || s.Kind != yaml.MappingNode {
+			return false
+		}
+		kw := e.keywordsOf(s)
+		if flag := kw[keyword]; flag != nil && flag.Value == "true" {
+			return true
+		}
+		ref := kw["$ref"]
+		if ref == nil || ref.Kind != yaml.ScalarNode {
+			return false
+		}
+		if s, sc, _ = e.resolve(ref.Value, sc); sc == nil {
+			return false
+		}
+	}
+	return false
+}
+
+func (e *evaluator) dependentRequired(r *result, name string, required *yaml.Node, in *instance, path, keyword string) {
+	for _, dep := range required.Content {
+		if mappingValue(in.node, dep.Value) == nil {
+			e.fail(r, in, in.node, path, keyword, "missing property '%s', which is required when '%s' is present",
+				dep.Value, name)
+		}
+	}
+}
+
+// array evaluates the keywords that apply to arrays.
+func (e *evaluator) array(r *result, kw map[string]*yaml.Node, sc *scope, in *instance, path string) {
+	n := in.node
+	count := len(n.Content)
+	if limit, ok := integer(kw["minItems"]); ok && count < limit {
+		e.fail(r, in, n, path, "minItems", "must have at least %d items, found %d", limit, count)
+	}
+	if limit, ok := integer(kw["maxItems"]); ok && count > limit {
+		e.fail(r, in, n, path, "maxItems", "must have at most %d items, found %d", limit, count)
+	}
+	if u := kw["uniqueItems"]; u != nil && u.Value == "true" {
+	unique:
+		for i := 0; i < count; i++ {
+			for j := i + 1; j < count; j++ {
+				if equal(n.Content[i], n.Content[j]) {
+					e.fail(r, in, n, path, "uniqueItems", "items %d and %d are equal", i, j)
+					break unique
+				}
+			}
+		}
+	}
+
+	item := func(i int) *instance {
+		return &instance{node: unwrap(n.Content[i]), pointer: in.pointer + "/" + strconv.Itoa(i), idx: in.idx}
+	}
+	// tuples are prefixItems from 2020-12, or an array of items with additionalItems in draft-04.
+	tuple, rest, restKeyword := kw["prefixItems"], kw["items"], "items"
+	if sc.res.dialect == draft04 {
+		tuple, rest, restKeyword = nil, kw["additionalItems"], "additionalItems"
+		if items := kw["items"]; items != nil && items.Kind == yaml.SequenceNode {
+			tuple = items
+		} else {
+			rest, restKeyword = items, "items"
+		}
+	}
+	start := 0
+	if tuple != nil && tuple.Kind == yaml.SequenceNode {
+		keyword := "prefixItems"
+		if sc.res.dialect == draft04 {
+			keyword = "items"
+		}
+		for i := 0; i < count && i < len(tuple.Content); i++ {
+			r.merge(e.evaluate(tuple.Content[i], sc, item(i), fmt.Sprintf("%s/%s/%d", path, keyword, i)))
+			r.evaluatedItem(i)
+		}
+		start = len(tuple.Content)
+	}
+	if rest != nil {
+		for i := start; i < count; i++ {
+			if rest.Kind == yaml.ScalarNode && rest.Value == "false" {
+				e.fail(r, in, n.Content[i], path, restKeyword, "item %d is not allowed", i)
+				break
+			}
+			r.merge(e.evaluate(rest, sc, item(i), path+"/"+restKeyword))
+		}
+		r.allItems = true
+	}
+
+	if contains := kw["contains"]; contains != nil {
+		matches := 0
+		for i := 0; i < count; i++ {
+			if e.evaluate(contains, sc, item(i), path+"/contains").valid() {
+				matches++
+				r.evaluatedItem(i)
+			}
+		}
+		least, ok := integer(kw["minContains"])
+		if !ok {
+			least = 1
+		}
+		if matches < least {
+			e.fail(r, in, n, path, "contains", "must contain at least %d matching items, found %d", least, matches)
+		}
+		if most, ok := integer(kw["maxContains"]); ok && matches > most {
+			e.fail(r, in, n, path, "maxContains", "must contain at most %d matching items, found %d", most, matches)
+		}
+	}
+}
+
+// unevaluated evaluates unevaluatedProperties and unevaluatedItems, after every other keyword.
+func (e *evaluator) unevaluated(r *result, kw map[string]*yaml.Node, sc *scope, in *instance, path string) {
+	n := in.node
+	if s := kw["unevaluatedProperties"]; s != nil && n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i]
+			if r.props[key.Value] {
+				continue
+			}
+			child := &instance{node: unwrap(n.Content[i+1]), key: key, pointer: in.pointer + "/" + escape(key.Value),
+				idx: in.idx}
+			e.additional(r, s, sc, child, path, "unevaluatedProperties")
+			r.evaluatedProperty(key.Value)
+		}
+	}
+	if s := kw["unevaluatedItems"]; s != nil && n.Kind == yaml.SequenceNode && !r.allItems {
+		for i, c := range n.Content {
+			if r.items[i] {
+				continue
+			}
+			if s.Kind == yaml.ScalarNode && s.Value == "false" {
+				e.fail(r, in, c, path, "unevaluatedItems", "item %d is not allowed", i)
+				continue
+			}
+			r.merge(e.evaluate(s, sc, &instance{node: unwrap(c), pointer: in.pointer + "/" + strconv.Itoa(i),
+				idx: in.idx}, path+"/unevaluatedItems"))
+		}
+		r.allItems = true
+	}
+}
+
+// typeOf returns the JSON type of a node.
+func typeOf(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	switch n.ShortTag() {
+	case "!!null":
+		return "null"
+	case "!!bool":
+		return "boolean"
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	}
+	return "string"
+}
+
+func hasType(n *yaml.Node, t string) bool {
+	actual := typeOf(n)
+	switch {
+	case actual == t:
+		return true
+	case t == "number":
+		return actual == "integer"
+	case t == "integer" && actual == "number":
+		num, ok := number(n)
+		return ok && num.IsInt()
+	}
+	return false
+}
+
+// number returns the value of a numeric node.
+func number(n *yaml.Node) (*big.Rat, bool) {
+	if n == nil {
+		return nil, false
+	}
+	if t := typeOf(n); t != "integer" && t != "number" {
+		return nil, false
+	}
+	num, ok := new(big.Rat).SetString(strings.ReplaceAll(n.Value, "_", ""))
+	return num, ok
+}
+
+// integer returns the value of a keyword that holds a non-negative integer.
+func integer(n *yaml.Node) (int, bool) {
+	num, ok := number(n)
+	if !ok || !num.IsInt() || !num.Num().IsInt64() {
+		return 0, false
+	}
+	return int(num.Num().Int64()), true
+}
+
+// equal compares two values as JSON.
+func equal(a, b *yaml.Node) bool {
+	a, b = unwrap(a), unwrap(b)
+	ta, tb := typeOf(a), typeOf(b)
+	numeric := func(t string) bool { return t == "integer" || t == "number" }
+	if numeric(ta) && numeric(tb) {
+		x, okA := number(a)
+		y, okB := number(b)
+		if okA && okB {
+			return x.Cmp(y) == 0
+		}
+		return a.Value == b.Value
+	}
+	if ta != tb {
+		return false
+	}
+	switch a.Kind {
+	case yaml.MappingNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		for i := 0; i+1 < len(a.Content); i += 2 {
+			v := mappingValue(b, a.Content[i].Value)
+			if v == nil || !equal(a.Content[i+1], v) {
+				return false
+			}
+		}
+		return true
+	case yaml.SequenceNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		for i := range a.Content {
+			if !equal(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if ta == "boolean" || ta == "null" {
+		return strings.EqualFold(a.Value, b.Value) || ta == "null"
+	}
+	return a.Value == b.Value
+}
+
+// describe returns a short description of a value for messages.
+func describe(n *yaml.Node) string {
+	switch t := typeOf(n); t {
+	case "object", "array":
+		return "the " + t
+	case "string":
+		return "'" + n.Value + "'"
+	}
+	return n.Value
+}
+
+// escape escapes a JSON pointer segment.
+func escape(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func evaluateSchema(t *testing.T, schema, value string) []*ValidationError {
+	t.Helper()
+	var s, v yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(schema), &s))
+	require.NoError(t, yaml.Unmarshal([]byte(value), &v))
+	e := newEvaluator()
+	res := e.addResource(&s, "urn:test", draft2020)
+	return e.evaluate(res.root, &scope{res: res}, &instance{node: unwrap(&v)}, "").errs
+}
+
+func TestEvaluate_Keywords(t *testing.T) {
+	tests := []struct {
+		name, schema, value, keyword string
+	}{
+		{"type", "type: string", "1", "type"},
+		{"type list", "type: [string, 'null']", "true", "type"},
+		{"integer", "type: integer", "1.5", "type"},
+		{"enum", "enum: [a, b]", "c", "enum"},
+		{"const", "const: {a: 1}", "{a: 2}", "const"},
+		{"minimum", "minimum: 5", "4", "minimum"},
+		{"exclusiveMaximum", "exclusiveMaximum: 5", "5", "exclusiveMaximum"},
+		{"multipleOf", "multipleOf: 0.1", "0.35", "multipleOf"},
+		{"minLength", "minLength: 2", "é", "minLength"},
+		{"pattern", "pattern: '^x-'", "y-1", "pattern"},
+		{"required", "required: [a]", "{b: 1}", "required"},
+		{"maxProperties", "maxProperties: 1", "{a: 1, b: 2}", "maxProperties"},
+		{"additionalProperties", "{properties: {a: {}}, additionalProperties: false}", "{a: 1, b: 2}", "additionalProperties"},
+		{"patternProperties", "patternProperties: {'^x': {type: string}}", "{x1: 1}", "type"},
+		{"propertyNames", "propertyNames: {maxLength: 2}", "{abc: 1}", "maxLength"},
+		{"dependentRequired", "dependentRequired: {a: [b]}", "{a: 1}", "dependentRequired"},
+		{"dependentSchemas", "dependentSchemas: {a: {required: [b]}}", "{a: 1}", "required"},
+		{"minItems", "minItems: 2", "[1]", "minItems"},
+		{"uniqueItems", "uniqueItems: true", "[1, 1.0]", "uniqueItems"},
+		{"prefixItems", "{prefixItems: [{type: string}], items: false}", "[a, b]", "items"},
+		{"contains", "contains: {type: string}", "[1, 2]", "contains"},
+		{"maxContains", "{contains: {type: string}, maxContains: 1}", "[a, b]", "maxContains"},
+		{"allOf", "allOf: [{type: object}, {required: [a]}]", "{}", "required"},
+		{"anyOf", "anyOf: [{type: string}, {type: integer}]", "true", "anyOf"},
+		{"oneOf", "oneOf: [{type: number}, {type: integer}]", "1", "oneOf"},
+		{"not", "not: {type: string}", "a", "not"},
+		{"then", "{if: {properties: {a: {const: 1}}}, then: {required: [b]}}", "{a: 1}", "required"},
+		{"else", "{if: {properties: {a: {const: 1}}}, else: {required: [b]}}", "{a: 2}", "required"},
+		{"false", "false", "1", "false"},
+		{"$ref", "{$defs: {s: {type: string}}, $ref: '#/$defs/s'}", "1", "type"},
+		{"anchor", "{$defs: {s: {$anchor: str, type: string}}, $ref: '#str'}", "1", "type"},
+		{"unresolved", "$ref: '#/$defs/missing'", "1", "$ref"},
+		{"unevaluatedProperties", "{allOf: [{properties: {a: {}}}], unevaluatedProperties: false}", "{a: 1, b: 2}",
+			"unevaluatedProperties"},
+		{"unevaluatedItems", "{prefixItems: [{}], unevaluatedItems: false}", "[1, 2]", "unevaluatedItems"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := evaluateSchema(t, tc.schema, tc.value)
+			require.Len(t, errs, 1)
+			assert.Equal(t, tc.keyword, errs[0].Keyword)
+		})
+	}
+}
+
+func TestEvaluate_Valid(t *testing.T) {
+	tests := []struct {
+		name, schema, value string
+	}{
+		{"integer as number", "type: number", "1"},
+		{"whole number as integer", "type: integer", "1.0"},
+		{"anyOf", "anyOf: [{type: string}, {type: integer}]", "1"},
+		{"unevaluatedProperties", "{allOf: [{properties: {a: {}}}], unevaluatedProperties: false}", "{a: 1}"},
+		{"unevaluated through a failed anyOf", "{anyOf: [{properties: {a: {}}}, {required: [b]}], " +
+			"properties: {b: {}}, unevaluatedProperties: false}", "{a: 1, b: 2}"},
+		{"recursive reference", "{properties: {child: {$ref: '#'}}, type: object}", "{child: {child: {}}}"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Empty(t, evaluateSchema(t, tc.schema, tc.value))
+		})
+	}
+}
+
+func TestEvaluate_Draft04(t *testing.T) {
+	schema := `$schema: 'http://json-schema.org/draft-04/schema#'
+definitions:
+  positive:
+    type: number
+    minimum: 0
+    exclusiveMinimum: true
+properties:
+  count:
+    $ref: '#/definitions/positive'
+    type: string
+  pair:
+    items: [{type: string}]
+    additionalItems: false
+dependencies:
+  count: [pair]`
+
+	// siblings of $ref are ignored, and the bounds are exclusive.
+	errs := evaluateSchema(t, schema, "{count: 0, pair: [a, b]}")
+	require.Len(t, errs, 2)
+	assert.Equal(t, "minimum", errs[0].Keyword)
+	assert.Equal(t, "0 must be greater than 0", errs[0].Message)
+	assert.Equal(t, "/count", errs[0].Pointer)
+	assert.Equal(t, "/properties/count/$ref/minimum", errs[0].SchemaPath)
+	assert.Equal(t, "additionalItems", errs[1].Keyword)
+
+	errs = evaluateSchema(t, schema, "{count: 1}")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing property 'pair', which is required when 'count' is present", errs[0].Message)
+}
+
+func TestEvaluate_DynamicRef(t *testing.T) {
+	// the generic list is extended with the dynamic anchor of the outer schema.
+	schema := `$id: 'https://example.com/strings'
+$defs:
+  list:
+    $id: list
+    type: array
+    items:
+      $dynamicRef: '#item'
+    $defs:
+      item:
+        $dynamicAnchor: item
+  item:
+    $dynamicAnchor: item
+    type: string
+$ref: list`
+
+	errs := evaluateSchema(t, schema, "[a, 1]")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "/1", errs[0].Pointer)
+	assert.Equal(t, "expected string, found integer", errs[0].Message)
+}
+
+func TestEvaluate_ClosestAlternative(t *testing.T) {
+	schema := `oneOf:
+  - required: [$ref]
+  - properties:
+      description: {type: string}
+      content: {type: object}
+    required: [description]`
+
+	// the value is closest to the second alternative, so its errors are used.
+	errs := evaluateSchema(t, schema, "{content: {}}")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "missing required property 'description'", errs[0].Message)
+
+	// when nothing is recognised, the alternatives are summarised.
+	errs = evaluateSchema(t, schema, "{other: 1}")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "does not match any of the allowed schemas", errs[0].Message)
+	assert.Equal(t, "/oneOf", errs[0].SchemaPath)
+}
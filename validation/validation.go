@@ -0,0 +1,72 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+// Package validation checks documents and values against JSON Schemas, without loading anything remote.
+//
+// Documents are validated against the OpenAPI (or Swagger) meta-schema for their version. The meta-schemas are
+// embedded in the datamodel package, along with the JSON Schema draft-04 meta-schema that the Swagger meta-schema
+// references. Schemas are evaluated directly from their YAML nodes using JSON Schema draft-04 or 2020-12 semantics,
+// depending on the dialect of the schema.
+//
+// Values are validated against the schemas of a model with the semantics of its OpenAPI version, which is how the
+// examples of a document, and the parameters and bodies of HTTP requests and responses are checked.
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoMetaSchema is returned when validating a document that does not have a meta-schema, like an Arazzo document.
+var ErrNoMetaSchema = errors.New("no meta-schema available")
+
+// ValidationError is a value that does not match its schema.
+type ValidationError struct {
+	// Message explains what is wrong with the value.
+	Message string `json:"message" yaml:"message"`
+
+	// Keyword is the JSON Schema keyword that failed, like 'required' or 'type'.
+	Keyword string `json:"keyword" yaml:"keyword"`
+
+	// Pointer is the JSON pointer of the invalid value. Values found through a reference to another file are located
+	// through the reference.
+	Pointer string `json:"pointer" yaml:"pointer"`
+
+	// SchemaPath is the JSON pointer of the keyword that failed, following the path taken through the schema
+	// (including through references).
+	SchemaPath string `json:"schemaPath" yaml:"schemaPath"`
+
+	// Line and Column locate the invalid value in the file that holds it.
+	Line   int `json:"line,omitempty" yaml:"line,omitempty"`
+	Column int `json:"column,omitempty" yaml:"column,omitempty"`
+
+	// Location is the absolute location of the file that holds the invalid value, empty for the root document.
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+
+	// SchemaLine and SchemaColumn locate the keyword that failed, for schemas from a document.
+	SchemaLine   int `json:"schemaLine,omitempty" yaml:"schemaLine,omitempty"`
+	SchemaColumn int `json:"schemaColumn,omitempty" yaml:"schemaColumn,omitempty"`
+
+	// SchemaLocation is the absolute location of the file that holds the schema, empty for the root document.
+	SchemaLocation string `json:"schemaLocation,omitempty" yaml:"schemaLocation,omitempty"`
+
+	// Node is the invalid value (or the key of a property that is not allowed).
+	Node *yaml.Node `json:"-" yaml:"-"`
+}
+
+// Error returns a string representation of the ValidationError, so it can be used as an error.
+func (e *ValidationError) Error() string {
+	pointer := e.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	switch {
+	case e.Location != "" && e.Line > 0:
+		return fmt.Sprintf("%s (%s, line %d, column %d): %s", pointer, e.Location, e.Line, e.Column, e.Message)
+	case e.Line > 0:
+		return fmt.Sprintf("%s (line %d, column %d): %s", pointer, e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", pointer, e.Message)
+}
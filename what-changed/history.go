@@ -0,0 +1,370 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Revision is a single revision of a specification, for example the contents of a file at a commit.
+type Revision struct {
+	// ID identifies the revision, for example a commit hash.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Time is when the revision was made, if known.
+	Time time.Time `json:"time,omitempty" yaml:"time,omitempty"`
+
+	// Message describes the revision, for example a commit message.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	// Spec is the specification itself.
+	Spec []byte `json:"-" yaml:"-"`
+}
+
+// EventKind describes what happened to an object in a timeline.
+type EventKind int
+
+const (
+	// EventIntroduced means the object (or value) was added.
+	EventIntroduced EventKind = iota + 1
+
+	// EventModified means the value of the object was changed.
+	EventModified
+
+	// EventDeprecated means the object was marked as deprecated.
+	EventDeprecated
+
+	// EventRemoved means the object (or value) was removed.
+	EventRemoved
+
+	// EventRenamed means the object was renamed or moved, the key of the event is the new key.
+	EventRenamed
+)
+
+// String returns the name of an event kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventIntroduced:
+		return "introduced"
+	case EventModified:
+		return "modified"
+	case EventDeprecated:
+		return "deprecated"
+	case EventRemoved:
+		return "removed"
+	case EventRenamed:
+		return "renamed"
+	}
+	return "unknown"
+}
+
+// TimelineEvent is a single change made to an object in a revision.
+type TimelineEvent struct {
+	// Kind is what happened.
+	Kind EventKind `json:"kind" yaml:"kind"`
+
+	// Key identifies the object across revisions. It's the JSON pointer of the change, with sequence indexes
+	// replaced by the identity of the item, so it does not move when items are re-ordered. Parameters are
+	// identified by location and name (query:limit), named items by name, servers by URL and values by themselves.
+	Key string `json:"key" yaml:"key"`
+
+	// Path is the JSON pointer of the change in the revision.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+
+	// Revision is the revision the change was made in, Previous is the revision it was compared with.
+	Revision *Revision `json:"revision" yaml:"revision"`
+	Previous *Revision `json:"previous" yaml:"previous"`
+
+	// Change is the change reported when comparing the revisions, the low level objects are not kept.
+	Change *model.Change `json:"change" yaml:"change"`
+}
+
+// Timeline is every change made across a series of revisions, in the order they were made.
+type Timeline struct {
+	// Revisions that were compared, the specifications are not kept.
+	Revisions []*Revision `json:"revisions,omitempty" yaml:"revisions,omitempty"`
+
+	// Events holds every change, oldest first.
+	Events []*TimelineEvent `json:"events,omitempty" yaml:"events,omitempty"`
+
+	// Errors holds any revisions that could not be read or compared, they are skipped.
+	Errors []error `json:"-" yaml:"-"`
+}
+
+// History returns every event for an object (identified by its key) and everything it contains, oldest first.
+func (t *Timeline) History(key string) []*TimelineEvent {
+	key = strings.TrimSuffix(key, "/")
+	var events []*TimelineEvent
+	for _, e := range t.Events {
+		if e.Key == key || strings.HasPrefix(e.Key, key+"/") {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Find returns the first event of a kind for an object (identified by its key), nil is returned if it never happened.
+// For example, Find("/components/schemas/Pet/required/name", EventIntroduced) returns when the name property of Pet
+// became required.
+func (t *Timeline) Find(key string, kind EventKind) *TimelineEvent {
+	for _, e := range t.Events {
+		if e.Key == key && e.Kind == kind {
+			return e
+		}
+	}
+	return nil
+}
+
+// RevisionsFromBytes returns a series of revisions from specifications held in memory, oldest first. Revisions are
+// identified by their position.
+func RevisionsFromBytes(specs ...[]byte) iter.Seq2[*Revision, error] {
+	return func(yield func(*Revision, error) bool) {
+		for i, spec := range specs {
+			if !yield(&Revision{ID: strconv.Itoa(i), Spec: spec}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CompareRevisions compares each revision in a series with the one before it (oldest first), and builds a timeline
+// of every change. A nil configuration uses the default. Revisions that can't be read are recorded as errors and
+// skipped. If a revision can't be compared with the one before it (for example a Swagger document upgraded to
+// OpenAPI 3), an error is recorded and the timeline continues from that revision.
+func CompareRevisions(revisions iter.Seq2[*Revision, error], config *datamodel.DocumentConfiguration) *Timeline {
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+	timeline := new(Timeline)
+	var previous *Revision
+	var previousDoc any
+
+	for revision, err := range revisions {
+		if err != nil {
+			timeline.Errors = append(timeline.Errors, err)
+			continue
+		}
+		if revision == nil {
+			continue
+		}
+		doc, err := buildRevision(revision, config)
+		if err != nil {
+			timeline.Errors = append(timeline.Errors, err)
+			continue
+		}
+		info := &Revision{ID: revision.ID, Time: revision.Time, Message: revision.Message}
+		timeline.Revisions = append(timeline.Revisions, info)
+
+		if previousDoc != nil {
+			changes, cErr := compareRevisionDocuments(previousDoc, doc)
+			if cErr != nil {
+				timeline.Errors = append(timeline.Errors,
+					fmt.Errorf("unable to compare revision '%s' with '%s': %w", info.ID, previous.ID, cErr))
+			} else if changes != nil {
+				l, r := revisionRoot(previousDoc), revisionRoot(doc)
+				for _, c := range changes.GetAllChanges() {
+					// low level objects hold on to the entire document, which adds up over a long history.
+					c.OriginalObject, c.NewObject = nil, nil
+					timeline.Events = append(timeline.Events, &TimelineEvent{
+						Kind:     eventKind(c),
+						Key:      identityKey(c, l, r),
+						Path:     c.Path,
+						Revision: info,
+						Previous: previous,
+						Change:   c,
+					})
+				}
+			}
+		}
+		previous, previousDoc = info, doc
+	}
+	return timeline
+}
+
+func buildRevision(revision *Revision, config *datamodel.DocumentConfiguration) (any, error) {
+	info, err := datamodel.ExtractSpecInfoWithDocumentCheck(revision.Spec, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read revision '%s': %w", revision.ID, err)
+	}
+	switch info.SpecFormat {
+	case datamodel.OAS2:
+		doc, dErr := v2.CreateDocumentFromConfig(info, config)
+		if doc == nil {
+			return nil, fmt.Errorf("unable to build revision '%s': %w", revision.ID, dErr)
+		}
+		return doc, nil
+	case datamodel.OAS3, datamodel.OAS31: // OpenAPI 3.2 documents are read as oas3.
+		doc, dErr := v3.CreateDocumentFromConfig(info, config)
+		if doc == nil {
+			return nil, fmt.Errorf("unable to build revision '%s': %w", revision.ID, dErr)
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unable to build revision '%s': %s documents (version %s) are not supported, "+
+		"only OpenAPI and Swagger documents can be compared", revision.ID, info.SpecFormat, info.Version)
+}
+
+func compareRevisionDocuments(l, r any) (*model.DocumentChanges, error) {
+	switch ld := l.(type) {
+	case *v3.Document:
+		if rd, ok := r.(*v3.Document); ok {
+			return CompareOpenAPIDocuments(ld, rd), nil
+		}
+	case *v2.Swagger:
+		if rd, ok := r.(*v2.Swagger); ok {
+			return CompareSwaggerDocuments(ld, rd), nil
+		}
+	}
+	return nil, fmt.Errorf("documents are not of the same version")
+}
+
+func eventKind(c *model.Change) EventKind {
+	if c.Property == v3.DeprecatedLabel && c.New == "true" && c.ChangeType != model.PropertyRemoved {
+		return EventDeprecated
+	}
+	switch c.ChangeType {
+	case model.PropertyAdded, model.ObjectAdded:
+		return EventIntroduced
+	case model.PropertyRemoved, model.ObjectRemoved:
+		return EventRemoved
+	case model.ObjectRenamed:
+		return EventRenamed
+	}
+	return EventModified
+}
+
+// revisionNode is a node in a revision, along with the index used to resolve references in it.
+type revisionNode struct {
+	node *yaml.Node
+	idx  *index.SpecIndex
+}
+
+func revisionRoot(doc any) revisionNode {
+	var idx *index.SpecIndex
+	switch d := doc.(type) {
+	case *v3.Document:
+		idx = d.Index
+	case *v2.Swagger:
+		idx = d.Index
+	}
+	if idx == nil || idx.GetRootNode() == nil {
+		return revisionNode{}
+	}
+	root := idx.GetRootNode()
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	return revisionNode{node: root, idx: idx}
+}
+
+// identityKey replaces the sequence indexes in the path of a change with the identity of each item. Removals are
+// looked up in the previous revision, everything else in the new revision.
+func identityKey(c *model.Change, l, r revisionNode) string {
+	segments := splitPointer(c.Path)
+	root := r
+	if c.ChangeType == model.PropertyRemoved || c.ChangeType == model.ObjectRemoved {
+		root = l
+	}
+	n := root
+	for i, s := range segments {
+		n = n.resolve()
+		if n.node == nil {
+			break
+		}
+		switch n.node.Kind {
+		case yaml.MappingNode:
+			n = n.child(s)
+		case yaml.SequenceNode:
+			pos, err := strconv.Atoi(s)
+			if err != nil || pos < 0 || pos >= len(n.node.Content) {
+				n = revisionNode{}
+				continue
+			}
+			n = revisionNode{node: n.node.Content[pos], idx: n.idx}
+			segments[i] = itemIdentity(n.resolve(), s)
+		default:
+			n = revisionNode{}
+		}
+	}
+	return model.JSONPointer(segments)
+}
+
+// itemIdentity identifies an item in a sequence, the position is used if there is nothing better.
+func itemIdentity(n revisionNode, position string) string {
+	if n.node == nil {
+		return position
+	}
+	if n.node.Kind == yaml.ScalarNode {
+		return n.node.Value
+	}
+	name := n.child(v3.NameLabel).value()
+	if in := n.child(v3.InLabel).value(); in != "" && name != "" {
+		return in + ":" + name
+	}
+	if name != "" {
+		return name
+	}
+	if url := n.child(v3.URLLabel).value(); url != "" {
+		return url
+	}
+	return position
+}
+
+func (n revisionNode) child(key string) revisionNode {
+	if n.node == nil || n.node.Kind != yaml.MappingNode {
+		return revisionNode{}
+	}
+	for i := 0; i+1 < len(n.node.Content); i += 2 {
+		if n.node.Content[i].Value == key {
+			return revisionNode{node: n.node.Content[i+1], idx: n.idx}
+		}
+	}
+	return revisionNode{}
+}
+
+func (n revisionNode) value() string {
+	if n.node == nil || n.node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return n.node.Value
+}
+
+// resolve follows a reference, if the node is one.
+func (n revisionNode) resolve() revisionNode {
+	ref := n.child(v3.RefLabel).value()
+	if ref == "" || n.idx == nil {
+		return n
+	}
+	found, idx := n.idx.SearchIndexForReference(ref)
+	if found == nil || found.Node == nil {
+		return revisionNode{}
+	}
+	if idx == nil {
+		idx = n.idx
+	}
+	return revisionNode{node: found.Node, idx: idx}
+}
+
+func splitPointer(pointer string) []string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return nil
+	}
+	segments := strings.Split(pointer, "/")
+	for i := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segments[i], "~1", "/"), "~0", "~")
+	}
+	return segments
+}
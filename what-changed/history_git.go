@@ -0,0 +1,103 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"iter"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// git log separators, a record per commit and a field per value.
+const (
+	gitRecordSeparator = "\x1e"
+	gitFieldSeparator  = "\x1f"
+)
+
+// gitCommit is a commit that changed a file, along with the path of the file at that commit.
+type gitCommit struct {
+	hash, path, subject string
+	time                time.Time
+}
+
+// GitRevisions returns every revision of a specification committed to a local git repository, oldest first. The
+// git CLI must be available, renames of the file are followed. The file path is relative to the repository.
+//
+// Revisions are read from the repository as they are needed, so a long history is never held in memory at once.
+func GitRevisions(ctx context.Context, repository, file string) iter.Seq2[*Revision, error] {
+	return func(yield func(*Revision, error) bool) {
+		commits, err := gitFileCommits(ctx, repository, file)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, c := range commits {
+			spec, sErr := runGit(ctx, repository, "show", fmt.Sprintf("%s:%s", c.hash, c.path))
+			if sErr != nil {
+				if !yield(nil, sErr) {
+					return
+				}
+				continue
+			}
+			if !yield(&Revision{ID: c.hash, Time: c.time, Message: c.subject, Spec: spec}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// gitFileCommits lists the commits that changed a file, oldest first. Commits that deleted the file are skipped.
+func gitFileCommits(ctx context.Context, repository, file string) ([]*gitCommit, error) {
+	out, err := runGit(ctx, repository, "log", "--follow", "--name-status",
+		"--format="+gitRecordSeparator+"%H"+gitFieldSeparator+"%at"+gitFieldSeparator+"%s", "--", file)
+	if err != nil {
+		return nil, err
+	}
+	var commits []*gitCommit
+	for _, record := range strings.Split(string(out), gitRecordSeparator) {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		fields := strings.Split(lines[0], gitFieldSeparator)
+		if len(fields) != 3 {
+			continue
+		}
+		c := &gitCommit{hash: fields[0], subject: fields[2]}
+		if ts, tErr := strconv.ParseInt(fields[1], 10, 64); tErr == nil {
+			c.time = time.Unix(ts, 0).UTC()
+		}
+
+		// the status line holds the path of the file at this commit, renames list the old path first.
+		deleted := false
+		for _, line := range lines[1:] {
+			status := strings.Split(strings.TrimSpace(line), "\t")
+			if len(status) < 2 || status[0] == "" {
+				continue
+			}
+			c.path = status[len(status)-1]
+			deleted = strings.HasPrefix(status[0], "D")
+		}
+		if c.path != "" && !deleted {
+			commits = append(commits, c)
+		}
+	}
+	slices.Reverse(commits)
+	return commits, nil
+}
+
+func runGit(ctx context.Context, repository string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = repository
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to run git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var historyRevisions = []string{
+	`openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string`,
+
+	`openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: offset
+          in: query
+        - name: limit
+          in: query
+          description: how many
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string`,
+
+	`not: a spec`,
+
+	`openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: offset
+          in: query
+        - name: limit
+          in: query
+          description: how many
+          deprecated: true
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]`,
+}
+
+func historySpecs() [][]byte {
+	specs := make([][]byte, len(historyRevisions))
+	for i := range historyRevisions {
+		specs[i] = []byte(historyRevisions[i])
+	}
+	return specs
+}
+
+func TestCompareRevisions(t *testing.T) {
+	timeline := CompareRevisions(RevisionsFromBytes(historySpecs()...), nil)
+
+	// the third revision can't be read, so the last revision is compared with the second.
+	require.Len(t, timeline.Errors, 1)
+	assert.Contains(t, timeline.Errors[0].Error(), "unable to read revision '2'")
+	require.Len(t, timeline.Revisions, 3)
+
+	// when did name become required?
+	e := timeline.Find("/components/schemas/Pet/required/name", EventIntroduced)
+	require.NotNil(t, e)
+	assert.Equal(t, "1", e.Revision.ID)
+	assert.Equal(t, "0", e.Previous.ID)
+	assert.Equal(t, "/components/schemas/Pet/required/0", e.Path)
+
+	// parameters are identified by name, so moving limit does not change its key.
+	history := timeline.History("/paths/~1pets/get/parameters/query:limit")
+	require.Len(t, history, 2)
+	assert.Equal(t, EventIntroduced, history[0].Kind)
+	assert.Equal(t, "/paths/~1pets/get/parameters/query:limit/description", history[0].Key)
+	assert.Equal(t, EventDeprecated, history[1].Kind)
+	assert.Equal(t, "3", history[1].Revision.ID)
+	assert.Equal(t, "deprecated", history[1].Kind.String())
+
+	e = timeline.Find("/components/schemas/Pet/properties", EventRemoved)
+	require.NotNil(t, e)
+	assert.Equal(t, "3", e.Revision.ID)
+	assert.Nil(t, e.Change.OriginalObject)
+	assert.Nil(t, timeline.Find("/components/schemas/Pet", EventDeprecated))
+}
+
+func TestCompareRevisions_VersionChange(t *testing.T) {
+	timeline := CompareRevisions(RevisionsFromBytes([]byte(historyRevisions[0]),
+		[]byte("swagger: 2.0\npaths: {}"), []byte("swagger: 2.0\nhost: pb33f.io\npaths: {}")), nil)
+	require.Len(t, timeline.Errors, 1)
+	assert.Contains(t, timeline.Errors[0].Error(), "unable to compare revision '1' with '0'")
+	require.Len(t, timeline.Events, 1)
+	assert.Equal(t, "/host", timeline.Events[0].Key)
+}
+
+func TestCompareRevisions_OpenAPI32(t *testing.T) {
+	timeline := CompareRevisions(RevisionsFromBytes([]byte(historyRevisions[0]),
+		[]byte(strings.Replace(historyRevisions[0], "openapi: 3.1.0", "openapi: 3.2.0", 1)),
+		[]byte(strings.Replace(historyRevisions[0], "openapi: 3.1.0", "openapi: 3.2.0\nservers:\n  - url: https://pb33f.io", 1))),
+		nil)
+	require.Empty(t, timeline.Errors)
+	require.Len(t, timeline.Revisions, 3)
+	assert.NotNil(t, timeline.Find("/openapi", EventModified))
+	servers := timeline.History("/servers")
+	require.Len(t, servers, 1)
+	assert.Equal(t, EventIntroduced, servers[0].Kind)
+	assert.Equal(t, "2", servers[0].Revision.ID)
+}
+
+func TestCompareRevisions_Unsupported(t *testing.T) {
+	timeline := CompareRevisions(RevisionsFromBytes([]byte(historyRevisions[0]),
+		[]byte("arazzo: 1.0.1\ninfo:\n  title: flows\n  version: 1.0.0")), nil)
+	require.Len(t, timeline.Errors, 1)
+	assert.Equal(t, "unable to build revision '1': arazzo1 documents (version 1.0.1) are not supported, "+
+		"only OpenAPI and Swagger documents can be compared", timeline.Errors[0].Error())
+	assert.Len(t, timeline.Revisions, 1)
+}
+
+func TestCompareRevisions_Renamed(t *testing.T) {
+	timeline := CompareRevisions(RevisionsFromBytes([]byte(historyRevisions[0]),
+		[]byte(strings.Replace(historyRevisions[0], "Pet:", "Animal:", 1))), nil)
+	require.Len(t, timeline.Events, 1)
+	e := timeline.Find("/components/schemas/Animal", EventRenamed)
+	require.NotNil(t, e)
+	assert.Equal(t, "Pet", e.Change.Original)
+	assert.Equal(t, "renamed", e.Kind.String())
+}
+
+func TestCompareRevisions_Errors(t *testing.T) {
+	timeline := CompareRevisions(func(yield func(*Revision, error) bool) {
+		if !yield(nil, assert.AnError) {
+			return
+		}
+		yield(&Revision{ID: "nope", Spec: []byte("not: a spec")}, nil)
+	}, nil)
+	assert.Len(t, timeline.Errors, 2)
+	assert.Empty(t, timeline.Revisions)
+	assert.Empty(t, timeline.Events)
+}
+
+func TestGitRevisions(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	repo := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@pb33f.io",
+			"-c", "commit.gpgsign=false"}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	git("init", "-q")
+	for i, spec := range []string{historyRevisions[0], historyRevisions[1]} {
+		require.NoError(t, os.WriteFile(filepath.Join(repo, "openapi.yaml"), []byte(spec), 0o644))
+		git("add", "-A")
+		git("commit", "-q", "-m", []string{"first", "second"}[i])
+	}
+	git("mv", "openapi.yaml", "api.yaml")
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "api.yaml"), []byte(historyRevisions[3]), 0o644))
+	git("add", "-A")
+	git("commit", "-q", "-m", "third")
+
+	timeline := CompareRevisions(GitRevisions(context.Background(), repo, "api.yaml"), nil)
+	require.Empty(t, timeline.Errors)
+	require.Len(t, timeline.Revisions, 3)
+	assert.Equal(t, "first", timeline.Revisions[0].Message)
+	assert.Equal(t, "third", timeline.Revisions[2].Message)
+	assert.False(t, timeline.Revisions[0].Time.IsZero())
+
+	e := timeline.Find("/components/schemas/Pet/required/name", EventIntroduced)
+	require.NotNil(t, e)
+	assert.Equal(t, "second", e.Revision.Message)
+	assert.NotNil(t, timeline.Find("/paths/~1pets/get/parameters/query:limit/deprecated", EventDeprecated))
+}
+
+func TestGitRevisions_Error(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	timeline := CompareRevisions(GitRevisions(context.Background(), t.TempDir(), "api.yaml"), nil)
+	require.Len(t, timeline.Errors, 1)
+	assert.Contains(t, timeline.Errors[0].Error(), "unable to run git log")
+}
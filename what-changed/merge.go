@@ -0,0 +1,567 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"gopkg.in/yaml.v3"
+)
+
+// MergeResult is the result of a three-way merge.
+type MergeResult struct {
+	// Document is the merged document. Objects in conflict keep the value from the base document.
+	Document *v3high.Document `json:"-" yaml:"-"`
+
+	// Spec is the merged document, rendered as YAML.
+	Spec []byte `json:"-" yaml:"-"`
+
+	// Conflicts holds every object changed differently by both sides.
+	Conflicts []*MergeConflict `json:"conflicts,omitempty" yaml:"conflicts,omitempty"`
+
+	// OursChanges and TheirsChanges are the changes made by each side, compared with the base document.
+	OursChanges   *model.DocumentChanges `json:"ours,omitempty" yaml:"ours,omitempty"`
+	TheirsChanges *model.DocumentChanges `json:"theirs,omitempty" yaml:"theirs,omitempty"`
+}
+
+// HasConflicts returns true if the merge has any conflicts.
+func (m *MergeResult) HasConflicts() bool {
+	return len(m.Conflicts) > 0
+}
+
+// MergeConflict is an object that was changed differently by both sides.
+type MergeConflict struct {
+	// Pointer is the JSON pointer of the object in conflict, it's the same in all three documents.
+	Pointer string `json:"pointer" yaml:"pointer"`
+
+	// Base, Ours and Theirs locate the object in each document. The node is nil if the object does not exist.
+	Base   *MergeSource `json:"base" yaml:"base"`
+	Ours   *MergeSource `json:"ours" yaml:"ours"`
+	Theirs *MergeSource `json:"theirs" yaml:"theirs"`
+
+	// OursChanges and TheirsChanges are the changes made by each side that caused the conflict.
+	OursChanges   []*model.Change `json:"oursChanges,omitempty" yaml:"oursChanges,omitempty"`
+	TheirsChanges []*model.Change `json:"theirsChanges,omitempty" yaml:"theirsChanges,omitempty"`
+}
+
+// MergeSource locates an object in one of the documents being merged.
+type MergeSource struct {
+	Pointer string     `json:"pointer,omitempty" yaml:"pointer,omitempty"`
+	Line    int        `json:"line,omitempty" yaml:"line,omitempty"`
+	Column  int        `json:"column,omitempty" yaml:"column,omitempty"`
+	Node    *yaml.Node `json:"-" yaml:"-"`
+}
+
+// MergeDocuments performs a three-way merge of OpenAPI documents. The changes made by each side are found by
+// comparing them with the base document, and applied to a copy of it. A nil configuration uses the default.
+//
+// Each change is applied to the object it was made in: changes reached through a local reference are applied to the
+// referenced object, and changes to an item in an array are applied to the whole array. If both sides change the same
+// object differently, a conflict is reported and the object keeps the value from the base document. If both sides
+// change the same array, and no items are added or removed, the array is merged item by item, and items that are
+// objects are merged key by key.
+//
+// An error is returned if any document has no content, or the merged document can't be built.
+func MergeDocuments(base, ours, theirs *v3high.Document, config *datamodel.DocumentConfiguration) (*MergeResult, error) {
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+	if base == nil || ours == nil || theirs == nil || base.GoLow() == nil || ours.GoLow() == nil ||
+		theirs.GoLow() == nil {
+		return nil, errors.New("unable to merge documents: all three documents are required")
+	}
+	b, o, t := revisionRoot(base.GoLow()), revisionRoot(ours.GoLow()), revisionRoot(theirs.GoLow())
+	if b.node == nil || o.node == nil || t.node == nil {
+		return nil, errors.New("unable to merge documents: all three documents must have content")
+	}
+
+	result := &MergeResult{
+		OursChanges:   CompareOpenAPIDocuments(base.GoLow(), ours.GoLow()),
+		TheirsChanges: CompareOpenAPIDocuments(base.GoLow(), theirs.GoLow()),
+	}
+	m := &merger{base: b.node, ours: o.node, theirs: t.node, merged: cloneNode(b.node)}
+	oursEdits := m.edits(result.OursChanges, o.node)
+	theirsEdits := m.edits(result.TheirsChanges, t.node)
+	m.merge(oursEdits, theirsEdits)
+	result.Conflicts = m.conflicts
+
+	var err error
+	if result.Spec, result.Document, err = buildDocument(m.merged, config); err != nil {
+		return nil, fmt.Errorf("unable to build merged document: %w", err)
+	}
+	return result, nil
+}
+
+// buildDocument renders a root node as YAML, and builds a document from it.
+func buildDocument(root *yaml.Node, config *datamodel.DocumentConfiguration) ([]byte, *v3high.Document, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return nil, nil, err
+	}
+	info, err := datamodel.ExtractSpecInfoWithDocumentCheck(buf.Bytes(), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := v3.CreateDocumentFromConfig(info, config)
+	if doc == nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), v3high.NewDocument(doc), nil
+}
+
+// mergeEdit is an object changed by one side, along with the changes made to it.
+type mergeEdit struct {
+	path    []string
+	changes []*model.Change
+}
+
+type merger struct {
+	base, ours, theirs, merged *yaml.Node
+	conflicts                  []*MergeConflict
+}
+
+// edits finds the objects changed by one side. Objects inside another changed object are not included.
+func (m *merger) edits(changes *model.DocumentChanges, side *yaml.Node) []*mergeEdit {
+	found := make(map[string]*mergeEdit)
+	for _, c := range changes.GetAllChanges() {
+		for _, p := range m.changedObjects(c, side) {
+			key := model.JSONPointer(p)
+			if found[key] == nil {
+				found[key] = &mergeEdit{path: p}
+			}
+			found[key].changes = append(found[key].changes, c)
+		}
+	}
+	var edits []*mergeEdit
+	for _, e := range found {
+		edits = append(edits, e)
+	}
+	// shortest first, so objects are seen before anything inside them.
+	sort.Slice(edits, func(i, j int) bool {
+		if len(edits[i].path) != len(edits[j].path) {
+			return len(edits[i].path) < len(edits[j].path)
+		}
+		return model.JSONPointer(edits[i].path) < model.JSONPointer(edits[j].path)
+	})
+	var outer []*mergeEdit
+	for _, e := range edits {
+		if o := containing(outer, e.path); o != nil {
+			o.changes = append(o.changes, e.changes...)
+			continue
+		}
+		outer = append(outer, e)
+	}
+	return outer
+}
+
+// changedObjects returns the paths of the objects a change was made in. Removals are located in the base document,
+// everything else in the document that made the change. A rename changes both the old and the new key.
+func (m *merger) changedObjects(c *model.Change, side *yaml.Node) [][]string {
+	segments := splitPointer(c.Path)
+	switch c.ChangeType {
+	case model.PropertyRemoved, model.ObjectRemoved:
+		return [][]string{objectPath(m.base, segments)}
+	case model.ObjectRenamed:
+		paths := [][]string{objectPath(side, segments)}
+		if len(segments) > 0 && c.Original != "" {
+			old := append(append([]string{}, segments[:len(segments)-1]...), c.Original)
+			paths = append(paths, objectPath(m.base, old))
+		}
+		return paths
+	}
+	return [][]string{objectPath(side, segments)}
+}
+
+// maxReferenceHops stops circular references being followed forever.
+const maxReferenceHops = 32
+
+// objectPath follows a pointer through a document, and returns the path of the object it ends in. Local references
+// are followed, so the path is where the object is written. The path stops at an array (unless the item is a
+// reference), or at the first key that does not exist.
+func objectPath(root *yaml.Node, segments []string) []string {
+	var path []string
+	n := root
+	hops := 0
+	for i := 0; i < len(segments); i++ {
+		s := segments[i]
+		if n == nil {
+			return path
+		}
+		switch n.Kind {
+		case yaml.MappingNode:
+			if s != v3.RefLabel && hops < maxReferenceHops {
+				if target, resolved := followReference(root, n); resolved != nil {
+					hops++
+					path, n = target, resolved
+					i-- // the same segment is looked up in the referenced object.
+					continue
+				}
+			}
+			path = append(path, s)
+			n = mappingValue(n, s)
+		case yaml.SequenceNode:
+			// a change inside a referenced item is made to the referenced object, not the array.
+			item := lookupNode(n, []string{s})
+			if i+1 < len(segments) && segments[i+1] != v3.RefLabel && hops < maxReferenceHops {
+				if target, resolved := followReference(root, item); resolved != nil {
+					hops++
+					path, n = target, resolved
+					continue
+				}
+			}
+			return path
+		default:
+			return path
+		}
+	}
+	return path
+}
+
+// followReference returns the path and node referenced by a node, if it's a local reference.
+func followReference(root, n *yaml.Node) ([]string, *yaml.Node) {
+	ref := mappingValue(n, v3.RefLabel)
+	if ref == nil || !strings.HasPrefix(ref.Value, "#") {
+		return nil, nil
+	}
+	target := splitPointer(strings.TrimPrefix(ref.Value, "#"))
+	resolved := lookupNode(root, target)
+	if resolved == nil || resolved == n {
+		return nil, nil
+	}
+	return target, resolved
+}
+
+// containing returns the edit that contains a path, if there is one.
+func containing(edits []*mergeEdit, path []string) *mergeEdit {
+	for _, e := range edits {
+		if isPrefix(e.path, path) {
+			return e
+		}
+	}
+	return nil
+}
+
+func isPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeCluster is an object changed by one or both sides, along with every edit made inside it.
+type mergeCluster struct {
+	path         []string
+	ours, theirs []*mergeEdit
+}
+
+// merge applies the edits made by both sides. Edits that overlap are applied if both sides made the same change,
+// arrays are merged item by item, and anything else is a conflict.
+func (m *merger) merge(ours, theirs []*mergeEdit) {
+	type sideEdit struct {
+		edit *mergeEdit
+		ours bool
+	}
+	var all []sideEdit
+	for _, e := range ours {
+		all = append(all, sideEdit{e, true})
+	}
+	for _, e := range theirs {
+		all = append(all, sideEdit{e, false})
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return len(all[i].edit.path) < len(all[j].edit.path)
+	})
+
+	// the outermost object changed by either side decides the outcome of everything inside it.
+	var clusters []*mergeCluster
+	for _, se := range all {
+		var c *mergeCluster
+		for _, k := range clusters {
+			if isPrefix(k.path, se.edit.path) {
+				c = k
+				break
+			}
+		}
+		if c == nil {
+			c = &mergeCluster{path: se.edit.path}
+			clusters = append(clusters, c)
+		}
+		if se.ours {
+			c.ours = append(c.ours, se.edit)
+		} else {
+			c.theirs = append(c.theirs, se.edit)
+		}
+	}
+
+	for _, c := range clusters {
+		switch {
+		case len(c.theirs) == 0:
+			for _, e := range c.ours {
+				m.apply(e.path, lookupNode(m.ours, e.path))
+			}
+		case len(c.ours) == 0:
+			for _, e := range c.theirs {
+				m.apply(e.path, lookupNode(m.theirs, e.path))
+			}
+		default:
+			m.resolve(c.path, editChanges(c.ours), editChanges(c.theirs))
+		}
+	}
+}
+
+func editChanges(edits []*mergeEdit) []*model.Change {
+	var changes []*model.Change
+	for _, e := range edits {
+		changes = append(changes, e.changes...)
+	}
+	return changes
+}
+
+// resolve merges an object changed by both sides.
+func (m *merger) resolve(path []string, oChanges, tChanges []*model.Change) {
+	b, o, t := lookupNode(m.base, path), lookupNode(m.ours, path), lookupNode(m.theirs, path)
+	if nodesEqual(o, t) {
+		m.apply(path, o)
+		return
+	}
+	if merged, conflicts, ok := mergeItems(b, o, t); ok {
+		for _, c := range conflicts {
+			m.conflict(append(append([]string{}, path...), c...), oChanges, tChanges)
+		}
+		m.apply(path, merged)
+		return
+	}
+	m.conflict(path, oChanges, tChanges)
+}
+
+// mergeItems merges two arrays changed from a base array by position, which is only possible if no items were added
+// or removed. Items are merged with mergeNodes, and the paths of values changed differently by both sides (which keep
+// the base value) are returned, starting with the position of the item.
+func mergeItems(b, o, t *yaml.Node) (*yaml.Node, [][]string, bool) {
+	if b == nil || o == nil || t == nil || b.Kind != yaml.SequenceNode || o.Kind != yaml.SequenceNode ||
+		t.Kind != yaml.SequenceNode || len(b.Content) != len(o.Content) || len(b.Content) != len(t.Content) {
+		return nil, nil, false
+	}
+	merged := cloneNode(b)
+	var conflicts [][]string
+	for i := range b.Content {
+		item, itemConflicts := mergeNodes(b.Content[i], o.Content[i], t.Content[i])
+		for _, c := range itemConflicts {
+			conflicts = append(conflicts, append([]string{strconv.Itoa(i)}, c...))
+		}
+		merged.Content[i] = item
+	}
+	return merged, conflicts, true
+}
+
+// mergeNodes merges two values changed from a base value. A value changed by one side (or the same way by both) is
+// taken from that side, objects changed by both sides are merged key by key, and arrays item by item. The paths of
+// values changed differently by both sides are returned, relative to the value, and keep the base value. A nil value
+// does not exist.
+func mergeNodes(b, o, t *yaml.Node) (*yaml.Node, [][]string) {
+	switch {
+	case nodesEqual(o, b):
+		return cloneNode(t), nil
+	case nodesEqual(t, b), nodesEqual(o, t):
+		return cloneNode(o), nil
+	}
+	if merged, conflicts, ok := mergeItems(b, o, t); ok {
+		return merged, conflicts
+	}
+	if b == nil || o == nil || t == nil || b.Kind != yaml.MappingNode || o.Kind != yaml.MappingNode ||
+		t.Kind != yaml.MappingNode {
+		return cloneNode(b), [][]string{{}}
+	}
+
+	// keys keep the order of the base object, keys added by ours come next, then keys added by theirs.
+	merged := cloneNode(b)
+	merged.Content = nil
+	var conflicts [][]string
+	seen := make(map[string]bool)
+	for _, side := range []*yaml.Node{b, o, t} {
+		for i := 0; i+1 < len(side.Content); i += 2 {
+			key := side.Content[i]
+			if seen[key.Value] {
+				continue
+			}
+			seen[key.Value] = true
+			value, valueConflicts := mergeNodes(mappingValue(b, key.Value), mappingValue(o, key.Value),
+				mappingValue(t, key.Value))
+			for _, c := range valueConflicts {
+				conflicts = append(conflicts, append([]string{key.Value}, c...))
+			}
+			if value != nil {
+				merged.Content = append(merged.Content, cloneNode(key), value)
+			}
+		}
+	}
+	return merged, conflicts
+}
+
+func (m *merger) conflict(path []string, oChanges, tChanges []*model.Change) {
+	m.conflicts = append(m.conflicts, &MergeConflict{
+		Pointer:       model.JSONPointer(path),
+		Base:          mergeSource(m.base, path),
+		Ours:          mergeSource(m.ours, path),
+		Theirs:        mergeSource(m.theirs, path),
+		OursChanges:   oChanges,
+		TheirsChanges: tChanges,
+	})
+}
+
+func mergeSource(root *yaml.Node, path []string) *MergeSource {
+	n := lookupNode(root, path)
+	if n == nil {
+		return &MergeSource{}
+	}
+	return &MergeSource{Pointer: model.JSONPointer(path), Line: n.Line, Column: n.Column, Node: n}
+}
+
+// apply sets the value of an object in the merged document, a nil value removes it. Missing parent objects are
+// created.
+func (m *merger) apply(path []string, value *yaml.Node) {
+	if len(path) == 0 {
+		return
+	}
+	n := m.merged
+	for i, s := range path[:len(path)-1] {
+		next := lookupNode(n, path[i:i+1])
+		if next == nil {
+			if value == nil {
+				return
+			}
+			next = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: s}, next)
+		}
+		if next.Kind != yaml.MappingNode && next.Kind != yaml.SequenceNode {
+			return
+		}
+		n = next
+	}
+	last := path[len(path)-1]
+	if n.Kind == yaml.SequenceNode {
+		if i, err := strconv.Atoi(last); err == nil && i >= 0 && i < len(n.Content) && value != nil {
+			n.Content[i] = cloneNode(value)
+		}
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value != last {
+			continue
+		}
+		if value == nil {
+			n.Content = append(n.Content[:i], n.Content[i+2:]...)
+		} else {
+			n.Content[i+1] = cloneNode(value)
+		}
+		return
+	}
+	if value != nil {
+		n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: last}, cloneNode(value))
+	}
+}
+
+// lookupNode finds the node at a path, nil is returned if it does not exist.
+func lookupNode(root *yaml.Node, path []string) *yaml.Node {
+	n := root
+	for _, s := range path {
+		if n == nil {
+			return nil
+		}
+		switch n.Kind {
+		case yaml.MappingNode:
+			n = mappingValue(n, s)
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(s)
+			if err != nil || i < 0 || i >= len(n.Content) {
+				return nil
+			}
+			n = n.Content[i]
+		default:
+			return nil
+		}
+	}
+	return n
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// nodesEqual compares the values of two nodes, ignoring key order, positions, style and comments.
+func nodesEqual(a, b *yaml.Node) bool {
+	if a != nil && a.Kind == yaml.AliasNode {
+		a = a.Alias
+	}
+	if b != nil && b.Kind == yaml.AliasNode {
+		b = b.Alias
+	}
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Kind != b.Kind {
+		return false
+	}
+	switch a.Kind {
+	case yaml.MappingNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		for i := 0; i+1 < len(a.Content); i += 2 {
+			if !nodesEqual(a.Content[i+1], mappingValue(b, a.Content[i].Value)) {
+				return false
+			}
+		}
+		return true
+	case yaml.SequenceNode, yaml.DocumentNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		for i := range a.Content {
+			if !nodesEqual(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a.Value == b.Value && a.ShortTag() == b.ShortTag()
+}
+
+func cloneNode(n *yaml.Node) *yaml.Node {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	if n.Content != nil {
+		c.Content = make([]*yaml.Node, len(n.Content))
+		for i := range n.Content {
+			c.Content[i] = cloneNode(n.Content[i])
+		}
+	}
+	return &c
+}
@@ -0,0 +1,328 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mergeDoc(t *testing.T, spec string) *v3high.Document {
+	t.Helper()
+	info, err := datamodel.ExtractSpecInfo([]byte(spec))
+	require.NoError(t, err)
+	doc, err := v3.CreateDocumentFromConfig(info, datamodel.NewDocumentConfiguration())
+	require.NoError(t, err)
+	return v3high.NewDocument(doc)
+}
+
+func mergeSpecs(t *testing.T, base, ours, theirs string) *MergeResult {
+	t.Helper()
+	result, err := MergeDocuments(mergeDoc(t, base), mergeDoc(t, ours), mergeDoc(t, theirs), nil)
+	require.NoError(t, err)
+	require.NotNil(t, result.Document)
+	return result
+}
+
+const mergeBase = `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+        - name: offset
+          in: query
+        - $ref: '#/components/parameters/Sort'
+      responses:
+        "200":
+          description: ok
+components:
+  parameters:
+    Sort:
+      name: sort
+      in: query
+      description: sort order
+  schemas:
+    Pet:
+      type: object`
+
+func TestMergeDocuments(t *testing.T) {
+	ours := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list all the pets
+      parameters:
+        - name: limit
+          in: query
+          description: how many
+        - name: offset
+          in: query
+        - $ref: '#/components/parameters/Sort'
+      responses:
+        "200":
+          description: ok
+components:
+  parameters:
+    Sort:
+      name: sort
+      in: query
+      description: sort order
+  schemas:
+    Pet:
+      type: object
+    Toy:
+      type: string`
+
+	theirs := `openapi: 3.1.0
+info:
+  title: pet store
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+        - name: offset
+          in: query
+          description: how many to skip
+        - $ref: '#/components/parameters/Sort'
+      responses:
+        "200":
+          description: ok
+  /stores:
+    get:
+      responses:
+        "200":
+          description: ok
+components:
+  parameters:
+    Sort:
+      name: sort
+      in: query
+      description: the sort order
+  schemas:
+    Pet:
+      type: object`
+
+	result := mergeSpecs(t, mergeBase, ours, theirs)
+	assert.False(t, result.HasConflicts())
+	assert.NotNil(t, result.OursChanges)
+	assert.NotNil(t, result.TheirsChanges)
+
+	doc := result.Document
+	assert.Equal(t, "pet store", doc.Info.Title)
+	get := doc.Paths.PathItems.GetOrZero("/pets").Get
+	assert.Equal(t, "list all the pets", get.Description)
+	assert.Equal(t, "how many", get.Parameters[0].Description)
+	assert.Equal(t, "how many to skip", get.Parameters[1].Description)
+	assert.NotNil(t, doc.Paths.PathItems.GetOrZero("/stores"))
+	assert.NotNil(t, doc.Components.Schemas.GetOrZero("Toy"))
+
+	// the change made through the reference is applied to the component.
+	assert.Equal(t, "the sort order", doc.Components.Parameters.GetOrZero("Sort").Description)
+	assert.Contains(t, string(result.Spec), "$ref: '#/components/parameters/Sort'")
+
+	// merging a document with itself changes nothing.
+	same := mergeSpecs(t, mergeBase, mergeBase, mergeBase)
+	assert.False(t, same.HasConflicts())
+	assert.Equal(t, "list pets", same.Document.Paths.PathItems.GetOrZero("/pets").Get.Description)
+}
+
+func TestMergeDocuments_Conflicts(t *testing.T) {
+	ours := `openapi: 3.1.0
+info:
+  title: our pets
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      type: object
+      description: a pet`
+
+	theirs := `openapi: 3.1.0
+info:
+  title: their pets
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      type: object
+      description: a pet`
+
+	base := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      type: object`
+
+	result := mergeSpecs(t, base, ours, theirs)
+	require.Len(t, result.Conflicts, 1)
+	c := result.Conflicts[0]
+	assert.Equal(t, "/info/title", c.Pointer)
+	assert.Equal(t, "pets", c.Base.Node.Value)
+	assert.Equal(t, "our pets", c.Ours.Node.Value)
+	assert.Equal(t, "their pets", c.Theirs.Node.Value)
+	assert.Equal(t, 3, c.Ours.Line)
+	assert.Equal(t, "/info/title", c.Theirs.Pointer)
+	require.Len(t, c.OursChanges, 1)
+	require.Len(t, c.TheirsChanges, 1)
+	assert.Equal(t, "their pets", c.TheirsChanges[0].New)
+
+	// the conflict keeps the base value, and the same change made by both sides is applied.
+	assert.Equal(t, "pets", result.Document.Info.Title)
+	assert.Equal(t, "a pet", result.Document.Components.Schemas.GetOrZero("Pet").Schema().Description)
+}
+
+func TestMergeDocuments_RemovedAndModified(t *testing.T) {
+	ours := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+        - name: offset
+          in: query
+        - $ref: '#/components/parameters/Sort'
+      responses:
+        "200":
+          description: ok
+components:
+  parameters:
+    Sort:
+      name: sort
+      in: query
+      description: sort order`
+
+	theirs := `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+        - name: offset
+          in: query
+        - $ref: '#/components/parameters/Sort'
+      responses:
+        "200":
+          description: ok
+components:
+  parameters:
+    Sort:
+      name: sort
+      in: query
+      description: sort order
+  schemas:
+    Pet:
+      type: object
+      description: a pet`
+
+	// ours removed the schemas, theirs changed a schema.
+	result := mergeSpecs(t, mergeBase, ours, theirs)
+	require.Len(t, result.Conflicts, 1)
+	c := result.Conflicts[0]
+	assert.Equal(t, "/components/schemas/Pet", c.Pointer)
+	assert.Nil(t, c.Ours.Node)
+	assert.NotNil(t, c.Theirs.Node)
+	assert.NotNil(t, result.Document.Components.Schemas.GetOrZero("Pet"))
+}
+
+func TestMergeDocuments_ArrayConflict(t *testing.T) {
+	side := func(description string) string {
+		return `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+          description: ` + description + `
+        - name: offset
+          in: query
+        - $ref: '#/components/parameters/Sort'
+      responses:
+        "200":
+          description: ok
+components:
+  parameters:
+    Sort:
+      name: sort
+      in: query
+      description: sort order
+  schemas:
+    Pet:
+      type: object`
+	}
+	result := mergeSpecs(t, mergeBase, side("ours"), side("theirs"))
+	require.Len(t, result.Conflicts, 1)
+	assert.Equal(t, "/paths/~1pets/get/parameters/0/description", result.Conflicts[0].Pointer)
+	assert.Equal(t, "", result.Document.Paths.PathItems.GetOrZero("/pets").Get.Parameters[0].Description)
+}
+
+func TestMergeDocuments_ArrayItemKeys(t *testing.T) {
+	base := strings.Replace(mergeBase, `        - name: limit
+          in: query
+`, `        - name: limit
+          in: query
+          schema:
+            type: integer
+            maximum: 100
+`, 1)
+	ours := strings.Replace(base, "maximum: 100", "maximum: 50", 1)
+	theirs := strings.Replace(base, "maximum: 100", "maximum: 100\n            minimum: 1", 1)
+
+	// different keys of the same parameter are changed, so both changes are kept.
+	result := mergeSpecs(t, base, ours, theirs)
+	assert.Empty(t, result.Conflicts)
+	limit := result.Document.Paths.PathItems.GetOrZero("/pets").Get.Parameters[0].Schema.Schema()
+	assert.Equal(t, float64(50), *limit.Maximum)
+	assert.Equal(t, float64(1), *limit.Minimum)
+
+	// the same key changed differently is a conflict, and keeps the base value. The other changes are kept.
+	theirs = strings.Replace(theirs, "maximum: 100", "maximum: 10", 1)
+	result = mergeSpecs(t, base, ours, theirs)
+	require.Len(t, result.Conflicts, 1)
+	assert.Equal(t, "/paths/~1pets/get/parameters/0/schema/maximum", result.Conflicts[0].Pointer)
+	limit = result.Document.Paths.PathItems.GetOrZero("/pets").Get.Parameters[0].Schema.Schema()
+	assert.Equal(t, float64(100), *limit.Maximum)
+	assert.Equal(t, float64(1), *limit.Minimum)
+}
+
+func TestMergeDocuments_Errors(t *testing.T) {
+	_, err := MergeDocuments(nil, nil, nil, nil)
+	assert.EqualError(t, err, "unable to merge documents: all three documents are required")
+}
@@ -0,0 +1,363 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	_ "embed"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Object types used to key breaking rules, each one represents a changes object in this package. For example,
+// changes found in ParameterChanges are checked against rules defined for ObjectParameter.
+const (
+	ObjectDocument            = "document"
+	ObjectInfo                = "info"
+	ObjectContact             = "contact"
+	ObjectLicense             = "license"
+	ObjectPaths               = "paths"
+	ObjectPathItem            = "pathItem"
+	ObjectOperation           = "operation"
+	ObjectParameter           = "parameter"
+	ObjectRequestBody         = "requestBody"
+	ObjectResponses           = "responses"
+	ObjectResponse            = "response"
+	ObjectHeader              = "header"
+	ObjectMediaType           = "mediaType"
+	ObjectEncoding            = "encoding"
+	ObjectExample             = "example"
+	ObjectExamples            = "examples"
+	ObjectLink                = "link"
+	ObjectCallback            = "callback"
+	ObjectSchema              = "schema"
+	ObjectDiscriminator       = "discriminator"
+	ObjectXML                 = "xml"
+	ObjectItems               = "items"
+	ObjectComponents          = "components"
+	ObjectSecurityScheme      = "securityScheme"
+	ObjectSecurityRequirement = "securityRequirement"
+	ObjectOAuthFlows          = "oauthFlows"
+	ObjectOAuthFlow           = "oauthFlow"
+	ObjectScopes              = "scopes"
+	ObjectServer              = "server"
+	ObjectServerVariable      = "serverVariable"
+	ObjectTag                 = "tag"
+	ObjectExternalDocs        = "externalDocs"
+	ObjectExtensions          = "extensions"
+)
+
+// AnyProperty can be used in place of a property name, to create a rule that applies to every property of an
+// object type that does not have a rule of its own. For example, every extension of ObjectExtensions.
+const AnyProperty = "*"
+
+var changesObjectTypes = map[reflect.Type]string{
+	reflect.TypeOf(DocumentChanges{}):            ObjectDocument,
+	reflect.TypeOf(InfoChanges{}):                ObjectInfo,
+	reflect.TypeOf(ContactChanges{}):             ObjectContact,
+	reflect.TypeOf(LicenseChanges{}):             ObjectLicense,
+	reflect.TypeOf(PathsChanges{}):               ObjectPaths,
+	reflect.TypeOf(PathItemChanges{}):            ObjectPathItem,
+	reflect.TypeOf(OperationChanges{}):           ObjectOperation,
+	reflect.TypeOf(ParameterChanges{}):           ObjectParameter,
+	reflect.TypeOf(RequestBodyChanges{}):         ObjectRequestBody,
+	reflect.TypeOf(ResponsesChanges{}):           ObjectResponses,
+	reflect.TypeOf(ResponseChanges{}):            ObjectResponse,
+	reflect.TypeOf(HeaderChanges{}):              ObjectHeader,
+	reflect.TypeOf(MediaTypeChanges{}):           ObjectMediaType,
+	reflect.TypeOf(EncodingChanges{}):            ObjectEncoding,
+	reflect.TypeOf(ExampleChanges{}):             ObjectExample,
+	reflect.TypeOf(ExamplesChanges{}):            ObjectExamples,
+	reflect.TypeOf(LinkChanges{}):                ObjectLink,
+	reflect.TypeOf(CallbackChanges{}):            ObjectCallback,
+	reflect.TypeOf(SchemaChanges{}):              ObjectSchema,
+	reflect.TypeOf(DiscriminatorChanges{}):       ObjectDiscriminator,
+	reflect.TypeOf(XMLChanges{}):                 ObjectXML,
+	reflect.TypeOf(ItemsChanges{}):               ObjectItems,
+	reflect.TypeOf(ComponentsChanges{}):          ObjectComponents,
+	reflect.TypeOf(SecuritySchemeChanges{}):      ObjectSecurityScheme,
+	reflect.TypeOf(SecurityRequirementChanges{}): ObjectSecurityRequirement,
+	reflect.TypeOf(OAuthFlowsChanges{}):          ObjectOAuthFlows,
+	reflect.TypeOf(OAuthFlowChanges{}):           ObjectOAuthFlow,
+	reflect.TypeOf(ScopesChanges{}):              ObjectScopes,
+	reflect.TypeOf(ServerChanges{}):              ObjectServer,
+	reflect.TypeOf(ServerVariableChanges{}):      ObjectServerVariable,
+	reflect.TypeOf(TagChanges{}):                 ObjectTag,
+	reflect.TypeOf(ExternalDocChanges{}):         ObjectExternalDocs,
+	reflect.TypeOf(ExtensionChanges{}):           ObjectExtensions,
+}
+
+// BreakingRule determines if a change to a property is breaking, for each kind of change. Additions cover both
+// PropertyAdded and ObjectAdded changes, removals cover both PropertyRemoved and ObjectRemoved changes, and renames
+// cover ObjectRenamed changes.
+//
+// A nil value means the rule has no opinion, and the decision made by the comparison function is kept.
+type BreakingRule struct {
+	Added    *bool `json:"added,omitempty" yaml:"added,omitempty"`
+	Modified *bool `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Removed  *bool `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Renamed  *bool `json:"renamed,omitempty" yaml:"renamed,omitempty"`
+}
+
+// NewBreakingRule creates a BreakingRule that sets all three kinds of change.
+func NewBreakingRule(added, modified, removed bool) *BreakingRule {
+	return &BreakingRule{Added: &added, Modified: &modified, Removed: &removed}
+}
+
+// ForChangeType returns the decision of the rule for a change type, nil is returned if the rule has no opinion.
+func (b *BreakingRule) ForChangeType(changeType int) *bool {
+	if b == nil {
+		return nil
+	}
+	switch changeType {
+	case PropertyAdded, ObjectAdded:
+		return b.Added
+	case Modified:
+		return b.Modified
+	case PropertyRemoved, ObjectRemoved:
+		return b.Removed
+	case ObjectRenamed:
+		return b.Renamed
+	}
+	return nil
+}
+
+// merge returns a new rule, with any decisions made by the override replacing the decisions of this rule.
+func (b *BreakingRule) merge(override *BreakingRule) *BreakingRule {
+	m := new(BreakingRule)
+	if b != nil {
+		*m = *b
+	}
+	if override == nil {
+		return m
+	}
+	if override.Added != nil {
+		m.Added = override.Added
+	}
+	if override.Modified != nil {
+		m.Modified = override.Modified
+	}
+	if override.Removed != nil {
+		m.Removed = override.Removed
+	}
+	if override.Renamed != nil {
+		m.Renamed = override.Renamed
+	}
+	return m
+}
+
+// BreakingRules is a ruleset that determines which changes are breaking. Rules are keyed by object type
+// (for example ObjectParameter) and then by property (for example 'style'), which is the same value as
+// Change.Property.
+//
+// Rulesets can be loaded from YAML or JSON using ParseBreakingRules, or built in code using SetRule. Use
+// DefaultBreakingRules to start from the rules used by the library, and Merge to layer overrides on top:
+//
+//	parameter:
+//	  style:
+//	    modified: true
+//	  maximum:
+//	    added: false
+//	    modified: false
+type BreakingRules struct {
+	rules map[string]map[string]*BreakingRule
+	lock  sync.RWMutex
+}
+
+// NewBreakingRules creates a new empty ruleset, an empty ruleset keeps every decision made by the comparison functions.
+func NewBreakingRules() *BreakingRules {
+	return &BreakingRules{rules: make(map[string]map[string]*BreakingRule)}
+}
+
+//go:embed breaking_rules.yaml
+var defaultBreakingRulesYAML []byte
+
+// DefaultBreakingRules returns a copy of the default ruleset. The default ruleset describes the decisions made by
+// the comparison functions for properties where the decision is fixed. Decisions that depend on the values being
+// compared (like a parameter becoming required) are not part of the defaults, but can still be overridden.
+func DefaultBreakingRules() *BreakingRules {
+	rules, err := ParseBreakingRules(defaultBreakingRulesYAML)
+	if err != nil {
+		panic(fmt.Sprintf("default breaking rules are invalid: %s", err))
+	}
+	return rules
+}
+
+// ParseBreakingRules parses a YAML or JSON ruleset. An error is returned if the ruleset cannot be parsed, or if it
+// uses an unknown object type.
+func ParseBreakingRules(data []byte) (*BreakingRules, error) {
+	var raw map[string]map[string]*BreakingRule
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse breaking rules: %w", err)
+	}
+	known := make(map[string]bool, len(changesObjectTypes))
+	for _, o := range changesObjectTypes {
+		known[o] = true
+	}
+	rules := NewBreakingRules()
+	for object, properties := range raw {
+		if !known[object] {
+			return nil, fmt.Errorf("unable to parse breaking rules: unknown object type '%s'", object)
+		}
+		for property, rule := range properties {
+			rules.SetRule(object, property, rule)
+		}
+	}
+	return rules, nil
+}
+
+// SetRule sets the rule for a property of an object type, replacing any existing rule.
+func (r *BreakingRules) SetRule(object, property string, rule *BreakingRule) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.rules[object] == nil {
+		r.rules[object] = make(map[string]*BreakingRule)
+	}
+	r.rules[object][property] = rule.merge(nil)
+}
+
+// GetRule returns the rule for a property of an object type, the AnyProperty rule of the object type is returned
+// if the property has no rule of its own. Nil is returned if there is no rule.
+func (r *BreakingRules) GetRule(object, property string) *BreakingRule {
+	if r == nil {
+		return nil
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if rule := r.rules[object][property]; rule != nil {
+		return rule
+	}
+	return r.rules[object][AnyProperty]
+}
+
+// IsBreaking determines if a change is breaking. If there is no rule for the change, the fallback is returned.
+func (r *BreakingRules) IsBreaking(object, property string, changeType int, fallback bool) bool {
+	if b := r.GetRule(object, property).ForChangeType(changeType); b != nil {
+		return *b
+	}
+	return fallback
+}
+
+// Merge returns a new ruleset, with the decisions of the overrides replacing the decisions of this ruleset. Only
+// the kinds of change set by an override are replaced, so an override can change 'modified' and keep 'removed'.
+func (r *BreakingRules) Merge(overrides *BreakingRules) *BreakingRules {
+	merged := NewBreakingRules()
+	for _, rs := range []*BreakingRules{r, overrides} {
+		if rs == nil {
+			continue
+		}
+		rs.lock.RLock()
+		for object, properties := range rs.rules {
+			for property, rule := range properties {
+				if merged.rules[object] == nil {
+					merged.rules[object] = make(map[string]*BreakingRule)
+				}
+				merged.rules[object][property] = merged.rules[object][property].merge(rule)
+			}
+		}
+		rs.lock.RUnlock()
+	}
+	return merged
+}
+
+// Render renders the ruleset as YAML, object types and properties are sorted.
+func (r *BreakingRules) Render() ([]byte, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, object := range sortedKeys(r.rules) {
+		props := &yaml.Node{Kind: yaml.MappingNode}
+		for _, property := range sortedKeys(r.rules[object]) {
+			var v yaml.Node
+			if err := v.Encode(r.rules[object][property]); err != nil {
+				return nil, err
+			}
+			props.Content = append(props.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: property}, &v)
+		}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: object}, props)
+	}
+	return yaml.Marshal(root)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ApplyBreakingRules walks a changes object (for example *DocumentChanges or *ParameterChanges) and all the changes
+// objects it contains, and sets the Breaking flag of every change that has a matching rule. Changes without a rule
+// are left untouched.
+func ApplyBreakingRules(changes any, rules *BreakingRules) {
+	if changes == nil || rules == nil {
+		return
+	}
+	applyBreakingRules(reflect.ValueOf(changes), "", rules)
+}
+
+var changeSliceType = reflect.TypeOf([]*Change{})
+
+func applyBreakingRules(v reflect.Value, object string, rules *BreakingRules) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			applyBreakingRules(v.Elem(), object, rules)
+		}
+	case reflect.Slice:
+		if v.Type() == changeSliceType {
+			for _, c := range v.Interface().([]*Change) {
+				if c != nil {
+					c.Breaking = rules.IsBreaking(object, c.Property, c.ChangeType, c.Breaking)
+				}
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			applyBreakingRules(v.Index(i), object, rules)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			applyBreakingRules(v.MapIndex(k), object, rules)
+		}
+	case reflect.Struct:
+		// changes to schemas that are never seen in the direction they are used in, can't be breaking.
+		if v.Type() == schemaChangesType && v.CanAddr() &&
+			v.Addr().Interface().(*SchemaChanges).direction == directionIgnored {
+			return
+		}
+		objectType, ok := changesObjectTypes[v.Type()]
+		if ok {
+			object = objectType
+		} else if v.Type() != reflect.TypeOf(PropertyChanges{}) {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if f.Type == changeSliceType || isChangesType(f.Type) {
+				applyBreakingRules(v.Field(i), object, rules)
+			}
+		}
+	}
+}
+
+// isChangesType returns true if the type is (or contains) a changes object of this package.
+func isChangesType(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(PropertyChanges{}) {
+		return true
+	}
+	_, ok := changesObjectTypes[t]
+	return ok
+}
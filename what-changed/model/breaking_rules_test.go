@@ -0,0 +1,183 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"testing"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultBreakingRules(t *testing.T) {
+	rules := DefaultBreakingRules()
+
+	// parameter style is not breaking, maximum is.
+	assert.False(t, rules.IsBreaking(ObjectParameter, v3.StyleLabel, Modified, true))
+	assert.True(t, rules.IsBreaking(ObjectParameter, v3.MaximumLabel, Modified, false))
+	assert.True(t, rules.IsBreaking(ObjectParameter, v3.DefaultLabel, PropertyAdded, false))
+
+	// required depends on the values being compared, so there is no rule and the fallback is used.
+	assert.Nil(t, rules.GetRule(ObjectParameter, v3.RequiredLabel))
+	assert.True(t, rules.IsBreaking(ObjectParameter, v3.RequiredLabel, Modified, true))
+	assert.False(t, rules.IsBreaking(ObjectParameter, v3.RequiredLabel, Modified, false))
+
+	// defaults are a copy.
+	rules.SetRule(ObjectParameter, v3.StyleLabel, NewBreakingRule(true, true, true))
+	assert.False(t, DefaultBreakingRules().IsBreaking(ObjectParameter, v3.StyleLabel, Modified, true))
+}
+
+func TestParseBreakingRules_YAML(t *testing.T) {
+	rules, err := ParseBreakingRules([]byte(`parameter:
+  style:
+    modified: true
+  maximum:
+    added: false
+    modified: false
+extensions:
+  "*":
+    removed: true`))
+	require.NoError(t, err)
+
+	assert.True(t, rules.IsBreaking(ObjectParameter, v3.StyleLabel, Modified, false))
+	assert.False(t, rules.IsBreaking(ObjectParameter, v3.StyleLabel, PropertyRemoved, false))
+	assert.False(t, rules.IsBreaking(ObjectParameter, v3.MaximumLabel, PropertyAdded, true))
+	assert.True(t, rules.IsBreaking(ObjectParameter, v3.MaximumLabel, PropertyRemoved, true))
+	assert.True(t, rules.IsBreaking(ObjectExtensions, "x-pizza", ObjectRemoved, false))
+	assert.False(t, rules.IsBreaking(ObjectExtensions, "x-pizza", ObjectAdded, false))
+}
+
+func TestParseBreakingRules_JSON(t *testing.T) {
+	rules, err := ParseBreakingRules([]byte(`{"schema": {"description": {"modified": true}}}`))
+	require.NoError(t, err)
+	assert.True(t, rules.IsBreaking(ObjectSchema, v3.DescriptionLabel, Modified, false))
+}
+
+func TestParseBreakingRules_Errors(t *testing.T) {
+	_, err := ParseBreakingRules([]byte(`pizza:
+  style:
+    modified: true`))
+	assert.EqualError(t, err, "unable to parse breaking rules: unknown object type 'pizza'")
+
+	_, err = ParseBreakingRules([]byte(`parameter: [not a map]`))
+	assert.Error(t, err)
+}
+
+func TestBreakingRules_Merge(t *testing.T) {
+	overrides := NewBreakingRules()
+	modified := true
+	overrides.SetRule(ObjectParameter, v3.StyleLabel, &BreakingRule{Modified: &modified})
+
+	merged := DefaultBreakingRules().Merge(overrides)
+
+	// modified is replaced, additions and removals are kept from the defaults.
+	assert.True(t, merged.IsBreaking(ObjectParameter, v3.StyleLabel, Modified, false))
+	assert.False(t, merged.IsBreaking(ObjectParameter, v3.StyleLabel, PropertyAdded, true))
+	assert.False(t, merged.IsBreaking(ObjectParameter, v3.StyleLabel, PropertyRemoved, true))
+
+	// nothing else is touched.
+	assert.True(t, merged.IsBreaking(ObjectParameter, v3.MaximumLabel, Modified, false))
+	assert.Nil(t, NewBreakingRules().Merge(nil).GetRule(ObjectParameter, v3.StyleLabel))
+}
+
+func TestBreakingRules_Render(t *testing.T) {
+	rules := NewBreakingRules()
+	rules.SetRule(ObjectSchema, v3.TypeLabel, NewBreakingRule(false, true, true))
+	removed := false
+	rules.SetRule(ObjectInfo, v3.TitleLabel, &BreakingRule{Removed: &removed})
+
+	out, err := rules.Render()
+	require.NoError(t, err)
+	assert.Equal(t, `info:
+    title:
+        removed: false
+schema:
+    type:
+        added: false
+        modified: true
+        removed: true
+`, string(out))
+
+	parsed, err := ParseBreakingRules(out)
+	require.NoError(t, err)
+	assert.True(t, parsed.IsBreaking(ObjectSchema, v3.TypeLabel, Modified, false))
+}
+
+func TestApplyBreakingRules(t *testing.T) {
+	changes := &ParameterChanges{
+		PropertyChanges: NewPropertyChanges([]*Change{
+			{Property: v3.StyleLabel, ChangeType: Modified},
+			{Property: v3.DescriptionLabel, ChangeType: Modified},
+		}),
+		SchemaChanges: &SchemaChanges{
+			PropertyChanges: NewPropertyChanges([]*Change{
+				{Property: v3.StyleLabel, ChangeType: Modified},
+			}),
+		},
+		ExtensionChanges: &ExtensionChanges{
+			PropertyChanges: NewPropertyChanges([]*Change{
+				{Property: "x-pizza", ChangeType: ObjectRemoved},
+			}),
+		},
+	}
+	rules, _ := ParseBreakingRules([]byte(`parameter:
+  style:
+    modified: true
+extensions:
+  "*":
+    removed: true`))
+
+	ApplyBreakingRules(changes, rules)
+	assert.True(t, changes.Changes[0].Breaking)
+	assert.False(t, changes.Changes[1].Breaking)
+	assert.False(t, changes.SchemaChanges.Changes[0].Breaking) // rule is for parameters, not schemas.
+	assert.True(t, changes.ExtensionChanges.Changes[0].Breaking)
+
+	// nothing happens without changes or rules.
+	ApplyBreakingRules(nil, rules)
+	ApplyBreakingRules(changes, nil)
+}
+
+const breakingRulesLeft = `openapi: 3.1.0
+paths:
+  /burgers:
+    get:
+      parameters:
+        - name: size
+          in: query
+          style: form
+          description: how big`
+
+const breakingRulesRight = `openapi: 3.1.0
+paths:
+  /burgers:
+    get:
+      parameters:
+        - name: size
+          in: query
+          style: spaceDelimited
+          description: how big is it`
+
+func TestCompareDocumentsWithOptions_BreakingRules(t *testing.T) {
+	extChanges := compareDocsWithOptions(t, breakingRulesLeft, breakingRulesRight, nil)
+	assert.Equal(t, 2, extChanges.TotalChanges())
+	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
+
+	overrides, err := ParseBreakingRules([]byte(`parameter:
+  style:
+    modified: true
+  description:
+    modified: true`))
+	require.NoError(t, err)
+	options := &CompareOptions{BreakingRules: DefaultBreakingRules().Merge(overrides)}
+
+	extChanges = compareDocsWithOptions(t, breakingRulesLeft, breakingRulesRight, options)
+	assert.Equal(t, 2, extChanges.TotalChanges())
+	assert.Equal(t, 2, extChanges.TotalBreakingChanges())
+
+	// the rules only apply to the comparison they are passed to.
+	extChanges = compareDocsWithOptions(t, breakingRulesLeft, breakingRulesRight, nil)
+	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
+}
@@ -0,0 +1,369 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// changePathSegments holds the fields of changes objects that don't use their JSON name in the specification. An
+// empty segment means the field does not add to the path, the keys it holds sit directly on the parent object.
+var changePathSegments = map[string]string{
+	"ExtensionChanges":           "",
+	"PathItemsChanges":           "",
+	"ExpressionChanges":          "",
+	"ResponseChanges":            "",
+	"SchemaChanges":              v3.SchemaLabel,
+	"ExternalDocChanges":         v3.ExternalDocsLabel,
+	"SecurityRequirementChanges": v3.SecurityLabel,
+	"RequestBodyChanges":         v3.RequestBodyLabel,
+	"ServerVariableChanges":      v3.VariablesLabel,
+	"OAuthFlowChanges":           v3.FlowsLabel,
+	"AuthorizationCodeChanges":   v3.AuthorizationCodeLabel,
+	"MappingChanges":             "mapping",
+}
+
+// swaggerPathSegments holds the segments that differ for Swagger documents, which keep components at the root.
+var swaggerPathSegments = map[string]string{
+	"ComponentsChanges":                       "",
+	"ComponentsChanges.SchemaChanges":         v2.DefinitionsLabel,
+	"ComponentsChanges.SecuritySchemeChanges": v2.SecurityDefinitionsLabel,
+}
+
+// changeLocateDepth is how deep a change is looked for below the object that reported it.
+const changeLocateDepth = 3
+
+// pathNode is a position in one side of the comparison, the node and the index used to resolve references in it.
+type pathNode struct {
+	node *yaml.Node
+	idx  *index.SpecIndex
+}
+
+// changePathWalker sets the Path and References of every change in a DocumentChanges tree. The changes tree is
+// walked alongside the original and modified documents, so sequence indexes and references can be resolved.
+// Changes to a component start their references with the component itself, e.g. '#/components/schemas/Pet'.
+type changePathWalker struct {
+	swagger bool
+}
+
+// applyChangePaths sets a JSON pointer (and the chain of references followed to get there) on every change found
+// when comparing two documents.
+func applyChangePaths(dc *DocumentChanges, l, r any) {
+	if dc == nil {
+		return
+	}
+	w := &changePathWalker{}
+	var lIdx, rIdx *index.SpecIndex
+	switch doc := l.(type) {
+	case *v2.Swagger:
+		w.swagger = true
+		lIdx = doc.Index
+	case *v3.Document:
+		lIdx = doc.Index
+	}
+	switch doc := r.(type) {
+	case *v2.Swagger:
+		rIdx = doc.Index
+	case *v3.Document:
+		rIdx = doc.Index
+	}
+	w.walk(reflect.ValueOf(dc), nil, nil, rootPathNode(lIdx), rootPathNode(rIdx))
+}
+
+func rootPathNode(idx *index.SpecIndex) pathNode {
+	if idx == nil {
+		return pathNode{}
+	}
+	root := idx.GetRootNode()
+	if root != nil && root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	return pathNode{node: root, idx: idx}
+}
+
+func (w *changePathWalker) walk(v reflect.Value, path, refs []string, l, r pathNode) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	// changes are looked for in the object as written first, then in whatever it references.
+	unresolvedL, unresolvedR := l, r
+	var ref string
+	l, _ = l.resolve()
+	r, ref = r.resolve()
+	innerRefs := refs
+	if ref != "" {
+		innerRefs = append(append([]string{}, refs...), ref)
+	}
+
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		field := v.Field(i)
+		if f.Anonymous {
+			if pc, ok := field.Interface().(*PropertyChanges); ok && pc != nil {
+				for _, c := range pc.Changes {
+					w.setPath(c, path, refs, innerRefs, unresolvedL, unresolvedR, l, r)
+				}
+			}
+			continue
+		}
+		segment := w.segment(t.Name(), f)
+		childPath := path
+		childL, childR := l, r
+		if segment != "" {
+			childPath = appendSegment(path, segment)
+			childL, childR = l.child(segment), r.child(segment)
+		}
+
+		switch {
+		case f.Type == changeSliceType:
+			for _, c := range field.Interface().([]*Change) {
+				w.setPath(c, childPath, innerRefs, innerRefs, childL, childR, childL, childR)
+			}
+		case f.Type.Kind() == reflect.Map:
+			for _, k := range field.MapKeys() {
+				key := k.String()
+				keyPath := appendSegment(childPath, key)
+				keyRefs := innerRefs
+				if t.Name() == "ComponentsChanges" {
+					// a component is reached through its own reference.
+					keyRefs = append(append([]string{}, innerRefs...), "#"+JSONPointer(keyPath))
+				}
+				w.walk(field.MapIndex(k), keyPath, keyRefs, childL.child(key), childR.child(key))
+			}
+		case f.Type.Kind() == reflect.Slice:
+			lSeq, _ := childL.resolve()
+			rSeq, _ := childR.resolve()
+			for n := 0; n < field.Len(); n++ {
+				elem := field.Index(n)
+				pos := sequenceIndex(elem, lSeq, rSeq, n)
+				w.walk(elem, appendSegment(childPath, strconv.Itoa(pos)), innerRefs, lSeq.item(pos), rSeq.item(pos))
+			}
+		case isChangesType(f.Type):
+			w.walk(field, childPath, innerRefs, childL, childR)
+		}
+	}
+}
+
+// segment returns the path segment for a field of a changes object.
+func (w *changePathWalker) segment(owner string, f reflect.StructField) string {
+	if w.swagger {
+		if s, ok := swaggerPathSegments[owner+"."+f.Name]; ok {
+			return s
+		}
+		if s, ok := swaggerPathSegments[f.Name]; ok {
+			return s
+		}
+	}
+	if owner == "ComponentsChanges" && f.Name == "SchemaChanges" {
+		return v3.SchemasLabel
+	}
+	if s, ok := changePathSegments[f.Name]; ok {
+		return s
+	}
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name
+}
+
+// setPath locates a change in the object that reported it, the object as written is checked before the object it
+// references (if any). When the change can't be located, the property name is used.
+func (w *changePathWalker) setPath(c *Change, path, refs, innerRefs []string, l, r, resolvedL, resolvedR pathNode) {
+	if c == nil {
+		return
+	}
+	removed := c.ChangeType == PropertyRemoved || c.ChangeType == ObjectRemoved
+	type candidate struct {
+		node pathNode
+		refs []string
+		left bool
+	}
+	candidates := []candidate{{r, refs, false}, {l, refs, true}, {resolvedR, innerRefs, false}, {resolvedL, innerRefs, true}}
+	if removed {
+		candidates[0], candidates[1] = candidates[1], candidates[0]
+		candidates[2], candidates[3] = candidates[3], candidates[2]
+	}
+	for _, cd := range candidates {
+		line, col := changePosition(c, cd.left)
+		if line == 0 || cd.node.node == nil || !cd.node.sameDocument(c) {
+			continue
+		}
+		if rel, ok := locateNode(cd.node.node, line, col, changeLocateDepth); ok {
+			c.Path = JSONPointer(append(append([]string{}, path...), rel...))
+			c.References = cd.refs
+			return
+		}
+	}
+	c.Path = JSONPointer(appendSegment(path, c.Property))
+	c.References = innerRefs
+}
+
+// changePosition returns the line and column of one side of a change, zero is returned if there is no position.
+func changePosition(c *Change, left bool) (int, int) {
+	if c.Context == nil {
+		return 0, 0
+	}
+	if left {
+		if c.Context.OriginalLine != nil && c.Context.OriginalColumn != nil {
+			return *c.Context.OriginalLine, *c.Context.OriginalColumn
+		}
+		return 0, 0
+	}
+	if c.Context.NewLine != nil && c.Context.NewColumn != nil {
+		return *c.Context.NewLine, *c.Context.NewColumn
+	}
+	return 0, 0
+}
+
+// sameDocument checks if a change was found in the same document as the node, positions are only comparable if so.
+func (p pathNode) sameDocument(c *Change) bool {
+	if c.Context == nil || c.Context.DocumentLocation == "" || p.idx == nil {
+		return true
+	}
+	return c.Context.DocumentLocation == p.idx.GetSpecAbsolutePath()
+}
+
+// resolve follows a reference (if the node is one), and returns the referenced node along with the reference.
+func (p pathNode) resolve() (pathNode, string) {
+	if p.node == nil || p.node.Kind != yaml.MappingNode || p.idx == nil {
+		return p, ""
+	}
+	for i := 0; i+1 < len(p.node.Content); i += 2 {
+		if p.node.Content[i].Value != v3.RefLabel {
+			continue
+		}
+		ref := p.node.Content[i+1].Value
+		found, idx := p.idx.SearchIndexForReference(ref)
+		if found == nil || found.Node == nil {
+			return pathNode{}, ref
+		}
+		if idx == nil {
+			idx = p.idx
+		}
+		return pathNode{node: found.Node, idx: idx}, ref
+	}
+	return p, ""
+}
+
+// child returns the value of a key, if the node is a map.
+func (p pathNode) child(key string) pathNode {
+	p, _ = p.resolve()
+	if p.node == nil || p.node.Kind != yaml.MappingNode {
+		return pathNode{}
+	}
+	for i := 0; i+1 < len(p.node.Content); i += 2 {
+		if p.node.Content[i].Value == key {
+			return pathNode{node: p.node.Content[i+1], idx: p.idx}
+		}
+	}
+	return pathNode{}
+}
+
+// item returns an item of a sequence.
+func (p pathNode) item(n int) pathNode {
+	if p.node == nil || p.node.Kind != yaml.SequenceNode || n < 0 || n >= len(p.node.Content) {
+		return pathNode{}
+	}
+	return pathNode{node: p.node.Content[n], idx: p.idx}
+}
+
+// sequenceIndex works out the position of a changes object in a sequence, by finding the item that holds its
+// changes. The modified document is checked first, if nothing can be found the position in the slice is used.
+func sequenceIndex(elem reflect.Value, l, r pathNode, fallback int) int {
+	ch, ok := elem.Interface().(interface{ GetAllChanges() []*Change })
+	if !ok || elem.IsNil() {
+		return fallback
+	}
+	all := ch.GetAllChanges()
+	for _, side := range []struct {
+		seq  pathNode
+		left bool
+	}{{r, false}, {l, true}} {
+		if side.seq.node == nil || side.seq.node.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, c := range all {
+			line, col := changePosition(c, side.left)
+			if line == 0 {
+				continue
+			}
+			for n := range side.seq.node.Content {
+				item, _ := side.seq.item(n).resolve()
+				if item.node == nil || !item.sameDocument(c) {
+					continue
+				}
+				if _, found := locateNode(item.node, line, col, -1); found {
+					return n
+				}
+			}
+		}
+	}
+	return fallback
+}
+
+// locateNode searches a node for a node at a line and column, and returns the path to it. A depth below zero searches
+// the whole tree.
+func locateNode(n *yaml.Node, line, col, depth int) ([]string, bool) {
+	if n == nil {
+		return nil, false
+	}
+	if n.Line == line && n.Column == col {
+		return nil, true
+	}
+	if depth == 0 {
+		return nil, false
+	}
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if (k.Line == line && k.Column == col) || (v.Line == line && v.Column == col) {
+				return []string{k.Value}, true
+			}
+			if rel, ok := locateNode(v, line, col, depth-1); ok {
+				return append([]string{k.Value}, rel...), true
+			}
+		}
+	case yaml.SequenceNode:
+		for i, v := range n.Content {
+			if rel, ok := locateNode(v, line, col, depth-1); ok {
+				return append([]string{strconv.Itoa(i)}, rel...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// JSONPointer builds an RFC 6901 JSON pointer from a list of unescaped segments.
+func JSONPointer(segments []string) string {
+	var b strings.Builder
+	for _, s := range segments {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+func appendSegment(path []string, segment string) []string {
+	if segment == "" {
+		return path
+	}
+	return append(append(make([]string, 0, len(path)+1), path...), segment)
+}
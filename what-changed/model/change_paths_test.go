@@ -0,0 +1,194 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func changesByPath(dc *DocumentChanges) map[string]*Change {
+	found := make(map[string]*Change)
+	for _, c := range dc.GetAllChanges() {
+		found[c.Path] = c
+	}
+	return found
+}
+
+func TestJSONPointer(t *testing.T) {
+	assert.Equal(t, "", JSONPointer(nil))
+	assert.Equal(t, "/paths/~1pets~1{id}/get", JSONPointer([]string{"paths", "/pets/{id}", "get"}))
+	assert.Equal(t, "/a~0b/0", JSONPointer([]string{"a~b", "0"}))
+}
+
+func TestCompareDocuments_ChangePaths(t *testing.T) {
+	left := `openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+        - $ref: '#/components/parameters/Offset'
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  name:
+                    type: string
+components:
+  parameters:
+    Offset:
+      name: offset
+      in: query
+      description: skip some
+  schemas:
+    Pet:
+      type: object
+      required: [name]`
+
+	right := `openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      parameters:
+        - $ref: '#/components/parameters/Offset'
+        - name: limit
+          in: query
+          description: how many
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  name:
+                    type: integer
+components:
+  parameters:
+    Offset:
+      name: offset
+      in: query
+      description: skip a few
+  schemas:
+    Pet:
+      type: object
+      required: [name, id]`
+
+	changes := compareDirectionDocs(t, left, right)
+	require.NotNil(t, changes)
+	found := changesByPath(changes)
+
+	c := found["/paths/~1pets/get/responses/200/content/application~1json/schema/properties/name/type"]
+	require.NotNil(t, c)
+	assert.Equal(t, "integer", c.New)
+	assert.Empty(t, c.References)
+
+	// indexes come from the modified document.
+	c = found["/paths/~1pets/get/parameters/1/description"]
+	require.NotNil(t, c)
+	assert.Equal(t, "how many", c.New)
+
+	// changes reached through a reference carry the chain.
+	c = found["/paths/~1pets/get/parameters/0/description"]
+	require.NotNil(t, c)
+	assert.Equal(t, []string{"#/components/parameters/Offset"}, c.References)
+
+	c = found["/components/schemas/Pet/required/1"]
+	require.NotNil(t, c)
+	assert.Equal(t, "id", c.New)
+
+	// changes to a component are reached through the component's own reference.
+	assert.Equal(t, []string{"#/components/schemas/Pet"}, c.References)
+
+	for _, ch := range changes.GetAllChanges() {
+		assert.NotEmpty(t, ch.Path)
+	}
+}
+
+func TestCompareDocuments_ChangePaths_ComponentReferences(t *testing.T) {
+	left := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      type: object
+      description: a person`
+
+	right := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      type: object
+      description: a kind person`
+
+	changes := compareDirectionDocs(t, left, right)
+	require.NotNil(t, changes)
+
+	all := changes.GetAllChanges()
+	require.Len(t, all, 1)
+	assert.Equal(t, "/components/schemas/Owner/description", all[0].Path)
+	assert.Equal(t, []string{"#/components/schemas/Owner"}, all[0].References)
+}
+
+func TestCompareDocuments_ChangePaths_Swagger(t *testing.T) {
+	left := `swagger: 2.0
+definitions:
+  Pet:
+    type: object
+    description: a pet`
+
+	right := `swagger: 2.0
+definitions:
+  Pet:
+    type: object
+    description: a good pet`
+
+	siLeft, _ := datamodel.ExtractSpecInfo([]byte(left))
+	siRight, _ := datamodel.ExtractSpecInfo([]byte(right))
+	lDoc, _ := v2.CreateDocumentFromConfig(siLeft, datamodel.NewDocumentConfiguration())
+	rDoc, _ := v2.CreateDocumentFromConfig(siRight, datamodel.NewDocumentConfiguration())
+
+	changes := CompareDocuments(lDoc, rDoc)
+	require.NotNil(t, changes)
+	all := changes.GetAllChanges()
+	require.Len(t, all, 1)
+	assert.Equal(t, "/definitions/Pet/description", all[0].Path)
+	assert.Equal(t, []string{"#/definitions/Pet"}, all[0].References)
+}
+
+func TestChange_MarshalJSON_Path(t *testing.T) {
+	c := &Change{
+		ChangeType: Modified,
+		Property:   "description",
+		Path:       "/paths/~1pets/get/parameters/0/description",
+		References: []string{"#/components/parameters/Offset"},
+	}
+	out, err := json.Marshal(c)
+	require.NoError(t, err)
+	var data map[string]any
+	require.NoError(t, json.Unmarshal(out, &data))
+	assert.Equal(t, c.Path, data["path"])
+	assert.Equal(t, []any{"#/components/parameters/Offset"}, data["references"])
+}
@@ -26,6 +26,9 @@ const (
 
 	// PropertyRemoved means that a property of an object was removed
 	PropertyRemoved
+
+	// ObjectRenamed means that an object was renamed or moved, Original holds the old key and New holds the new key
+	ObjectRenamed
 )
 
 // WhatChanged is a summary object that contains a high level summary of everything changed.
@@ -96,8 +99,14 @@ type Change struct {
 	// Type represents the type of object that was changed. (not used in the current implementation).
 	Type string `json:"type,omitempty"`
 
-	// Path represents the path to the object that was changed (not used in the current implementation).
+	// Path is a JSON pointer to the property that was changed, for example
+	// /paths/~1pets/get/responses/200/content/application~1json/schema/properties/name. Set by CompareDocuments,
+	// indexes are taken from the modified document (or the original document for removals).
 	Path string `json:"path,omitempty"`
+
+	// References is the chain of references ($ref values) followed to reach the change, outermost first. Changes
+	// found in a component (or a Swagger definition) start with the reference to that component.
+	References []string `json:"references,omitempty"`
 }
 
 // MarshalJSON is a custom JSON marshaller for the Change object.
@@ -114,6 +123,8 @@ func (c *Change) MarshalJSON() ([]byte, error) {
 		changeType = "object_removed"
 	case PropertyRemoved:
 		changeType = "property_removed"
+	case ObjectRenamed:
+		changeType = "object_renamed"
 	}
 	data := map[string]interface{}{
 		"change":     c.ChangeType,
@@ -139,6 +150,9 @@ func (c *Change) MarshalJSON() ([]byte, error) {
 	if c.Path != "" {
 		data["path"] = c.Path
 	}
+	if len(c.References) > 0 {
+		data["references"] = c.References
+	}
 	return json.Marshal(data)
 }
 
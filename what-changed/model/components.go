@@ -93,6 +93,7 @@ func CompareComponents(l, r any) *ComponentsChanges {
 			b = rDef.Schemas
 		}
 		cc.SchemaChanges = CheckMapForChanges(a, b, &changes, v2.DefinitionsLabel, CompareSchemas)
+		cc.SchemaChanges = compareRenamedSchemas(a, b, &changes, v2.DefinitionsLabel, cc.SchemaChanges)
 	}
 
 	// Swagger Security Definitions
@@ -180,6 +181,12 @@ func CompareComponents(l, r any) *ComponentsChanges {
 				&changes, v3.CallbacksLabel, CompareCallback, doneChan)
 		}
 
+		if !lComponents.MediaTypes.IsEmpty() || !rComponents.MediaTypes.IsEmpty() {
+			comparisons++
+			go runComparison(lComponents.MediaTypes.Value, rComponents.MediaTypes.Value,
+				&changes, v3.MediaTypesLabel, CompareMediaTypes, doneChan)
+		}
+
 		cc.ExtensionChanges = CompareExtensions(lComponents.Extensions, rComponents.Extensions)
 
 		completedComponents := 0
@@ -193,10 +200,12 @@ func CompareComponents(l, r any) *ComponentsChanges {
 				completedComponents++
 				cc.SecuritySchemeChanges = res.result.(map[string]*SecuritySchemeChanges)
 			case v3.ResponsesLabel, v3.ParametersLabel, v3.ExamplesLabel, v3.RequestBodiesLabel, v3.HeadersLabel,
-				v3.LinksLabel, v3.CallbacksLabel:
+				v3.LinksLabel, v3.CallbacksLabel, v3.MediaTypesLabel:
 				completedComponents++
 			}
 		}
+		cc.SchemaChanges = compareRenamedSchemas(lComponents.Schemas.Value, rComponents.Schemas.Value,
+			&changes, v3.SchemasLabel, cc.SchemaChanges)
 	}
 
 	cc.PropertyChanges = NewPropertyChanges(changes)
@@ -206,6 +215,23 @@ func CompareComponents(l, r any) *ComponentsChanges {
 	return cc
 }
 
+// compareRenamedSchemas detects schemas that were renamed, and compares each renamed schema with the original. Schema
+// names are not seen by a client, so renaming a schema is not breaking.
+func compareRenamedSchemas(l, r *orderedmap.Map[low.KeyReference[string], low.ValueReference[*base.SchemaProxy]],
+	changes *[]*Change, label string, schemaChanges map[string]*SchemaChanges,
+) map[string]*SchemaChanges {
+	renamed := detectRenames(l, r, changes, label, nil, func(_, _ string) bool { return false })
+	for _, m := range renamed {
+		if sc := CompareSchemas(m.l, m.r); sc != nil {
+			if schemaChanges == nil {
+				schemaChanges = make(map[string]*SchemaChanges)
+			}
+			schemaChanges[m.to] = sc
+		}
+	}
+	return schemaChanges
+}
+
 type componentComparison struct {
 	prop   string
 	result any
@@ -1072,3 +1072,41 @@ func TestCompareComponents_OpenAPI_Extensions_Modified(t *testing.T) {
 	assert.Equal(t, 1, extChanges.TotalChanges())
 	assert.Len(t, extChanges.GetAllChanges(), 1)
 }
+
+func TestCompareComponents_OpenAPI_MediaTypes_AddedRemoved(t *testing.T) {
+	left := `mediaTypes:
+  EventStream:
+    itemSchema:
+      type: string
+  JsonLines:
+    itemSchema:
+      type: object`
+
+	right := `mediaTypes:
+  EventStream:
+    itemSchema:
+      type: string
+  Multipart:
+    prefixEncoding:
+      - contentType: application/json`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.Components
+	var rDoc v3.Components
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), rNode.Content[0], nil)
+
+	// compare.
+	extChanges := CompareComponents(&lDoc, &rDoc)
+	assert.Equal(t, 2, extChanges.TotalChanges())
+	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
+	for _, c := range extChanges.Changes {
+		assert.Equal(t, v3.MediaTypesLabel, c.Property)
+	}
+}
@@ -0,0 +1,432 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// ChangeDirection represents the direction data described by a schema travels in, which determines if a change to
+// that schema is breaking for clients. Adding a required property is breaking for a request, but safe for a response.
+// Adding an enum value is safe for a request, but breaking for a response.
+type ChangeDirection int
+
+const (
+	// DirectionUnknown means the schema has no context, the decisions made by the comparison functions are kept.
+	DirectionUnknown ChangeDirection = 0
+
+	// DirectionRequest means the schema describes data sent by a client, for example a request body or a parameter.
+	DirectionRequest ChangeDirection = 1
+
+	// DirectionResponse means the schema describes data received by a client, for example a response or its headers.
+	DirectionResponse ChangeDirection = 2
+
+	// DirectionBoth means the schema is used by requests and responses, for example a shared component schema.
+	DirectionBoth = DirectionRequest | DirectionResponse
+
+	// directionIgnored means the schema is never seen in the direction it's used in, for example a readOnly property
+	// of a request body. No change to it can be breaking.
+	directionIgnored ChangeDirection = 4
+)
+
+// forSchema returns the direction for a schema, readOnly schemas are never sent in requests and writeOnly schemas
+// are never returned in responses. A schema is only considered readOnly or writeOnly if both sides agree, so
+// making a property readOnly is still judged in the context of a request.
+func (d ChangeDirection) forSchema(l, r *base.Schema) ChangeDirection {
+	if d == DirectionUnknown || d == directionIgnored || (l == nil && r == nil) {
+		return d
+	}
+	readOnly := (l == nil || l.ReadOnly.Value) && (r == nil || r.ReadOnly.Value)
+	writeOnly := (l == nil || l.WriteOnly.Value) && (r == nil || r.WriteOnly.Value)
+	if readOnly {
+		d &^= DirectionRequest
+	}
+	if writeOnly {
+		d &^= DirectionResponse
+	}
+	if d == DirectionUnknown {
+		return directionIgnored
+	}
+	return d
+}
+
+// ApplyChangeDirection walks a changes object (for example *RequestBodyChanges or *SchemaChanges) and re-evaluates
+// the Breaking flag of every schema change it contains in the context of the direction. Only decisions that depend on
+// direction are changed, everything else is left untouched.
+//
+// CompareRequestBodies, CompareParameters and CompareResponse apply their own direction, so this only needs to be
+// called when comparing schemas directly.
+func ApplyChangeDirection(changes any, direction ChangeDirection) {
+	if changes == nil || direction == DirectionUnknown {
+		return
+	}
+	applyChangeDirection(reflect.ValueOf(changes), direction)
+}
+
+var schemaChangesType = reflect.TypeOf(SchemaChanges{})
+
+func applyChangeDirection(v reflect.Value, direction ChangeDirection) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			applyChangeDirection(v.Elem(), direction)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			applyChangeDirection(v.Index(i), direction)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			applyChangeDirection(v.MapIndex(k), direction)
+		}
+	case reflect.Struct:
+		if v.Type() == schemaChangesType {
+			if v.CanAddr() {
+				applySchemaDirection(v.Addr().Interface().(*SchemaChanges), direction)
+			}
+			return
+		}
+		if _, ok := changesObjectTypes[v.Type()]; !ok {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.IsExported() && f.Type != changeSliceType && isChangesType(f.Type) {
+				applyChangeDirection(v.Field(i), direction)
+			}
+		}
+	}
+}
+
+// applySchemaDirection re-evaluates the changes of a schema (and every schema it contains) for a direction.
+func applySchemaDirection(sc *SchemaChanges, direction ChangeDirection) {
+	if sc == nil || direction == DirectionUnknown {
+		return
+	}
+	direction = direction.forSchema(sc.left, sc.right)
+	sc.direction = direction
+	if direction == directionIgnored {
+		for _, c := range sc.GetAllChanges() {
+			c.Breaking = false
+		}
+		return
+	}
+	if sc.PropertyChanges != nil {
+		for _, c := range sc.Changes {
+			c.Breaking = isBreakingForDirection(c, direction, sc)
+		}
+	}
+	for _, child := range []*SchemaChanges{
+		sc.NotChanges, sc.ItemsChanges, sc.AdditionalPropertiesChanges, sc.IfChanges, sc.ElseChanges,
+		sc.ThenChanges, sc.PropertyNamesChanges, sc.ContainsChanges, sc.UnevaluatedItemsChanges,
+		sc.UnevaluatedPropertiesChanges,
+	} {
+		applySchemaDirection(child, direction)
+	}
+	for _, children := range [][]*SchemaChanges{sc.AllOfChanges, sc.AnyOfChanges, sc.OneOfChanges, sc.PrefixItemsChanges} {
+		for _, child := range children {
+			applySchemaDirection(child, direction)
+		}
+	}
+	for _, children := range []map[string]*SchemaChanges{
+		sc.SchemaPropertyChanges, sc.DependentSchemasChanges, sc.PatternPropertiesChanges, sc.DefsChanges,
+	} {
+		for _, child := range children {
+			applySchemaDirection(child, direction)
+		}
+	}
+}
+
+// isBreakingForDirection decides if a schema change is breaking for a direction. Constraints that are tightened
+// break requests, constraints that are loosened break responses.
+func isBreakingForDirection(c *Change, direction ChangeDirection, sc *SchemaChanges) bool {
+	requests := direction&DirectionRequest != 0
+	responses := direction&DirectionResponse != 0
+	added := c.ChangeType == PropertyAdded || c.ChangeType == ObjectAdded
+	removed := c.ChangeType == PropertyRemoved || c.ChangeType == ObjectRemoved
+
+	switch c.Property {
+	case v3.RequiredLabel:
+		// a new required property must be sent by clients, a property no longer required may be missing.
+		if added {
+			return direction.forSchema(propertySchema(sc.right, c.New), nil)&DirectionRequest != 0
+		}
+		if removed {
+			return direction.forSchema(propertySchema(sc.left, c.Original), nil)&DirectionResponse != 0
+		}
+	case v3.EnumLabel:
+		// a new value widens an enum, unless there was no enum before, then the enum itself narrows the values
+		// allowed. Removing the last values of an enum drops the enum, which widens the values allowed.
+		if added {
+			if withoutEnum(sc.left) {
+				return requests
+			}
+			return responses
+		}
+		if removed {
+			if withoutEnum(sc.right) {
+				return responses
+			}
+			return requests
+		}
+	case v3.PropertiesLabel:
+		// a removed property only matters if it was seen in this direction.
+		if removed {
+			if sp, ok := c.OriginalObject.(*base.SchemaProxy); ok && sp != nil {
+				return direction.forSchema(sp.Schema(), nil) != directionIgnored
+			}
+		}
+	case v3.MaximumLabel, v3.MaxLengthLabel, v3.MaxItemsLabel, v3.MaxPropertiesLabel:
+		if tightened, ok := constraintTightened(c, false); ok {
+			return (tightened && requests) || (!tightened && responses)
+		}
+	case v3.MinimumLabel, v3.MinLengthLabel, v3.MinItemsLabel, v3.MinPropertiesLabel:
+		if tightened, ok := constraintTightened(c, true); ok {
+			return (tightened && requests) || (!tightened && responses)
+		}
+	}
+	return c.Breaking
+}
+
+// constraintTightened determines if a change to a minimum or maximum constraint narrows the values allowed. Adding a
+// constraint tightens it, removing one loosens it. False is returned for ok, if the values can't be compared.
+func constraintTightened(c *Change, minimum bool) (tightened bool, ok bool) {
+	switch c.ChangeType {
+	case PropertyAdded, ObjectAdded:
+		return true, true
+	case PropertyRemoved, ObjectRemoved:
+		return false, true
+	}
+	o, oErr := strconv.ParseFloat(strings.TrimSpace(c.Original), 64)
+	n, nErr := strconv.ParseFloat(strings.TrimSpace(c.New), 64)
+	if oErr != nil || nErr != nil || o == n {
+		return false, false
+	}
+	if minimum {
+		return n > o, true
+	}
+	return n < o, true
+}
+
+// propertySchema returns the schema of a named property, nil is returned if it does not exist.
+// withoutEnum returns true for a schema known to have no enum.
+func withoutEnum(s *base.Schema) bool {
+	return s != nil && len(s.Enum.Value) == 0
+}
+
+func propertySchema(s *base.Schema, name string) *base.Schema {
+	if s == nil || s.Properties.Value == nil {
+		return nil
+	}
+	for k, v := range s.Properties.Value.FromOldest() {
+		if k.Value == name && v.Value != nil {
+			return v.Value.Schema()
+		}
+	}
+	return nil
+}
+
+// componentSchemaDirections works out which direction every component schema (or Swagger definition) is used in,
+// by following references from the request bodies, parameters and responses of every operation.
+func componentSchemaDirections(docs ...any) map[string]ChangeDirection {
+	d := &directionWalker{
+		directions: make(map[string]ChangeDirection),
+		seen:       make(map[*base.SchemaProxy]ChangeDirection),
+	}
+	for _, doc := range docs {
+		switch doc := doc.(type) {
+		case *v3.Document:
+			if doc.Paths.Value != nil {
+				d.walkPathItemsV3(doc.Paths.Value.PathItems)
+			}
+			d.walkPathItemsV3(doc.Webhooks.Value)
+		case *v2.Swagger:
+			if doc.Paths.Value != nil {
+				for _, pi := range doc.Paths.Value.PathItems.FromOldest() {
+					d.walkPathItemV2(pi.Value)
+				}
+			}
+		}
+	}
+	return d.directions
+}
+
+type directionWalker struct {
+	directions map[string]ChangeDirection
+	seen       map[*base.SchemaProxy]ChangeDirection
+}
+
+func (d *directionWalker) walkPathItemsV3(items *orderedmap.Map[low.KeyReference[string], low.ValueReference[*v3.PathItem]]) {
+	for _, pi := range items.FromOldest() {
+		p := pi.Value
+		if p == nil {
+			continue
+		}
+		for _, param := range p.Parameters.Value {
+			d.walkParameterV3(param.Value)
+		}
+		ops := []*v3.Operation{
+			p.Get.Value, p.Put.Value, p.Post.Value, p.Delete.Value, p.Options.Value,
+			p.Head.Value, p.Patch.Value, p.Trace.Value, p.Query.Value,
+		}
+		for _, op := range p.AdditionalOperations.Value.FromOldest() {
+			ops = append(ops, op.Value)
+		}
+		for _, op := range ops {
+			d.walkOperationV3(op)
+		}
+	}
+}
+
+func (d *directionWalker) walkOperationV3(op *v3.Operation) {
+	if op == nil {
+		return
+	}
+	for _, param := range op.Parameters.Value {
+		d.walkParameterV3(param.Value)
+	}
+	if op.RequestBody.Value != nil {
+		d.walkContent(op.RequestBody.Value.Content.Value, DirectionRequest)
+	}
+	if responses := op.Responses.Value; responses != nil {
+		d.walkResponseV3(responses.Default.Value)
+		for _, resp := range responses.Codes.FromOldest() {
+			d.walkResponseV3(resp.Value)
+		}
+	}
+	for _, cb := range op.Callbacks.Value.FromOldest() {
+		if cb.Value != nil {
+			d.walkPathItemsV3(cb.Value.Expression)
+		}
+	}
+}
+
+func (d *directionWalker) walkParameterV3(param *v3.Parameter) {
+	if param == nil {
+		return
+	}
+	d.walkSchema(param.Schema.Value, DirectionRequest)
+	d.walkContent(param.Content.Value, DirectionRequest)
+}
+
+func (d *directionWalker) walkResponseV3(resp *v3.Response) {
+	if resp == nil {
+		return
+	}
+	for _, h := range resp.Headers.Value.FromOldest() {
+		if h.Value != nil {
+			d.walkSchema(h.Value.Schema.Value, DirectionResponse)
+			d.walkContent(h.Value.Content.Value, DirectionResponse)
+		}
+	}
+	d.walkContent(resp.Content.Value, DirectionResponse)
+}
+
+func (d *directionWalker) walkContent(content *orderedmap.Map[low.KeyReference[string], low.ValueReference[*v3.MediaType]],
+	direction ChangeDirection,
+) {
+	for _, mt := range content.FromOldest() {
+		if mt.Value != nil {
+			d.walkSchema(mt.Value.Schema.Value, direction)
+			d.walkSchema(mt.Value.ItemSchema.Value, direction)
+		}
+	}
+}
+
+func (d *directionWalker) walkPathItemV2(p *v2.PathItem) {
+	if p == nil {
+		return
+	}
+	for _, param := range p.Parameters.Value {
+		if param.Value != nil {
+			d.walkSchema(param.Value.Schema.Value, DirectionRequest)
+		}
+	}
+	for _, op := range []*v2.Operation{
+		p.Get.Value, p.Put.Value, p.Post.Value, p.Delete.Value, p.Options.Value, p.Head.Value, p.Patch.Value,
+	} {
+		if op == nil {
+			continue
+		}
+		for _, param := range op.Parameters.Value {
+			if param.Value != nil {
+				d.walkSchema(param.Value.Schema.Value, DirectionRequest)
+			}
+		}
+		if responses := op.Responses.Value; responses != nil {
+			if responses.Default.Value != nil {
+				d.walkSchema(responses.Default.Value.Schema.Value, DirectionResponse)
+			}
+			for _, resp := range responses.Codes.FromOldest() {
+				if resp.Value != nil {
+					d.walkSchema(resp.Value.Schema.Value, DirectionResponse)
+				}
+			}
+		}
+	}
+}
+
+// walkSchema records the direction of every component schema referenced by a schema, references are followed
+// until a component has already been seen in the direction.
+func (d *directionWalker) walkSchema(sp *base.SchemaProxy, direction ChangeDirection) {
+	if sp == nil || d.seen[sp]&direction == direction {
+		return
+	}
+	d.seen[sp] |= direction
+	if sp.IsReference() {
+		ref := sp.GetReference()
+		name := ""
+		for _, prefix := range []string{"#/components/schemas/", "#/definitions/"} {
+			if strings.HasPrefix(ref, prefix) {
+				name = strings.TrimPrefix(ref, prefix)
+			}
+		}
+		if name != "" {
+			if d.directions[name]&direction == direction {
+				return
+			}
+			d.directions[name] |= direction
+		}
+	}
+	s := sp.Schema()
+	direction = direction.forSchema(s, nil)
+	if s == nil || direction == directionIgnored {
+		return
+	}
+	for _, child := range []*base.SchemaProxy{
+		s.Not.Value, s.If.Value, s.Else.Value, s.Then.Value, s.Contains.Value, s.PropertyNames.Value,
+		s.UnevaluatedItems.Value,
+	} {
+		d.walkSchema(child, direction)
+	}
+	for _, dv := range []*base.SchemaDynamicValue[*base.SchemaProxy, bool]{
+		s.Items.Value, s.AdditionalProperties.Value, s.UnevaluatedProperties.Value,
+	} {
+		if dv != nil && dv.IsA() {
+			d.walkSchema(dv.A, direction)
+		}
+	}
+	for _, children := range [][]low.ValueReference[*base.SchemaProxy]{
+		s.AllOf.Value, s.AnyOf.Value, s.OneOf.Value, s.PrefixItems.Value,
+	} {
+		for _, child := range children {
+			d.walkSchema(child.Value, direction)
+		}
+	}
+	for _, children := range []*orderedmap.Map[low.KeyReference[string], low.ValueReference[*base.SchemaProxy]]{
+		s.Properties.Value, s.PatternProperties.Value, s.DependentSchemas.Value,
+	} {
+		for _, child := range children.FromOldest() {
+			d.walkSchema(child.Value, direction)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// directionDocs builds two documents that use the supplied schemas for a request body and a response.
+func directionDocs(request, response string) string {
+	tmpl := `openapi: 3.1.0
+paths:
+  /burgers:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+%s
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+%s`
+	return fmt.Sprintf(tmpl, indent(request, 14), indent(response, 16))
+}
+
+func indent(s string, n int) string {
+	pad := strings.Repeat(" ", n)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+}
+
+func compareDirectionDocs(t *testing.T, left, right string) *DocumentChanges {
+	t.Helper()
+	return compareDocsWithOptions(t, left, right, nil)
+}
+
+func compareDocsWithOptions(t *testing.T, left, right string, options *CompareOptions) *DocumentChanges {
+	t.Helper()
+	low.ClearHashCache()
+	siLeft, err := datamodel.ExtractSpecInfo([]byte(left))
+	require.NoError(t, err)
+	siRight, err := datamodel.ExtractSpecInfo([]byte(right))
+	require.NoError(t, err)
+	lDoc, _ := v3.CreateDocumentFromConfig(siLeft, datamodel.NewDocumentConfiguration())
+	rDoc, _ := v3.CreateDocumentFromConfig(siRight, datamodel.NewDocumentConfiguration())
+	return CompareDocumentsWithOptions(lDoc, rDoc, options)
+}
+
+func compareDirections(t *testing.T, lRequest, lResponse, rRequest, rResponse string) *DocumentChanges {
+	t.Helper()
+	return compareDirectionDocs(t, directionDocs(lRequest, lResponse), directionDocs(rRequest, rResponse))
+}
+
+const directionSchema = `type: object
+properties:
+  name:
+    type: string
+  id:
+    type: string
+    readOnly: true`
+
+func TestChangeDirection_RequiredAdded(t *testing.T) {
+	// a new required property breaks requests, responses now always include it.
+	changes := compareDirections(t, directionSchema, directionSchema,
+		directionSchema+"\nrequired: [name]", directionSchema+"\nrequired: [name]")
+	assert.Equal(t, 2, changes.TotalChanges())
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+
+	// a read only property is never sent by clients, so requiring it can't break requests.
+	changes = compareDirections(t, directionSchema, directionSchema,
+		directionSchema+"\nrequired: [id]", directionSchema)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+}
+
+func TestChangeDirection_RequiredRemoved(t *testing.T) {
+	// a property no longer required may be missing from responses, requests are fine.
+	changes := compareDirections(t, directionSchema+"\nrequired: [name]", directionSchema+"\nrequired: [name]",
+		directionSchema, directionSchema)
+	assert.Equal(t, 2, changes.TotalChanges())
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+}
+
+func TestChangeDirection_Enum(t *testing.T) {
+	left := "type: string\nenum: [cheese, bacon]"
+	added := "type: string\nenum: [cheese, bacon, pickles]"
+	removed := "type: string\nenum: [cheese]"
+
+	// clients may receive a value they don't know about.
+	changes := compareDirections(t, left, left, left, added)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+
+	// clients may send more values than before.
+	changes = compareDirections(t, left, left, added, left)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	// clients may send a value that is no longer accepted.
+	changes = compareDirections(t, left, left, removed, left)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+
+	// clients receive fewer values than before.
+	changes = compareDirections(t, left, left, left, removed)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+}
+
+func TestChangeDirection_EnumKeyword(t *testing.T) {
+	left := "type: string"
+	enum := "type: string\nenum: [cheese, bacon]"
+
+	// a new enum narrows the values clients may send.
+	changes := compareDirections(t, left, left, enum, left)
+	assert.Equal(t, 2, changes.TotalChanges())
+	assert.Equal(t, 2, changes.TotalBreakingChanges())
+
+	// clients receive fewer values than before.
+	changes = compareDirections(t, left, left, left, enum)
+	assert.Equal(t, 2, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	// without the enum, clients may send anything.
+	changes = compareDirections(t, enum, enum, left, enum)
+	assert.Equal(t, 2, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	// clients may receive values they don't know about.
+	changes = compareDirections(t, enum, enum, enum, left)
+	assert.Equal(t, 2, changes.TotalChanges())
+	assert.Equal(t, 2, changes.TotalBreakingChanges())
+}
+
+func TestChangeDirection_Constraints(t *testing.T) {
+	left := "type: string\nmaxLength: 10"
+	tighter := "type: string\nmaxLength: 5"
+	looser := "type: string\nmaxLength: 20"
+
+	changes := compareDirections(t, left, left, tighter, left)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+
+	changes = compareDirections(t, left, left, looser, left)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	changes = compareDirections(t, left, left, left, looser)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 1, changes.TotalBreakingChanges())
+
+	changes = compareDirections(t, left, left, left, tighter)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+
+	// removing a minimum loosens it.
+	changes = compareDirections(t, "type: integer\nminimum: 1", left, "type: integer", left)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+}
+
+func TestChangeDirection_ComponentSchemas(t *testing.T) {
+	tmpl := `openapi: 3.1.0
+paths:
+  /burgers:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Order'
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Burger'
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        burger:
+          $ref: '#/components/schemas/Burger'
+        notes:
+          type: string%s
+    Burger:
+      type: object
+      properties:
+        name:
+          type: string%s
+    Unused:
+      type: object
+      properties:
+        name:
+          type: string%s`
+
+	left := fmt.Sprintf(tmpl, "", "", "")
+	right := fmt.Sprintf(tmpl, "\n      required: [notes]", "\n      required: [name]", "\n      required: [name]")
+
+	siLeft, _ := datamodel.ExtractSpecInfo([]byte(left))
+	lDoc, _ := v3.CreateDocumentFromConfig(siLeft, datamodel.NewDocumentConfiguration())
+	directions := componentSchemaDirections(lDoc)
+	assert.Equal(t, DirectionRequest, directions["Order"])
+	assert.Equal(t, DirectionBoth, directions["Burger"])
+	assert.Equal(t, DirectionUnknown, directions["Unused"])
+
+	changes := compareDirectionDocs(t, left, right)
+	require.NotNil(t, changes.ComponentsChanges)
+	schemas := changes.ComponentsChanges.SchemaChanges
+	assert.Equal(t, 1, schemas["Order"].TotalBreakingChanges())
+	assert.Equal(t, 1, schemas["Burger"].TotalBreakingChanges())
+
+	// without any context, the decision made by the comparison is kept.
+	assert.Equal(t, 1, schemas["Unused"].TotalBreakingChanges())
+}
+
+func TestChangeDirection_WriteOnlyResponse(t *testing.T) {
+	left := `type: object
+properties:
+  password:
+    type: string
+    writeOnly: true
+    enum: [a, b]`
+	right := `type: object
+properties:
+  password:
+    type: string
+    writeOnly: true
+    enum: [a, b, c]`
+
+	// write only properties are never received by clients.
+	changes := compareDirections(t, left, left, left, right)
+	assert.Equal(t, 1, changes.TotalChanges())
+	assert.Equal(t, 0, changes.TotalBreakingChanges())
+}
+
+func TestApplyChangeDirection_Unknown(t *testing.T) {
+	sc := &SchemaChanges{
+		PropertyChanges: NewPropertyChanges([]*Change{
+			{Property: v3.EnumLabel, ChangeType: PropertyAdded, Breaking: true},
+		}),
+	}
+	ApplyChangeDirection(sc, DirectionUnknown)
+	ApplyChangeDirection(nil, DirectionRequest)
+	assert.True(t, sc.Changes[0].Breaking)
+
+	ApplyChangeDirection(sc, DirectionRequest)
+	assert.False(t, sc.Changes[0].Breaking)
+}
@@ -142,9 +142,23 @@ func (d *DocumentChanges) TotalBreakingChanges() int {
 	return c
 }
 
+// CompareOptions are the options used by CompareDocumentsWithOptions.
+type CompareOptions struct {
+	// BreakingRules determine the Breaking flag of every change with a matching rule, changes without a matching rule
+	// keep the decision made by the comparison functions. Nil uses the decisions of the comparison functions, which
+	// are the same as DefaultBreakingRules.
+	BreakingRules *BreakingRules
+}
+
 // CompareDocuments will compare any two OpenAPI documents (either Swagger or OpenAPI) and return a pointer to
 // DocumentChanges that outlines everything that was found to have changed.
 func CompareDocuments(l, r any) *DocumentChanges {
+	return CompareDocumentsWithOptions(l, r, nil)
+}
+
+// CompareDocumentsWithOptions compares two OpenAPI documents (either Swagger or OpenAPI) like CompareDocuments, using
+// the supplied options. Nil options are the same as CompareDocuments.
+func CompareDocumentsWithOptions(l, r any, options *CompareOptions) *DocumentChanges {
 	var changes []*Change
 	var props []*PropertyCheck
 
@@ -215,6 +229,7 @@ func CompareDocuments(l, r any) *DocumentChanges {
 		cc.PropertyChanges = new(PropertyChanges)
 		if n := CompareComponents(lDoc.Definitions.Value, rDoc.Definitions.Value); n != nil {
 			cc.SchemaChanges = n.SchemaChanges
+			cc.Changes = append(cc.Changes, n.Changes...)
 		}
 		if n := CompareComponents(lDoc.SecurityDefinitions.Value, rDoc.SecurityDefinitions.Value); n != nil {
 			cc.SecuritySchemeChanges = n.SecuritySchemeChanges
@@ -239,6 +254,10 @@ func CompareDocuments(l, r any) *DocumentChanges {
 		addPropertyCheck(&props, lDoc.Version.ValueNode, rDoc.Version.ValueNode,
 			lDoc.Version.Value, rDoc.Version.Value, &changes, v3.OpenAPILabel, true)
 
+		// self (3.2), relative references are resolved against it.
+		addPropertyCheck(&props, lDoc.Self.ValueNode, rDoc.Self.ValueNode,
+			lDoc.Self.Value, rDoc.Self.Value, &changes, v3.SelfLabel, true)
+
 		// schema dialect
 		addPropertyCheck(&props, lDoc.JsonSchemaDialect.ValueNode, rDoc.JsonSchemaDialect.ValueNode,
 			lDoc.JsonSchemaDialect.Value, rDoc.JsonSchemaDialect.Value, &changes, v3.JSONSchemaDialectLabel, true)
@@ -296,6 +315,22 @@ func CompareDocuments(l, r any) *DocumentChanges {
 		return nil
 	}
 	base.SchemaQuickHashMap.Clear()
+
+	// component schemas are judged in the direction(s) they are used in by operations.
+	if dc.ComponentsChanges != nil && len(dc.ComponentsChanges.SchemaChanges) > 0 {
+		directions := componentSchemaDirections(l, r)
+		for name, sc := range dc.ComponentsChanges.SchemaChanges {
+			applySchemaDirection(sc, directions[name])
+		}
+	}
+
+	// every change gets a JSON pointer to where it was found.
+	applyChangePaths(dc, l, r)
+
+	// apply the breaking rules (if set), so policy overrides are reflected in the result.
+	if options != nil {
+		ApplyBreakingRules(dc, options.BreakingRules)
+	}
 	return dc
 }
 
@@ -1255,3 +1255,25 @@ func TestDocumentChanges_Nil(t *testing.T) {
 	assert.Equal(t, 0, dc.TotalBreakingChanges())
 	assert.Nil(t, dc.GetAllChanges())
 }
+
+func TestCompareDocuments_OpenAPI_Self_Modified(t *testing.T) {
+	low.ClearHashCache()
+	left := `openapi: 3.2.0
+$self: https://pb33f.io/openapi.yaml`
+
+	right := `openapi: 3.2.0
+$self: https://pb33f.io/v2/openapi.yaml`
+
+	siLeft, _ := datamodel.ExtractSpecInfo([]byte(left))
+	siRight, _ := datamodel.ExtractSpecInfo([]byte(right))
+
+	lDoc, _ := v3.CreateDocumentFromConfig(siLeft, datamodel.NewDocumentConfiguration())
+	rDoc, _ := v3.CreateDocumentFromConfig(siRight, datamodel.NewDocumentConfiguration())
+
+	// compare.
+	extChanges := CompareDocuments(lDoc, rDoc)
+	assert.Equal(t, 1, extChanges.TotalChanges())
+	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
+	assert.Equal(t, v3.SelfLabel, extChanges.Changes[0].Property)
+	assert.Equal(t, "https://pb33f.io/v2/openapi.yaml", extChanges.Changes[0].New)
+}
@@ -12,10 +12,13 @@ import (
 // MediaTypeChanges represent changes made between two OpenAPI MediaType instances.
 type MediaTypeChanges struct {
 	*PropertyChanges
-	SchemaChanges    *SchemaChanges              `json:"schemas,omitempty" yaml:"schemas,omitempty"`
-	ExtensionChanges *ExtensionChanges           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
-	ExampleChanges   map[string]*ExampleChanges  `json:"examples,omitempty" yaml:"examples,omitempty"`
-	EncodingChanges  map[string]*EncodingChanges `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+	SchemaChanges         *SchemaChanges              `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+	ItemSchemaChanges     *SchemaChanges              `json:"itemSchema,omitempty" yaml:"itemSchema,omitempty"`
+	ExtensionChanges      *ExtensionChanges           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+	ExampleChanges        map[string]*ExampleChanges  `json:"examples,omitempty" yaml:"examples,omitempty"`
+	EncodingChanges       map[string]*EncodingChanges `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+	PrefixEncodingChanges []*EncodingChanges          `json:"prefixEncoding,omitempty" yaml:"prefixEncoding,omitempty"`
+	ItemEncodingChanges   *EncodingChanges            `json:"itemEncoding,omitempty" yaml:"itemEncoding,omitempty"`
 }
 
 // GetAllChanges returns a slice of all changes made between MediaType objects
@@ -28,12 +31,21 @@ func (m *MediaTypeChanges) GetAllChanges() []*Change {
 	if m.SchemaChanges != nil {
 		changes = append(changes, m.SchemaChanges.GetAllChanges()...)
 	}
+	if m.ItemSchemaChanges != nil {
+		changes = append(changes, m.ItemSchemaChanges.GetAllChanges()...)
+	}
 	for k := range m.ExampleChanges {
 		changes = append(changes, m.ExampleChanges[k].GetAllChanges()...)
 	}
 	for k := range m.EncodingChanges {
 		changes = append(changes, m.EncodingChanges[k].GetAllChanges()...)
 	}
+	for k := range m.PrefixEncodingChanges {
+		changes = append(changes, m.PrefixEncodingChanges[k].GetAllChanges()...)
+	}
+	if m.ItemEncodingChanges != nil {
+		changes = append(changes, m.ItemEncodingChanges.GetAllChanges()...)
+	}
 	if m.ExtensionChanges != nil {
 		changes = append(changes, m.ExtensionChanges.GetAllChanges()...)
 	}
@@ -52,11 +64,20 @@ func (m *MediaTypeChanges) TotalChanges() int {
 	if m.SchemaChanges != nil {
 		c += m.SchemaChanges.TotalChanges()
 	}
+	if m.ItemSchemaChanges != nil {
+		c += m.ItemSchemaChanges.TotalChanges()
+	}
 	if len(m.EncodingChanges) > 0 {
 		for i := range m.EncodingChanges {
 			c += m.EncodingChanges[i].TotalChanges()
 		}
 	}
+	for i := range m.PrefixEncodingChanges {
+		c += m.PrefixEncodingChanges[i].TotalChanges()
+	}
+	if m.ItemEncodingChanges != nil {
+		c += m.ItemEncodingChanges.TotalChanges()
+	}
 	if m.ExtensionChanges != nil {
 		c += m.ExtensionChanges.TotalChanges()
 	}
@@ -72,11 +93,20 @@ func (m *MediaTypeChanges) TotalBreakingChanges() int {
 	if m.SchemaChanges != nil {
 		c += m.SchemaChanges.TotalBreakingChanges()
 	}
+	if m.ItemSchemaChanges != nil {
+		c += m.ItemSchemaChanges.TotalBreakingChanges()
+	}
 	if len(m.EncodingChanges) > 0 {
 		for i := range m.EncodingChanges {
 			c += m.EncodingChanges[i].TotalBreakingChanges()
 		}
 	}
+	for i := range m.PrefixEncodingChanges {
+		c += m.PrefixEncodingChanges[i].TotalBreakingChanges()
+	}
+	if m.ItemEncodingChanges != nil {
+		c += m.ItemEncodingChanges.TotalBreakingChanges()
+	}
 	return c
 }
 
@@ -139,6 +169,19 @@ func CompareMediaTypes(l, r *v3.MediaType) *MediaTypeChanges {
 			r.Schema.ValueNode, true, nil, r.Schema.Value)
 	}
 
+	// item schema (3.2+)
+	if !l.ItemSchema.IsEmpty() && !r.ItemSchema.IsEmpty() {
+		mc.ItemSchemaChanges = CompareSchemas(l.ItemSchema.Value, r.ItemSchema.Value)
+	}
+	if !l.ItemSchema.IsEmpty() && r.ItemSchema.IsEmpty() {
+		CreateChange(&changes, ObjectRemoved, v3.ItemSchemaLabel, l.ItemSchema.ValueNode,
+			nil, true, l.ItemSchema.Value, nil)
+	}
+	if l.ItemSchema.IsEmpty() && !r.ItemSchema.IsEmpty() {
+		CreateChange(&changes, ObjectAdded, v3.ItemSchemaLabel, nil,
+			r.ItemSchema.ValueNode, true, nil, r.ItemSchema.Value)
+	}
+
 	// examples
 	mc.ExampleChanges = CheckMapForChanges(l.Examples.Value, r.Examples.Value,
 		&changes, v3.ExamplesLabel, CompareExamples)
@@ -147,7 +190,48 @@ func CompareMediaTypes(l, r *v3.MediaType) *MediaTypeChanges {
 	mc.EncodingChanges = CheckMapForChanges(l.Encoding.Value, r.Encoding.Value,
 		&changes, v3.EncodingLabel, CompareEncoding)
 
+	// prefix encoding (3.2+)
+	mc.PrefixEncodingChanges = checkPrefixEncoding(l.PrefixEncoding.Value, r.PrefixEncoding.Value, &changes)
+
+	// item encoding (3.2+)
+	if !l.ItemEncoding.IsEmpty() && !r.ItemEncoding.IsEmpty() {
+		if !low.AreEqual(l.ItemEncoding.Value, r.ItemEncoding.Value) {
+			mc.ItemEncodingChanges = CompareEncoding(l.ItemEncoding.Value, r.ItemEncoding.Value)
+		}
+	}
+	if !l.ItemEncoding.IsEmpty() && r.ItemEncoding.IsEmpty() {
+		CreateChange(&changes, ObjectRemoved, v3.ItemEncodingLabel, l.ItemEncoding.ValueNode,
+			nil, true, l.ItemEncoding.Value, nil)
+	}
+	if l.ItemEncoding.IsEmpty() && !r.ItemEncoding.IsEmpty() {
+		CreateChange(&changes, ObjectAdded, v3.ItemEncodingLabel, nil,
+			r.ItemEncoding.ValueNode, false, nil, r.ItemEncoding.Value)
+	}
+
 	mc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
 	mc.PropertyChanges = NewPropertyChanges(changes)
 	return mc
 }
+
+// checkPrefixEncoding compares prefixEncoding entries by position, each entry describes the encoding of the
+// part at the same position, so a reordering is treated as a modification.
+func checkPrefixEncoding(l, r []low.ValueReference[*v3.Encoding], changes *[]*Change) []*EncodingChanges {
+	var ec []*EncodingChanges
+	for i := range l {
+		if i >= len(r) {
+			CreateChange(changes, ObjectRemoved, v3.PrefixEncodingLabel,
+				l[i].ValueNode, nil, true, l[i].Value, nil)
+			continue
+		}
+		if !low.AreEqual(l[i].Value, r[i].Value) {
+			if c := CompareEncoding(l[i].Value, r[i].Value); c != nil {
+				ec = append(ec, c)
+			}
+		}
+	}
+	for i := len(l); i < len(r); i++ {
+		CreateChange(changes, ObjectAdded, v3.PrefixEncodingLabel,
+			nil, r[i].ValueNode, false, nil, r[i].Value)
+	}
+	return ec
+}
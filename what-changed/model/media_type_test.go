@@ -314,3 +314,91 @@ x-tea: cup`
 	assert.Len(t, extChanges.GetAllChanges(), 5)
 	assert.Equal(t, 2, extChanges.TotalBreakingChanges())
 }
+
+func TestCompareMediaTypes_Streaming(t *testing.T) {
+	low.ClearHashCache()
+
+	left := `itemSchema:
+  type: string
+prefixEncoding:
+  - contentType: application/json
+  - contentType: image/png
+itemEncoding:
+  contentType: text/plain`
+
+	right := `itemSchema:
+  type: integer
+prefixEncoding:
+  - contentType: application/xml
+itemEncoding:
+  contentType: text/csv`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.MediaType
+	var rDoc v3.MediaType
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// compare.
+	extChanges := CompareMediaTypes(&lDoc, &rDoc)
+	assert.NotNil(t, extChanges)
+	assert.Equal(t, 4, extChanges.TotalChanges())
+	assert.Len(t, extChanges.GetAllChanges(), 4)
+	assert.Equal(t, 4, extChanges.TotalBreakingChanges())
+	assert.Equal(t, v3.TypeLabel, extChanges.ItemSchemaChanges.Changes[0].Property)
+	assert.Len(t, extChanges.PrefixEncodingChanges, 1)
+	assert.Equal(t, v3.ContentTypeLabel, extChanges.PrefixEncodingChanges[0].Changes[0].Property)
+	assert.Equal(t, "text/csv", extChanges.ItemEncodingChanges.Changes[0].New)
+	assert.Equal(t, ObjectRemoved, extChanges.Changes[0].ChangeType)
+	assert.Equal(t, v3.PrefixEncodingLabel, extChanges.Changes[0].Property)
+}
+
+func TestCompareMediaTypes_Streaming_AddRemove(t *testing.T) {
+	low.ClearHashCache()
+
+	left := `schema:
+  type: string`
+
+	right := `schema:
+  type: string
+itemSchema:
+  type: string
+prefixEncoding:
+  - contentType: application/json
+itemEncoding:
+  contentType: text/plain`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.MediaType
+	var rDoc v3.MediaType
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// added
+	extChanges := CompareMediaTypes(&lDoc, &rDoc)
+	assert.Equal(t, 3, extChanges.TotalChanges())
+	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
+	for _, c := range extChanges.Changes {
+		assert.Equal(t, ObjectAdded, c.ChangeType)
+	}
+
+	// removed
+	extChanges = CompareMediaTypes(&rDoc, &lDoc)
+	assert.Equal(t, 3, extChanges.TotalChanges())
+	assert.Equal(t, 3, extChanges.TotalBreakingChanges())
+	for _, c := range extChanges.Changes {
+		assert.Equal(t, ObjectRemoved, c.ChangeType)
+	}
+}
@@ -11,11 +11,12 @@ import (
 // OAuthFlowsChanges represents changes found between two OpenAPI OAuthFlows objects.
 type OAuthFlowsChanges struct {
 	*PropertyChanges
-	ImplicitChanges          *OAuthFlowChanges `json:"implicit,omitempty" yaml:"implicit,omitempty"`
-	PasswordChanges          *OAuthFlowChanges `json:"password,omitempty" yaml:"password,omitempty"`
-	ClientCredentialsChanges *OAuthFlowChanges `json:"clientCredentials,omitempty" yaml:"clientCredentials,omitempty"`
-	AuthorizationCodeChanges *OAuthFlowChanges `json:"authCode,omitempty" yaml:"authCode,omitempty"`
-	ExtensionChanges         *ExtensionChanges `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+	ImplicitChanges            *OAuthFlowChanges `json:"implicit,omitempty" yaml:"implicit,omitempty"`
+	PasswordChanges            *OAuthFlowChanges `json:"password,omitempty" yaml:"password,omitempty"`
+	ClientCredentialsChanges   *OAuthFlowChanges `json:"clientCredentials,omitempty" yaml:"clientCredentials,omitempty"`
+	AuthorizationCodeChanges   *OAuthFlowChanges `json:"authCode,omitempty" yaml:"authCode,omitempty"`
+	DeviceAuthorizationChanges *OAuthFlowChanges `json:"deviceAuthorization,omitempty" yaml:"deviceAuthorization,omitempty"`
+	ExtensionChanges           *ExtensionChanges `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 }
 
 // GetAllChanges returns a slice of all changes made between OAuthFlows objects
@@ -37,6 +38,9 @@ func (o *OAuthFlowsChanges) GetAllChanges() []*Change {
 	if o.AuthorizationCodeChanges != nil {
 		changes = append(changes, o.AuthorizationCodeChanges.GetAllChanges()...)
 	}
+	if o.DeviceAuthorizationChanges != nil {
+		changes = append(changes, o.DeviceAuthorizationChanges.GetAllChanges()...)
+	}
 	if o.ExtensionChanges != nil {
 		changes = append(changes, o.ImplicitChanges.GetAllChanges()...)
 	}
@@ -61,6 +65,9 @@ func (o *OAuthFlowsChanges) TotalChanges() int {
 	if o.AuthorizationCodeChanges != nil {
 		c += o.AuthorizationCodeChanges.TotalChanges()
 	}
+	if o.DeviceAuthorizationChanges != nil {
+		c += o.DeviceAuthorizationChanges.TotalChanges()
+	}
 	if o.ExtensionChanges != nil {
 		c += o.ExtensionChanges.TotalChanges()
 	}
@@ -82,6 +89,9 @@ func (o *OAuthFlowsChanges) TotalBreakingChanges() int {
 	if o.AuthorizationCodeChanges != nil {
 		c += o.AuthorizationCodeChanges.TotalBreakingChanges()
 	}
+	if o.DeviceAuthorizationChanges != nil {
+		c += o.DeviceAuthorizationChanges.TotalBreakingChanges()
+	}
 	return c
 }
 
@@ -154,6 +164,21 @@ func CompareOAuthFlows(l, r *v3.OAuthFlows) *OAuthFlowsChanges {
 			nil, r.AuthorizationCode.ValueNode, false,
 			nil, r.AuthorizationCode.Value)
 	}
+
+	// device authorization
+	if !l.DeviceAuthorization.IsEmpty() && !r.DeviceAuthorization.IsEmpty() {
+		oa.DeviceAuthorizationChanges = CompareOAuthFlow(l.DeviceAuthorization.Value, r.DeviceAuthorization.Value)
+	}
+	if !l.DeviceAuthorization.IsEmpty() && r.DeviceAuthorization.IsEmpty() {
+		CreateChange(&changes, ObjectRemoved, v3.DeviceAuthorizationLabel,
+			l.DeviceAuthorization.ValueNode, nil, true,
+			l.DeviceAuthorization.Value, nil)
+	}
+	if l.DeviceAuthorization.IsEmpty() && !r.DeviceAuthorization.IsEmpty() {
+		CreateChange(&changes, ObjectAdded, v3.DeviceAuthorizationLabel,
+			nil, r.DeviceAuthorization.ValueNode, false,
+			nil, r.DeviceAuthorization.Value)
+	}
 	oa.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
 	oa.PropertyChanges = NewPropertyChanges(changes)
 	return oa
@@ -216,6 +241,17 @@ func CompareOAuthFlow(l, r *v3.OAuthFlow) *OAuthFlowChanges {
 		New:       r,
 	})
 
+	// device authorization url
+	props = append(props, &PropertyCheck{
+		LeftNode:  l.DeviceAuthorizationUrl.ValueNode,
+		RightNode: r.DeviceAuthorizationUrl.ValueNode,
+		Label:     v3.DeviceAuthorizationUrlLabel,
+		Changes:   &changes,
+		Breaking:  true,
+		Original:  l,
+		New:       r,
+	})
+
 	// token url
 	props = append(props, &PropertyCheck{
 		LeftNode:  l.TokenUrl.ValueNode,
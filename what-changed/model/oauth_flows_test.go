@@ -348,3 +348,73 @@ x-coke: cherry`
 	assert.Len(t, extChanges.GetAllChanges(), 5)
 	assert.Equal(t, 4, extChanges.TotalBreakingChanges())
 }
+
+func TestCompareOAuthFlows_DeviceAuthorization(t *testing.T) {
+	// Clear hash cache to ensure deterministic results in concurrent test environments
+	low.ClearHashCache()
+	left := `implicit:
+  authorizationUrl: cheese`
+
+	right := `implicit:
+  authorizationUrl: cheese
+deviceAuthorization:
+  deviceAuthorizationUrl: toast
+  tokenUrl: butter`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.OAuthFlows
+	var rDoc v3.OAuthFlows
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// adding a flow is not breaking
+	extChanges := CompareOAuthFlows(&lDoc, &rDoc)
+	assert.Equal(t, 1, extChanges.TotalChanges())
+	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
+	assert.Equal(t, ObjectAdded, extChanges.Changes[0].ChangeType)
+	assert.Equal(t, v3.DeviceAuthorizationLabel, extChanges.Changes[0].Property)
+
+	// removing a flow is breaking
+	extChanges = CompareOAuthFlows(&rDoc, &lDoc)
+	assert.Equal(t, 1, extChanges.TotalChanges())
+	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
+	assert.Equal(t, ObjectRemoved, extChanges.Changes[0].ChangeType)
+}
+
+func TestCompareOAuthFlows_DeviceAuthorization_Modified(t *testing.T) {
+	// Clear hash cache to ensure deterministic results in concurrent test environments
+	low.ClearHashCache()
+	left := `deviceAuthorization:
+  deviceAuthorizationUrl: toast
+  tokenUrl: butter`
+
+	right := `deviceAuthorization:
+  deviceAuthorizationUrl: crumpets
+  tokenUrl: butter`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.OAuthFlows
+	var rDoc v3.OAuthFlows
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// compare
+	extChanges := CompareOAuthFlows(&lDoc, &rDoc)
+	assert.Equal(t, 1, extChanges.TotalChanges())
+	assert.Len(t, extChanges.GetAllChanges(), 1)
+	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
+	assert.Equal(t, Modified, extChanges.DeviceAuthorizationChanges.Changes[0].ChangeType)
+	assert.Equal(t, v3.DeviceAuthorizationUrlLabel, extChanges.DeviceAuthorizationChanges.Changes[0].Property)
+}
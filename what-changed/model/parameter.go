@@ -18,6 +18,7 @@ import (
 type ParameterChanges struct {
 	*PropertyChanges
 	Name             string            `json:"name,omitempty" yaml:"name,omitempty"`
+	In               string            `json:"in,omitempty" yaml:"in,omitempty"`
 	SchemaChanges    *SchemaChanges    `json:"schemas,omitempty" yaml:"schemas,omitempty"`
 	ExtensionChanges *ExtensionChanges `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 
@@ -244,6 +245,7 @@ func CompareParameters(l, r any) *ParameterChanges {
 		lParam := l.(*v2.Parameter)
 		rParam := r.(*v2.Parameter)
 		pc.Name = lParam.Name.Value
+		pc.In = lParam.In.Value
 
 		// perform hash check to avoid further processing
 		if low.AreEqual(lParam, rParam) {
@@ -292,6 +294,7 @@ func CompareParameters(l, r any) *ParameterChanges {
 		lParam := l.(*v3.Parameter)
 		rParam := r.(*v3.Parameter)
 		pc.Name = lParam.Name.Value
+		pc.In = lParam.In.Value
 
 		// perform hash check to avoid further processing
 		if low.AreEqual(lParam, rParam) {
@@ -339,6 +342,9 @@ func CompareParameters(l, r any) *ParameterChanges {
 
 	pc.PropertyChanges = NewPropertyChanges(changes)
 	pc.ExtensionChanges = CompareExtensions(lext, rext)
+
+	// parameters are sent by clients.
+	ApplyChangeDirection(pc, DirectionRequest)
 	return pc
 }
 
@@ -5,6 +5,7 @@ package model
 
 import (
 	"reflect"
+	"sort"
 
 	"github.com/pb33f/libopenapi/datamodel/low"
 	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
@@ -14,17 +15,19 @@ import (
 // PathItemChanges represents changes found between to Swagger or OpenAPI PathItem object.
 type PathItemChanges struct {
 	*PropertyChanges
-	GetChanges       *OperationChanges   `json:"get,omitempty" yaml:"get,omitempty"`
-	PutChanges       *OperationChanges   `json:"put,omitempty" yaml:"put,omitempty"`
-	PostChanges      *OperationChanges   `json:"post,omitempty" yaml:"post,omitempty"`
-	DeleteChanges    *OperationChanges   `json:"delete,omitempty" yaml:"delete,omitempty"`
-	OptionsChanges   *OperationChanges   `json:"options,omitempty" yaml:"options,omitempty"`
-	HeadChanges      *OperationChanges   `json:"head,omitempty" yaml:"head,omitempty"`
-	PatchChanges     *OperationChanges   `json:"patch,omitempty" yaml:"patch,omitempty"`
-	TraceChanges     *OperationChanges   `json:"trace,omitempty" yaml:"trace,omitempty"`
-	ServerChanges    []*ServerChanges    `json:"servers,omitempty" yaml:"servers,omitempty"`
-	ParameterChanges []*ParameterChanges `json:"parameters,omitempty" yaml:"parameters,omitempty"`
-	ExtensionChanges *ExtensionChanges   `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+	GetChanges                 *OperationChanges            `json:"get,omitempty" yaml:"get,omitempty"`
+	PutChanges                 *OperationChanges            `json:"put,omitempty" yaml:"put,omitempty"`
+	PostChanges                *OperationChanges            `json:"post,omitempty" yaml:"post,omitempty"`
+	DeleteChanges              *OperationChanges            `json:"delete,omitempty" yaml:"delete,omitempty"`
+	OptionsChanges             *OperationChanges            `json:"options,omitempty" yaml:"options,omitempty"`
+	HeadChanges                *OperationChanges            `json:"head,omitempty" yaml:"head,omitempty"`
+	PatchChanges               *OperationChanges            `json:"patch,omitempty" yaml:"patch,omitempty"`
+	TraceChanges               *OperationChanges            `json:"trace,omitempty" yaml:"trace,omitempty"`
+	QueryChanges               *OperationChanges            `json:"query,omitempty" yaml:"query,omitempty"`
+	AdditionalOperationChanges map[string]*OperationChanges `json:"additionalOperations,omitempty" yaml:"additionalOperations,omitempty"`
+	ServerChanges              []*ServerChanges             `json:"servers,omitempty" yaml:"servers,omitempty"`
+	ParameterChanges           []*ParameterChanges          `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	ExtensionChanges           *ExtensionChanges            `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 }
 
 // GetAllChanges returns a slice of all changes made between PathItem objects
@@ -58,6 +61,12 @@ func (p *PathItemChanges) GetAllChanges() []*Change {
 	if p.TraceChanges != nil {
 		changes = append(changes, p.TraceChanges.GetAllChanges()...)
 	}
+	if p.QueryChanges != nil {
+		changes = append(changes, p.QueryChanges.GetAllChanges()...)
+	}
+	for _, k := range sortedKeys(p.AdditionalOperationChanges) {
+		changes = append(changes, p.AdditionalOperationChanges[k].GetAllChanges()...)
+	}
 	for i := range p.ServerChanges {
 		changes = append(changes, p.ServerChanges[i].GetAllChanges()...)
 	}
@@ -100,6 +109,12 @@ func (p *PathItemChanges) TotalChanges() int {
 	if p.TraceChanges != nil {
 		c += p.TraceChanges.TotalChanges()
 	}
+	if p.QueryChanges != nil {
+		c += p.QueryChanges.TotalChanges()
+	}
+	for _, k := range sortedKeys(p.AdditionalOperationChanges) {
+		c += p.AdditionalOperationChanges[k].TotalChanges()
+	}
 	for i := range p.ServerChanges {
 		c += p.ServerChanges[i].TotalChanges()
 	}
@@ -139,6 +154,12 @@ func (p *PathItemChanges) TotalBreakingChanges() int {
 	if p.TraceChanges != nil {
 		c += p.TraceChanges.TotalBreakingChanges()
 	}
+	if p.QueryChanges != nil {
+		c += p.QueryChanges.TotalBreakingChanges()
+	}
+	for _, k := range sortedKeys(p.AdditionalOperationChanges) {
+		c += p.AdditionalOperationChanges[k].TotalBreakingChanges()
+	}
 	for i := range p.ServerChanges {
 		c += p.ServerChanges[i].TotalBreakingChanges()
 	}
@@ -576,6 +597,23 @@ func compareOpenAPIPathItem(lPath, rPath *v3.PathItem, changes *[]*Change, pc *P
 			nil, rPath.Trace.ValueNode, false, nil, lPath.Trace.Value)
 	}
 
+	// query
+	if !lPath.Query.IsEmpty() && !rPath.Query.IsEmpty() {
+		totalOps++
+		go checkOperation(lPath.Query.Value, rPath.Query.Value, opChan, v3.QueryLabel)
+	}
+	if !lPath.Query.IsEmpty() && rPath.Query.IsEmpty() {
+		CreateChange(changes, PropertyRemoved, v3.QueryLabel,
+			lPath.Query.ValueNode, nil, true, lPath.Query.Value, nil)
+	}
+	if lPath.Query.IsEmpty() && !rPath.Query.IsEmpty() {
+		CreateChange(changes, PropertyAdded, v3.QueryLabel,
+			nil, rPath.Query.ValueNode, false, nil, rPath.Query.Value)
+	}
+
+	// additional operations
+	pc.AdditionalOperationChanges = checkAdditionalOperations(lPath, rPath, changes)
+
 	// servers
 	pc.ServerChanges = checkServers(lPath.Servers, rPath.Servers)
 
@@ -627,12 +665,74 @@ func compareOpenAPIPathItem(lPath, rPath *v3.PathItem, changes *[]*Change, pc *P
 			pc.PatchChanges = n.changes
 		case v3.TraceLabel:
 			pc.TraceChanges = n.changes
+		case v3.QueryLabel:
+			pc.QueryChanges = n.changes
 		}
 		completedOperations++
 	}
 	pc.ExtensionChanges = CompareExtensions(lPath.Extensions, rPath.Extensions)
 }
 
+// checkAdditionalOperations compares the 'additionalOperations' maps of two OpenAPI 3.2+ PathItem objects. Removed
+// operations are breaking, added operations are not. Returns nil if no operations have changed.
+func checkAdditionalOperations(lPath, rPath *v3.PathItem, changes *[]*Change) map[string]*OperationChanges {
+	lOps := FlattenLowLevelOrderedMap(lPath.AdditionalOperations.Value)
+	rOps := FlattenLowLevelOrderedMap(rPath.AdditionalOperations.Value)
+
+	if len(lOps) <= 0 && len(rOps) <= 0 {
+		return nil
+	}
+
+	// the whole map has been added or removed.
+	if !lPath.AdditionalOperations.IsEmpty() && rPath.AdditionalOperations.IsEmpty() {
+		CreateChange(changes, PropertyRemoved, v3.AdditionalOperationsLabel,
+			lPath.AdditionalOperations.ValueNode, nil, true, lPath.AdditionalOperations.Value, nil)
+		return nil
+	}
+	if lPath.AdditionalOperations.IsEmpty() && !rPath.AdditionalOperations.IsEmpty() {
+		CreateChange(changes, PropertyAdded, v3.AdditionalOperationsLabel,
+			nil, rPath.AdditionalOperations.ValueNode, false, nil, rPath.AdditionalOperations.Value)
+		return nil
+	}
+
+	// walk the keys in order, so changes are always reported in the same order.
+	lKeys := make([]string, 0, len(lOps))
+	for k := range lOps {
+		lKeys = append(lKeys, k)
+	}
+	sort.Strings(lKeys)
+	rKeys := make([]string, 0, len(rOps))
+	for k := range rOps {
+		rKeys = append(rKeys, k)
+	}
+	sort.Strings(rKeys)
+
+	opChanges := make(map[string]*OperationChanges)
+	for _, k := range lKeys {
+		if rOps[k] == nil {
+			CreateChange(changes, ObjectRemoved, k,
+				lOps[k].ValueNode, nil, true, lOps[k].Value, nil)
+			continue
+		}
+		if low.AreEqual(lOps[k].Value, rOps[k].Value) {
+			continue
+		}
+		if ch := CompareOperations(lOps[k].Value, rOps[k].Value); ch != nil {
+			opChanges[k] = ch
+		}
+	}
+	for _, k := range rKeys {
+		if lOps[k] == nil {
+			CreateChange(changes, ObjectAdded, k,
+				nil, rOps[k].ValueNode, false, nil, rOps[k].Value)
+		}
+	}
+	if len(opChanges) <= 0 {
+		return nil
+	}
+	return opChanges
+}
+
 func checkOperation(l, r any, done chan opCheck, method string) {
 	done <- opCheck{
 		label:   method,
@@ -797,3 +797,157 @@ parameters:
 	assert.Len(t, extChanges.GetAllChanges(), 1)
 	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
 }
+
+func TestComparePathItem_V3_QueryAndAdditionalOperations(t *testing.T) {
+	left := `query:
+  description: search
+additionalOperations:
+  LINK:
+    description: link
+  COPY:
+    description: copy`
+
+	right := `query:
+  description: search things
+additionalOperations:
+  LINK:
+    description: link things
+  PURGE:
+    description: purge`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.PathItem
+	var rDoc v3.PathItem
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// compare.
+	extChanges := ComparePathItems(&lDoc, &rDoc)
+	assert.Equal(t, 4, extChanges.TotalChanges())
+	assert.Len(t, extChanges.GetAllChanges(), 4)
+	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
+	assert.Equal(t, Modified, extChanges.QueryChanges.Changes[0].ChangeType)
+	assert.Equal(t, Modified, extChanges.AdditionalOperationChanges["LINK"].Changes[0].ChangeType)
+}
+
+func TestComparePathItem_V3_AddRemoveQueryAndAdditionalOperations(t *testing.T) {
+	left := `summary: something`
+
+	right := `summary: something
+query:
+  description: search
+additionalOperations:
+  LINK:
+    description: link`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.PathItem
+	var rDoc v3.PathItem
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// added
+	extChanges := ComparePathItems(&lDoc, &rDoc)
+	assert.Equal(t, 2, extChanges.TotalChanges())
+	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
+
+	// removed
+	extChanges = ComparePathItems(&rDoc, &lDoc)
+	assert.Equal(t, 2, extChanges.TotalChanges())
+	assert.Equal(t, 2, extChanges.TotalBreakingChanges())
+}
+
+func TestComparePathItem_V3_AdditionalOperationsOrder(t *testing.T) {
+	left := `additionalOperations:
+  UNLINK:
+    description: unlink
+  LINK:
+    description: link
+  COPY:
+    description: copy`
+
+	right := `additionalOperations:
+  PURGE:
+    description: purge
+  MOVE:
+    description: move
+  LOCK:
+    description: lock`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	var lDoc v3.PathItem
+	var rDoc v3.PathItem
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// removed operations come first, then added operations, each sorted by method.
+	for i := 0; i < 20; i++ {
+		extChanges := ComparePathItems(&lDoc, &rDoc)
+		var properties []string
+		for _, change := range extChanges.Changes {
+			properties = append(properties, change.Property)
+		}
+		assert.Equal(t, []string{"COPY", "LINK", "UNLINK", "LOCK", "MOVE", "PURGE"}, properties)
+	}
+}
+
+func TestComparePathItem_V3_AdditionalOperationChangesOrder(t *testing.T) {
+	left := `additionalOperations:
+  UNLINK:
+    description: unlink
+  LINK:
+    description: link
+  COPY:
+    description: copy
+  MOVE:
+    description: move`
+
+	right := `additionalOperations:
+  UNLINK:
+    description: unlink them
+  LINK:
+    description: link them
+  COPY:
+    description: copy them
+  MOVE:
+    description: move them`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	var lDoc v3.PathItem
+	var rDoc v3.PathItem
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// changes made inside operations are reported in order of method.
+	for i := 0; i < 20; i++ {
+		extChanges := ComparePathItems(&lDoc, &rDoc)
+		assert.Equal(t, 4, extChanges.TotalChanges())
+		var descriptions []string
+		for _, change := range extChanges.GetAllChanges() {
+			descriptions = append(descriptions, change.New)
+		}
+		assert.Equal(t, []string{"copy them", "link them", "move them", "unlink them"}, descriptions)
+	}
+}
@@ -21,6 +21,12 @@ type PathsChanges struct {
 	ExtensionChanges *ExtensionChanges           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 }
 
+// pathMoveBreaking decides if a renamed path is breaking. Renaming the parameters of a path does not change the URL
+// used by a client, moving the path does.
+func pathMoveBreaking(from, to string) bool {
+	return !samePathTemplate(from, to)
+}
+
 // GetAllChanges returns a slice of all changes made between Paths objects
 func (p *PathsChanges) GetAllChanges() []*Change {
 	if p == nil {
@@ -137,6 +143,12 @@ func ComparePaths(l, r any) *PathsChanges {
 			<-doneChan
 			completedChecks++
 		}
+		for _, m := range detectRenames(lPath.PathItems, rPath.PathItems, &changes, v3.PathLabel,
+			samePathTemplate, pathMoveBreaking) {
+			if !low.AreEqual(m.l, m.r) {
+				pathChanges[m.to] = ComparePathItems(m.l, m.r)
+			}
+		}
 		if len(pathChanges) > 0 {
 			pc.PathItemsChanges = pathChanges
 		}
@@ -210,6 +222,14 @@ func ComparePaths(l, r any) *PathsChanges {
 			<-doneChan
 			completedChecks++
 		}
+		if lPath != nil && rPath != nil {
+			for _, m := range detectRenames(lPath.PathItems, rPath.PathItems, &changes, v3.PathLabel,
+				samePathTemplate, pathMoveBreaking) {
+				if !low.AreEqual(m.l, m.r) {
+					pathChanges[m.to] = ComparePathItems(m.l, m.r)
+				}
+			}
+		}
 		if len(pathChanges) > 0 {
 			pc.PathItemsChanges = pathChanges
 		}
@@ -0,0 +1,183 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/low"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// renameSimilarity is how similar (between 0 and 1) a removed object and an added object need to be, for the pair to
+// be reported as a single ObjectRenamed change. Similarity is measured by comparing every value held by each object,
+// along with where it's held.
+const renameSimilarity = 0.8
+
+// renamedObject is a removed object that was matched with an added object.
+type renamedObject[T any] struct {
+	from, to string
+	l, r     T
+}
+
+type renameCandidate struct {
+	from, to string
+	score    float64
+}
+
+var pathParameter = regexp.MustCompile(`\{[^}/]*}`)
+
+// samePathTemplate returns true if two paths are the same once parameter names are ignored, for example
+// /pets/{id} and /pets/{petId}.
+func samePathTemplate(l, r string) bool {
+	return pathParameter.ReplaceAllString(l, "{}") == pathParameter.ReplaceAllString(r, "{}")
+}
+
+// detectRenames matches objects removed from a map with objects added to it, that are either identical (the hashes
+// match) or similar enough to be the same object under a new key. Each match replaces the ObjectRemoved and
+// ObjectAdded changes for the label with a single ObjectRenamed change. The same function decides if a match is
+// always a rename, regardless of how similar the objects are, and breaking decides if the rename is breaking.
+//
+// Matches are made greedily, the most similar pair first, and returned so the objects can be compared.
+func detectRenames[T any](l, r *orderedmap.Map[low.KeyReference[string], low.ValueReference[T]],
+	changes *[]*Change, label string, same func(from, to string) bool, breaking func(from, to string) bool,
+) []renamedObject[T] {
+	removed := make(map[string]bool)
+	added := make(map[string]bool)
+	lValues := make(map[string]low.ValueReference[T])
+	rValues := make(map[string]low.ValueReference[T])
+	for k, v := range l.FromOldest() {
+		lValues[k.Value] = v
+		removed[k.Value] = true
+	}
+	for k, v := range r.FromOldest() {
+		rValues[k.Value] = v
+		if _, ok := lValues[k.Value]; ok {
+			delete(removed, k.Value)
+			continue
+		}
+		added[k.Value] = true
+	}
+	if len(removed) == 0 || len(added) == 0 {
+		return nil
+	}
+
+	var candidates []renameCandidate
+	fingerprints := make(map[string]map[string]struct{})
+	for to := range added {
+		fingerprints[to] = leafFingerprints(rValues[to].ValueNode)
+	}
+	for from := range removed {
+		lHash := low.GenerateHashString(lValues[from].Value)
+		lPrints := leafFingerprints(lValues[from].ValueNode)
+		for to := range added {
+			score := 0.0
+			switch {
+			case same != nil && same(from, to):
+				score = 2 // always wins over similarity.
+			case lHash == low.GenerateHashString(rValues[to].Value):
+				score = 1
+			default:
+				score = similarity(lPrints, fingerprints[to])
+			}
+			if score >= renameSimilarity {
+				candidates = append(candidates, renameCandidate{from: from, to: to, score: score})
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		if candidates[i].from != candidates[j].from {
+			return candidates[i].from < candidates[j].from
+		}
+		return candidates[i].to < candidates[j].to
+	})
+
+	var renamed []renamedObject[T]
+	matched := make(map[string]bool)
+	for _, c := range candidates {
+		if matched["l:"+c.from] || matched["r:"+c.to] {
+			continue
+		}
+		matched["l:"+c.from], matched["r:"+c.to] = true, true
+		renamed = append(renamed, renamedObject[T]{
+			from: c.from, to: c.to, l: lValues[c.from].Value, r: rValues[c.to].Value,
+		})
+	}
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	// replace the removal and addition of each match with a rename.
+	kept := (*changes)[:0]
+	for _, ch := range *changes {
+		if ch.Property == label &&
+			((ch.ChangeType == ObjectRemoved && matched["l:"+ch.Original]) ||
+				(ch.ChangeType == ObjectAdded && matched["r:"+ch.New])) {
+			continue
+		}
+		kept = append(kept, ch)
+	}
+	*changes = kept
+	for _, m := range renamed {
+		// the values locate the change, the same as removals and additions, the keys describe it.
+		CreateChange(changes, ObjectRenamed, label,
+			lValues[m.from].ValueNode, rValues[m.to].ValueNode, breaking(m.from, m.to),
+			m.l, m.r)
+		c := (*changes)[len(*changes)-1]
+		c.Original, c.New = m.from, m.to
+	}
+	return renamed
+}
+
+// leafFingerprints returns every scalar value held by a node, prefixed by where it's held. Sequence indexes are not
+// included, so re-ordering items does not change the fingerprints.
+func leafFingerprints(node *yaml.Node) map[string]struct{} {
+	prints := make(map[string]struct{})
+	var walk func(n *yaml.Node, at string)
+	walk = func(n *yaml.Node, at string) {
+		if n == nil {
+			return
+		}
+		switch n.Kind {
+		case yaml.DocumentNode:
+			for _, c := range n.Content {
+				walk(c, at)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				walk(n.Content[i+1], at+"/"+n.Content[i].Value)
+			}
+		case yaml.SequenceNode:
+			for _, c := range n.Content {
+				walk(c, at+"/-")
+			}
+		case yaml.AliasNode:
+			walk(n.Alias, at)
+		default:
+			prints[at+"="+strings.TrimSpace(n.Value)] = struct{}{}
+		}
+	}
+	walk(node, "")
+	return prints
+}
+
+// similarity is the Jaccard index of two sets of fingerprints.
+func similarity(l, r map[string]struct{}) float64 {
+	if len(l) == 0 && len(r) == 0 {
+		return 0
+	}
+	shared := 0
+	for k := range l {
+		if _, ok := r[k]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(l)+len(r)-shared)
+}
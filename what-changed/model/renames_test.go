@@ -0,0 +1,313 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func changesOfType(changes []*Change, changeType int) []*Change {
+	var found []*Change
+	for _, c := range changes {
+		if c.ChangeType == changeType {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+func TestSamePathTemplate(t *testing.T) {
+	assert.True(t, samePathTemplate("/pets/{id}", "/pets/{petId}"))
+	assert.True(t, samePathTemplate("/pets/{id}/toys/{toy}", "/pets/{petId}/toys/{toyId}"))
+	assert.False(t, samePathTemplate("/pets/{id}", "/animals/{id}"))
+	assert.False(t, samePathTemplate("/pets/{id}", "/pets/{id}/toys"))
+}
+
+func TestSimilarity(t *testing.T) {
+	var ln, rn yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte("type: object\nrequired: [a, b]"), &ln))
+	require.NoError(t, yaml.Unmarshal([]byte("type: object\nrequired: [b, a, c]"), &rn))
+
+	// re-ordering is ignored, so only the extra value differs.
+	l, r := leafFingerprints(&ln), leafFingerprints(&rn)
+	assert.Equal(t, 0.75, similarity(l, r))
+	assert.Equal(t, 0.0, similarity(nil, nil))
+}
+
+func TestComparePaths_Reparameterised(t *testing.T) {
+	left := `openapi: 3.1.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+      responses:
+        "200":
+          description: ok
+  /stores:
+    get:
+      operationId: listStores
+      responses:
+        "200":
+          description: ok`
+
+	right := `openapi: 3.1.0
+paths:
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: petId
+          in: path
+          required: true
+      responses:
+        "200":
+          description: ok
+  /stores:
+    get:
+      operationId: listStores
+      responses:
+        "200":
+          description: ok`
+
+	changes := compareDirectionDocs(t, left, right)
+	require.NotNil(t, changes)
+	pc := changes.PathsChanges
+	require.NotNil(t, pc)
+
+	renamed := changesOfType(pc.Changes, ObjectRenamed)
+	require.Len(t, renamed, 1)
+	assert.Equal(t, "/pets/{id}", renamed[0].Original)
+	assert.Equal(t, "/pets/{petId}", renamed[0].New)
+	assert.False(t, renamed[0].Breaking)
+	assert.Equal(t, "/paths/~1pets~1{petId}", renamed[0].Path)
+	assert.Empty(t, changesOfType(pc.Changes, ObjectRemoved))
+	assert.Empty(t, changesOfType(pc.Changes, ObjectAdded))
+
+	// the renamed path items are compared.
+	require.NotNil(t, pc.PathItemsChanges["/pets/{petId}"])
+	assert.NotEmpty(t, pc.PathItemsChanges["/pets/{petId}"].GetAllChanges())
+}
+
+func TestComparePaths_Moved(t *testing.T) {
+	left := `openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      description: all the pets
+      responses:
+        "200":
+          description: ok
+  /stores:
+    get:
+      operationId: listStores
+      responses:
+        "200":
+          description: ok`
+
+	right := `openapi: 3.1.0
+paths:
+  /animals:
+    get:
+      operationId: listPets
+      description: all the pets
+      responses:
+        "200":
+          description: ok
+  /owners:
+    post:
+      operationId: createOwner
+      responses:
+        "201":
+          description: created`
+
+	changes := compareDirectionDocs(t, left, right)
+	require.NotNil(t, changes)
+	pc := changes.PathsChanges
+	require.NotNil(t, pc)
+
+	// clients use the old URL, so a move is breaking.
+	renamed := changesOfType(pc.Changes, ObjectRenamed)
+	require.Len(t, renamed, 1)
+	assert.Equal(t, "/pets", renamed[0].Original)
+	assert.Equal(t, "/animals", renamed[0].New)
+	assert.True(t, renamed[0].Breaking)
+	assert.Nil(t, pc.PathItemsChanges["/animals"])
+
+	// unrelated paths are still removed and added.
+	removed := changesOfType(pc.Changes, ObjectRemoved)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "/stores", removed[0].Original)
+	added := changesOfType(pc.Changes, ObjectAdded)
+	require.Len(t, added, 1)
+	assert.Equal(t, "/owners", added[0].New)
+}
+
+func TestComparePaths_Moved_Swagger(t *testing.T) {
+	left := `swagger: 2.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      responses:
+        "200":
+          description: ok`
+
+	right := `swagger: 2.0
+paths:
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      responses:
+        "200":
+          description: ok`
+
+	changes := compareSwaggerRenameDocs(t, left, right)
+	require.NotNil(t, changes)
+	renamed := changesOfType(changes.PathsChanges.Changes, ObjectRenamed)
+	require.Len(t, renamed, 1)
+	assert.False(t, renamed[0].Breaking)
+	assert.Equal(t, "/pets/{petId}", renamed[0].New)
+}
+
+func TestCompareComponents_RenamedSchema(t *testing.T) {
+	left := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        age:
+          type: integer
+        color:
+          type: string
+    Store:
+      type: object
+      properties:
+        address:
+          type: string`
+
+	right := `openapi: 3.1.0
+components:
+  schemas:
+    Animal:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        age:
+          type: integer
+        color:
+          type: string
+          description: the color of the animal
+    Owner:
+      type: array
+      items:
+        type: integer`
+
+	changes := compareDirectionDocs(t, left, right)
+	require.NotNil(t, changes)
+	cc := changes.ComponentsChanges
+	require.NotNil(t, cc)
+
+	renamed := changesOfType(cc.Changes, ObjectRenamed)
+	require.Len(t, renamed, 1)
+	assert.Equal(t, "Pet", renamed[0].Original)
+	assert.Equal(t, "Animal", renamed[0].New)
+	assert.Equal(t, v3.SchemasLabel, renamed[0].Property)
+	assert.False(t, renamed[0].Breaking)
+	assert.Equal(t, "/components/schemas/Animal", renamed[0].Path)
+
+	// the renamed schema is compared with the original.
+	require.NotNil(t, cc.SchemaChanges["Animal"])
+	assert.Equal(t, 1, cc.SchemaChanges["Animal"].TotalChanges())
+
+	// dissimilar schemas are not matched.
+	require.Len(t, changesOfType(cc.Changes, ObjectRemoved), 1)
+	require.Len(t, changesOfType(cc.Changes, ObjectAdded), 1)
+}
+
+func TestCompareComponents_RenamedDefinition(t *testing.T) {
+	left := `swagger: 2.0
+definitions:
+  Pet:
+    type: object
+    description: a pet`
+
+	right := `swagger: 2.0
+definitions:
+  Animal:
+    type: object
+    description: a pet`
+
+	changes := compareSwaggerRenameDocs(t, left, right)
+	require.NotNil(t, changes)
+	all := changes.GetAllChanges()
+	require.Len(t, all, 1)
+	assert.Equal(t, ObjectRenamed, all[0].ChangeType)
+	assert.Equal(t, v2.DefinitionsLabel, all[0].Property)
+	assert.Equal(t, "/definitions/Animal", all[0].Path)
+	assert.False(t, all[0].Breaking)
+}
+
+func TestCompareDocuments_RenamedBreakingRule(t *testing.T) {
+	left := `openapi: 3.1.0
+components:
+  schemas:
+    Pet:
+      type: string`
+
+	right := `openapi: 3.1.0
+components:
+  schemas:
+    Animal:
+      type: string`
+
+	renamed := true
+	overrides := NewBreakingRules()
+	overrides.SetRule(ObjectComponents, v3.SchemasLabel, &BreakingRule{Renamed: &renamed})
+	changes := compareDocsWithOptions(t, left, right,
+		&CompareOptions{BreakingRules: DefaultBreakingRules().Merge(overrides)})
+	require.NotNil(t, changes)
+	all := changes.GetAllChanges()
+	require.Len(t, all, 1)
+	assert.Equal(t, ObjectRenamed, all[0].ChangeType)
+	assert.True(t, all[0].Breaking)
+}
+
+func TestBreakingRule_Renamed(t *testing.T) {
+	renamed := true
+	rule := &BreakingRule{Renamed: &renamed}
+	assert.Equal(t, &renamed, rule.ForChangeType(ObjectRenamed))
+	assert.Nil(t, NewBreakingRule(true, true, true).ForChangeType(ObjectRenamed))
+	assert.Equal(t, &renamed, new(BreakingRule).merge(rule).Renamed)
+}
+
+func compareSwaggerRenameDocs(t *testing.T, left, right string) *DocumentChanges {
+	t.Helper()
+	low.ClearHashCache()
+	siLeft, err := datamodel.ExtractSpecInfo([]byte(left))
+	require.NoError(t, err)
+	siRight, err := datamodel.ExtractSpecInfo([]byte(right))
+	require.NoError(t, err)
+	lDoc, _ := v2.CreateDocumentFromConfig(siLeft, datamodel.NewDocumentConfiguration())
+	rDoc, _ := v2.CreateDocumentFromConfig(siRight, datamodel.NewDocumentConfiguration())
+	return CompareDocuments(lDoc, rDoc)
+}
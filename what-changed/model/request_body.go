@@ -94,5 +94,8 @@ func CompareRequestBodies(l, r *v3.RequestBody) *RequestBodyChanges {
 		&changes, v3.ContentLabel, CompareMediaTypes)
 	rbc.ExtensionChanges = CompareExtensions(l.Extensions, r.Extensions)
 	rbc.PropertyChanges = NewPropertyChanges(changes)
+
+	// request bodies are sent by clients.
+	ApplyChangeDirection(rbc, DirectionRequest)
 	return rbc
 }
@@ -197,5 +197,8 @@ func CompareResponse(l, r any) *ResponseChanges {
 
 	CheckProperties(props)
 	rc.PropertyChanges = NewPropertyChanges(changes)
+
+	// responses are received by clients.
+	ApplyChangeDirection(rc, DirectionResponse)
 	return rc
 }
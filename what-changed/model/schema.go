@@ -48,6 +48,12 @@ type SchemaChanges struct {
 	DependentSchemasChanges      map[string]*SchemaChanges `json:"dependentSchemas,omitempty" yaml:"dependentSchemas,omitempty"`
 	DependentRequiredChanges     []*Change                 `json:"dependentRequired,omitempty" yaml:"dependentRequired,omitempty"`
 	PatternPropertiesChanges     map[string]*SchemaChanges `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
+	DefsChanges                  map[string]*SchemaChanges `json:"$defs,omitempty" yaml:"$defs,omitempty"`
+	VocabularyChanges            []*Change                 `json:"$vocabulary,omitempty" yaml:"$vocabulary,omitempty"`
+
+	// schemas compared and the direction applied, used to re-evaluate breaking changes for a direction.
+	left, right *base.Schema
+	direction   ChangeDirection
 }
 
 func (s *SchemaChanges) GetPropertyChanges() []*Change {
@@ -79,6 +85,16 @@ func (s *SchemaChanges) GetPropertyChanges() []*Change {
 			}
 		}
 	}
+	if s.DefsChanges != nil {
+		for n := range s.DefsChanges {
+			if s.DefsChanges[n] != nil {
+				changes = append(changes, s.DefsChanges[n].GetAllChanges()...)
+			}
+		}
+	}
+	if len(s.VocabularyChanges) > 0 {
+		changes = append(changes, s.VocabularyChanges...)
+	}
 	if s.XMLChanges != nil {
 		changes = append(changes, s.XMLChanges.GetAllChanges()...)
 	}
@@ -177,6 +193,16 @@ func (s *SchemaChanges) GetAllChanges() []*Change {
 			}
 		}
 	}
+	if s.DefsChanges != nil {
+		for n := range s.DefsChanges {
+			if s.DefsChanges[n] != nil {
+				changes = append(changes, s.DefsChanges[n].GetAllChanges()...)
+			}
+		}
+	}
+	if len(s.VocabularyChanges) > 0 {
+		changes = append(changes, s.VocabularyChanges...)
+	}
 	if s.ExternalDocChanges != nil {
 		changes = append(changes, s.ExternalDocChanges.GetAllChanges()...)
 	}
@@ -271,6 +297,14 @@ func (s *SchemaChanges) TotalChanges() int {
 			t += s.PatternPropertiesChanges[n].TotalChanges()
 		}
 	}
+	if s.DefsChanges != nil {
+		for n := range s.DefsChanges {
+			t += s.DefsChanges[n].TotalChanges()
+		}
+	}
+	if len(s.VocabularyChanges) > 0 {
+		t += len(s.VocabularyChanges)
+	}
 	if s.ExternalDocChanges != nil {
 		t += s.ExternalDocChanges.TotalChanges()
 	}
@@ -365,6 +399,16 @@ func (s *SchemaChanges) TotalBreakingChanges() int {
 			t += s.PatternPropertiesChanges[n].TotalBreakingChanges()
 		}
 	}
+	if s.DefsChanges != nil {
+		for n := range s.DefsChanges {
+			t += s.DefsChanges[n].TotalBreakingChanges()
+		}
+	}
+	for _, change := range s.VocabularyChanges {
+		if change.Breaking {
+			t++
+		}
+	}
 	if s.XMLChanges != nil {
 		t += s.XMLChanges.TotalBreakingChanges()
 	}
@@ -476,6 +520,7 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
 
 		lSchema := l.Schema()
 		rSchema := r.Schema()
+		sc.left, sc.right = lSchema, rSchema
 
 		if low.AreEqual(lSchema, rSchema) {
 			// there is no point going on, we know nothing changed!
@@ -537,6 +582,25 @@ func CompareSchemas(l, r *base.SchemaProxy) *SchemaChanges {
 		patterns := checkMappedSchemaOfASchema(lPattProp, rPattProp, &changes)
 		sc.PatternPropertiesChanges = patterns
 
+		// check $defs and $vocabulary (3.1)
+		var lDefs, rDefs *orderedmap.Map[low.KeyReference[string], low.ValueReference[*base.SchemaProxy]]
+		var lVocab, rVocab *orderedmap.Map[low.KeyReference[string], low.ValueReference[bool]]
+		if lSchema != nil {
+			lDefs = lSchema.Defs.Value
+			lVocab = lSchema.Vocabulary.Value
+		}
+		if rSchema != nil {
+			rDefs = rSchema.Defs.Value
+			rVocab = rSchema.Vocabulary.Value
+		}
+		if lDefs != nil || rDefs != nil {
+			sc.DefsChanges = checkMappedSchemaOfASchema(lDefs, rDefs, &changes)
+		}
+		vocabChanges := checkVocabularyChanges(lVocab, rVocab)
+		if len(vocabChanges) > 0 {
+			sc.VocabularyChanges = vocabChanges
+		}
+
 		var wg sync.WaitGroup
 		wg.Add(4)
 		go func() {
@@ -753,6 +817,82 @@ func checkSchemaPropertyChanges(
 	lnv = nil
 	rnv = nil
 
+	// $id (breaking change, alters how relative references resolve)
+	if lSchema != nil && lSchema.Id.ValueNode != nil {
+		lnv = lSchema.Id.ValueNode
+	}
+	if rSchema != nil && rSchema.Id.ValueNode != nil {
+		rnv = rSchema.Id.ValueNode
+	}
+	props = append(props, &PropertyCheck{
+		LeftNode:  lnv,
+		RightNode: rnv,
+		Label:     v3.IdLabel,
+		Changes:   changes,
+		Breaking:  true,
+		Original:  lSchema,
+		New:       rSchema,
+	})
+	lnv = nil
+	rnv = nil
+
+	// $dynamicRef (breaking change)
+	if lSchema != nil && lSchema.DynamicRef.ValueNode != nil {
+		lnv = lSchema.DynamicRef.ValueNode
+	}
+	if rSchema != nil && rSchema.DynamicRef.ValueNode != nil {
+		rnv = rSchema.DynamicRef.ValueNode
+	}
+	props = append(props, &PropertyCheck{
+		LeftNode:  lnv,
+		RightNode: rnv,
+		Label:     v3.DynamicRefLabel,
+		Changes:   changes,
+		Breaking:  true,
+		Original:  lSchema,
+		New:       rSchema,
+	})
+	lnv = nil
+	rnv = nil
+
+	// $dynamicAnchor (breaking change)
+	if lSchema != nil && lSchema.DynamicAnchor.ValueNode != nil {
+		lnv = lSchema.DynamicAnchor.ValueNode
+	}
+	if rSchema != nil && rSchema.DynamicAnchor.ValueNode != nil {
+		rnv = rSchema.DynamicAnchor.ValueNode
+	}
+	props = append(props, &PropertyCheck{
+		LeftNode:  lnv,
+		RightNode: rnv,
+		Label:     v3.DynamicAnchorLabel,
+		Changes:   changes,
+		Breaking:  true,
+		Original:  lSchema,
+		New:       rSchema,
+	})
+	lnv = nil
+	rnv = nil
+
+	// $comment
+	if lSchema != nil && lSchema.Comment.ValueNode != nil {
+		lnv = lSchema.Comment.ValueNode
+	}
+	if rSchema != nil && rSchema.Comment.ValueNode != nil {
+		rnv = rSchema.Comment.ValueNode
+	}
+	props = append(props, &PropertyCheck{
+		LeftNode:  lnv,
+		RightNode: rnv,
+		Label:     v3.CommentLabel,
+		Changes:   changes,
+		Breaking:  false,
+		Original:  lSchema,
+		New:       rSchema,
+	})
+	lnv = nil
+	rnv = nil
+
 	if lSchema != nil && lSchema.ExclusiveMaximum.ValueNode != nil {
 		lnv = lSchema.ExclusiveMaximum.ValueNode
 	}
@@ -1759,6 +1899,36 @@ func checkDependentRequiredChanges(
 	return changes
 }
 
+// checkVocabularyChanges compares two $vocabulary maps and returns any changes found. Adding a required
+// vocabulary, or making an optional vocabulary required is breaking, as consumers may not support it.
+func checkVocabularyChanges(
+	left, right *orderedmap.Map[low.KeyReference[string], low.ValueReference[bool]],
+) []*Change {
+	if left == nil && right == nil {
+		return nil
+	}
+	var changes []*Change
+	for uri, rv := range right.FromOldest() {
+		lv := low.FindItemInOrderedMap[bool](uri.Value, left)
+		if lv == nil {
+			CreateChange(&changes, PropertyAdded, uri.Value,
+				nil, rv.ValueNode, rv.Value, nil, rv.Value)
+			continue
+		}
+		if lv.Value != rv.Value {
+			CreateChange(&changes, Modified, uri.Value,
+				lv.ValueNode, rv.ValueNode, rv.Value, lv.Value, rv.Value)
+		}
+	}
+	for uri, lv := range left.FromOldest() {
+		if low.FindItemInOrderedMap[bool](uri.Value, right) == nil {
+			CreateChange(&changes, PropertyRemoved, uri.Value,
+				lv.ValueNode, nil, false, lv.Value, nil)
+		}
+	}
+	return changes
+}
+
 // slicesEqual compares two string slices for equality (order matters)
 func slicesEqual(a, b []string) bool {
 	if len(a) != len(b) {
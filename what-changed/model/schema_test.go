@@ -4499,3 +4499,71 @@ components:
 	}
 	assert.True(t, foundDepReq)
 }
+
+func TestCompareSchemas_CoreKeywords(t *testing.T) {
+	low.ClearHashCache()
+	left := `openapi: 3.1.0
+components:
+  schemas:
+    Something:
+      $id: https://example.com/something
+      $comment: original
+      $dynamicAnchor: meta
+      $vocabulary:
+        https://json-schema.org/draft/2020-12/vocab/core: true
+        https://example.com/vocab/optional: false
+        https://example.com/vocab/gone: true
+      $defs:
+        name:
+          type: string
+        removed:
+          type: string
+      type: object`
+
+	right := `openapi: 3.1.0
+components:
+  schemas:
+    Something:
+      $id: https://example.com/something-else
+      $comment: updated
+      $dynamicAnchor: meta
+      $dynamicRef: "#meta"
+      $vocabulary:
+        https://json-schema.org/draft/2020-12/vocab/core: true
+        https://example.com/vocab/optional: true
+        https://example.com/vocab/new: false
+      $defs:
+        name:
+          type: integer
+        added:
+          type: string
+      type: object`
+
+	leftDoc, rightDoc := test_BuildDoc(left, right)
+
+	lSchemaProxy := leftDoc.Components.Value.FindSchema("Something").Value
+	rSchemaProxy := rightDoc.Components.Value.FindSchema("Something").Value
+
+	changes := CompareSchemas(lSchemaProxy, rSchemaProxy)
+	assert.NotNil(t, changes)
+
+	// $id modified (breaking), $comment modified, $dynamicRef added (breaking)
+	// $defs: one added, one removed (breaking), one modified (breaking type change)
+	// $vocabulary: one made required (breaking), one added, one removed
+	assert.Len(t, changes.DefsChanges, 1)
+	assert.Equal(t, 1, changes.DefsChanges["name"].TotalChanges())
+	assert.Len(t, changes.VocabularyChanges, 3)
+	assert.Equal(t, 9, changes.TotalChanges())
+	assert.Equal(t, 5, changes.TotalBreakingChanges())
+	assert.Len(t, changes.GetAllChanges(), 9)
+
+	labels := make(map[string]*Change)
+	for _, c := range changes.Changes {
+		labels[c.Property] = c
+	}
+	assert.Equal(t, Modified, labels[v3.IdLabel].ChangeType)
+	assert.True(t, labels[v3.IdLabel].Breaking)
+	assert.Equal(t, Modified, labels[v3.CommentLabel].ChangeType)
+	assert.False(t, labels[v3.CommentLabel].Breaking)
+	assert.Equal(t, PropertyAdded, labels[v3.DynamicRefLabel].ChangeType)
+}
@@ -340,3 +340,36 @@ flows:
 	assert.Equal(t, 1, extChanges.TotalBreakingChanges())
 	assert.Equal(t, Modified, extChanges.OAuthFlowChanges.ImplicitChanges.Changes[0].ChangeType)
 }
+
+func TestCompareSecuritySchemes_v3_DeprecatedAndMetadata(t *testing.T) {
+	// Clear hash cache to ensure deterministic results in concurrent test environments
+	low.ClearHashCache()
+	left := `type: oauth2
+oauth2MetadataUrl: https://pb33f.io/.well-known/oauth-authorization-server`
+
+	right := `type: oauth2
+oauth2MetadataUrl: https://api.pb33f.io/.well-known/oauth-authorization-server
+deprecated: true`
+
+	var lNode, rNode yaml.Node
+	_ = yaml.Unmarshal([]byte(left), &lNode)
+	_ = yaml.Unmarshal([]byte(right), &rNode)
+
+	// create low level objects
+	var lDoc v3.SecurityScheme
+	var rDoc v3.SecurityScheme
+	_ = low.BuildModel(lNode.Content[0], &lDoc)
+	_ = low.BuildModel(rNode.Content[0], &rDoc)
+	_ = lDoc.Build(context.Background(), nil, lNode.Content[0], nil)
+	_ = rDoc.Build(context.Background(), nil, rNode.Content[0], nil)
+
+	// marking a scheme as deprecated is not a breaking change.
+	extChanges := CompareSecuritySchemes(&lDoc, &rDoc)
+	assert.Equal(t, 2, extChanges.TotalChanges())
+	assert.Len(t, extChanges.GetAllChanges(), 2)
+	assert.Equal(t, 0, extChanges.TotalBreakingChanges())
+	assert.Equal(t, Modified, extChanges.Changes[0].ChangeType)
+	assert.Equal(t, v3.OAuth2MetadataUrlLabel, extChanges.Changes[0].Property)
+	assert.Equal(t, PropertyAdded, extChanges.Changes[1].ChangeType)
+	assert.Equal(t, v3.DeprecatedLabel, extChanges.Changes[1].Property)
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+)
+
+// VersionBump is a semantic version increment.
+type VersionBump int
+
+const (
+	// BumpNone means the version does not need to change.
+	BumpNone VersionBump = iota
+
+	// BumpPatch means the patch version should be incremented, nothing a client can use has changed.
+	BumpPatch
+
+	// BumpMinor means the minor version should be incremented, something was added that does not break clients.
+	BumpMinor
+
+	// BumpMajor means the major version should be incremented, something was changed that breaks clients.
+	BumpMajor
+)
+
+// String returns the name of a version bump.
+func (b VersionBump) String() string {
+	switch b {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	}
+	return "none"
+}
+
+// VersionRecommendation is the version bump recommended for a set of changes, along with the changes that drove
+// the decision.
+type VersionRecommendation struct {
+	// Bump is the recommended version increment.
+	Bump VersionBump `json:"bump" yaml:"bump"`
+
+	// Reason explains the recommendation in a sentence.
+	Reason string `json:"reason" yaml:"reason"`
+
+	// Rationale holds the changes that drove the decision: breaking changes for a major bump, additions for a minor
+	// bump and everything else for a patch bump.
+	Rationale []*Change `json:"rationale,omitempty" yaml:"rationale,omitempty"`
+
+	// BreakingChanges, Additions and OtherChanges count every change considered.
+	BreakingChanges int `json:"breakingChanges" yaml:"breakingChanges"`
+	Additions       int `json:"additions" yaml:"additions"`
+	OtherChanges    int `json:"otherChanges" yaml:"otherChanges"`
+}
+
+// RecommendVersionBump recommends a semantic version bump for info.version, based on the changes made to a
+// document. Any breaking change requires a major bump, any (non-breaking) addition a minor bump, and anything else
+// a patch bump. A change to info.version itself is ignored.
+func RecommendVersionBump(changes *DocumentChanges) *VersionRecommendation {
+	rec := new(VersionRecommendation)
+	var breaking, additions, other []*Change
+	for _, c := range changes.GetAllChanges() {
+		if isVersionChange(changes, c) {
+			continue
+		}
+		switch {
+		case c.Breaking:
+			breaking = append(breaking, c)
+		case c.ChangeType == PropertyAdded || c.ChangeType == ObjectAdded:
+			additions = append(additions, c)
+		default:
+			other = append(other, c)
+		}
+	}
+	rec.BreakingChanges, rec.Additions, rec.OtherChanges = len(breaking), len(additions), len(other)
+
+	switch {
+	case len(breaking) > 0:
+		rec.Bump, rec.Rationale = BumpMajor, breaking
+		rec.Reason = fmt.Sprintf("%d breaking %s", len(breaking), pluralChanges(len(breaking)))
+	case len(additions) > 0:
+		rec.Bump, rec.Rationale = BumpMinor, additions
+		rec.Reason = fmt.Sprintf("%d non-breaking %s", len(additions), pluralAdditions(len(additions)))
+	case len(other) > 0:
+		rec.Bump, rec.Rationale = BumpPatch, other
+		rec.Reason = fmt.Sprintf("%d non-breaking %s, with nothing added", len(other), pluralChanges(len(other)))
+	default:
+		rec.Reason = "no changes"
+	}
+	return rec
+}
+
+// isVersionChange returns true if the change is to info.version.
+func isVersionChange(changes *DocumentChanges, c *Change) bool {
+	if changes == nil || changes.InfoChanges == nil || c.Property != v3.VersionLabel {
+		return false
+	}
+	for _, ic := range changes.InfoChanges.Changes {
+		if ic == c {
+			return true
+		}
+	}
+	return false
+}
+
+// VersionCheck is the result of checking a proposed version against the changes made to a document.
+type VersionCheck struct {
+	// Recommendation is the version bump required by the changes.
+	Recommendation *VersionRecommendation `json:"recommendation" yaml:"recommendation"`
+
+	// Required is the smallest bump that is acceptable, Proposed is the bump that was made.
+	Required VersionBump `json:"required" yaml:"required"`
+	Proposed VersionBump `json:"proposed" yaml:"proposed"`
+
+	// Problems describes why the proposed version is not acceptable, it's empty if the version is valid.
+	Problems []string `json:"problems,omitempty" yaml:"problems,omitempty"`
+}
+
+// Valid returns true if the proposed version is acceptable.
+func (v *VersionCheck) Valid() bool {
+	return len(v.Problems) == 0
+}
+
+// CheckVersionBump validates a proposed info.version against the changes made to a document, and the previous
+// version. Versions are semantic versions (an optional 'v' prefix, and missing minor or patch numbers are allowed).
+//
+// Before 1.0.0 anything may change, so a breaking change only requires a minor bump and an addition only requires
+// a patch bump. An error is returned if either version can't be read.
+func CheckVersionBump(changes *DocumentChanges, previous, proposed string) (*VersionCheck, error) {
+	prev, err := parseVersion(previous)
+	if err != nil {
+		return nil, err
+	}
+	next, err := parseVersion(proposed)
+	if err != nil {
+		return nil, err
+	}
+	check := &VersionCheck{Recommendation: RecommendVersionBump(changes)}
+	check.Required = check.Recommendation.Bump
+	if prev[0] == 0 && check.Required > BumpPatch {
+		check.Required--
+	}
+
+	switch {
+	case next[0] != prev[0]:
+		check.Proposed = BumpMajor
+		if next[0] < prev[0] {
+			check.Problems = append(check.Problems, fmt.Sprintf("version %s is lower than %s", proposed, previous))
+		}
+	case next[1] != prev[1]:
+		check.Proposed = BumpMinor
+		if next[1] < prev[1] {
+			check.Problems = append(check.Problems, fmt.Sprintf("version %s is lower than %s", proposed, previous))
+		}
+	case next[2] != prev[2]:
+		check.Proposed = BumpPatch
+		if next[2] < prev[2] {
+			check.Problems = append(check.Problems, fmt.Sprintf("version %s is lower than %s", proposed, previous))
+		}
+	}
+
+	if check.Proposed < check.Required {
+		rec := check.Recommendation
+		var found string
+		switch rec.Bump {
+		case BumpMajor:
+			found = fmt.Sprintf("%d breaking %s", rec.BreakingChanges, pluralChanges(rec.BreakingChanges))
+		case BumpMinor:
+			found = fmt.Sprintf("%d %s", rec.Additions, pluralAdditions(rec.Additions))
+		default:
+			found = fmt.Sprintf("%d %s", rec.OtherChanges, pluralChanges(rec.OtherChanges))
+		}
+		if check.Proposed == BumpNone {
+			check.Problems = append(check.Problems, fmt.Sprintf("%s without a version bump, %s bump required",
+				found, check.Required))
+		} else {
+			check.Problems = append(check.Problems, fmt.Sprintf("%s with only a %s bump, %s bump required",
+				found, check.Proposed, check.Required))
+		}
+	}
+	return check, nil
+}
+
+// parseVersion reads the major, minor and patch numbers of a semantic version. Pre-release and build metadata
+// are ignored.
+func parseVersion(version string) ([3]int, error) {
+	var parsed [3]int
+	v := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if v == "" || len(parts) > 3 {
+		return parsed, fmt.Errorf("unable to read version '%s': not a semantic version", version)
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return parsed, fmt.Errorf("unable to read version '%s': not a semantic version", version)
+		}
+		parsed[i] = n
+	}
+	return parsed, nil
+}
+
+func pluralChanges(n int) string {
+	if n == 1 {
+		return "change"
+	}
+	return "changes"
+}
+
+func pluralAdditions(n int) string {
+	if n == 1 {
+		return "addition"
+	}
+	return "additions"
+}
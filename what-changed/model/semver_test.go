@@ -0,0 +1,134 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const semverBase = `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.2.3
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+      responses:
+        "200":
+          description: ok`
+
+func TestRecommendVersionBump(t *testing.T) {
+	// breaking: a parameter is removed.
+	breaking := compareDirectionDocs(t, semverBase, `openapi: 3.1.0
+info:
+  title: pets
+  version: 2.0.0
+paths:
+  /pets:
+    get:
+      description: list all the pets
+      responses:
+        "200":
+          description: ok`)
+	rec := RecommendVersionBump(breaking)
+	assert.Equal(t, BumpMajor, rec.Bump)
+	assert.Equal(t, "major", rec.Bump.String())
+	assert.Equal(t, 1, rec.BreakingChanges)
+	assert.Equal(t, 1, rec.OtherChanges) // the version change is ignored.
+	require.Len(t, rec.Rationale, 1)
+	assert.True(t, rec.Rationale[0].Breaking)
+	assert.Equal(t, "1 breaking change", rec.Reason)
+
+	// minor: a response is added.
+	minor := compareDirectionDocs(t, semverBase, semverBase+`
+        "404":
+          description: not found`)
+	rec = RecommendVersionBump(minor)
+	assert.Equal(t, BumpMinor, rec.Bump)
+	require.Len(t, rec.Rationale, 1)
+	assert.Equal(t, ObjectAdded, rec.Rationale[0].ChangeType)
+
+	// patch: a description is changed.
+	patch := compareDirectionDocs(t, semverBase, `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.2.4
+paths:
+  /pets:
+    get:
+      description: list the pets
+      parameters:
+        - name: limit
+          in: query
+      responses:
+        "200":
+          description: ok`)
+	rec = RecommendVersionBump(patch)
+	assert.Equal(t, BumpPatch, rec.Bump)
+	assert.Len(t, rec.Rationale, 1)
+
+	rec = RecommendVersionBump(nil)
+	assert.Equal(t, BumpNone, rec.Bump)
+	assert.Equal(t, "none", rec.Bump.String())
+	assert.Empty(t, rec.Rationale)
+}
+
+func TestCheckVersionBump(t *testing.T) {
+	changes := compareDirectionDocs(t, semverBase, `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.3.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      responses:
+        "200":
+          description: ok`)
+
+	check, err := CheckVersionBump(changes, "1.2.3", "1.3.0")
+	require.NoError(t, err)
+	assert.False(t, check.Valid())
+	assert.Equal(t, BumpMajor, check.Required)
+	assert.Equal(t, BumpMinor, check.Proposed)
+	assert.Equal(t, []string{"1 breaking change with only a minor bump, major bump required"}, check.Problems)
+
+	check, err = CheckVersionBump(changes, "v1.2.3", "v2.0.0")
+	require.NoError(t, err)
+	assert.True(t, check.Valid())
+
+	check, err = CheckVersionBump(changes, "1.2.3", "1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1 breaking change without a version bump, major bump required"}, check.Problems)
+
+	// anything can change before 1.0.0.
+	check, err = CheckVersionBump(changes, "0.4.1", "0.5.0-beta.1")
+	require.NoError(t, err)
+	assert.True(t, check.Valid())
+	assert.Equal(t, BumpMinor, check.Required)
+
+	check, err = CheckVersionBump(changes, "2.0", "1.9")
+	require.NoError(t, err)
+	assert.Contains(t, check.Problems, "version 1.9 is lower than 2.0")
+
+	check, err = CheckVersionBump(nil, "1.0.0", "1.0.0")
+	require.NoError(t, err)
+	assert.True(t, check.Valid())
+}
+
+func TestCheckVersionBump_Errors(t *testing.T) {
+	_, err := CheckVersionBump(nil, "one", "1.0.0")
+	assert.EqualError(t, err, "unable to read version 'one': not a semantic version")
+	_, err = CheckVersionBump(nil, "1.0.0", "1.0.0.0")
+	assert.Error(t, err)
+	_, err = CheckVersionBump(nil, "1.0.0", "")
+	assert.Error(t, err)
+}
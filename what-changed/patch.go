@@ -0,0 +1,215 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"gopkg.in/yaml.v3"
+)
+
+// PatchStatus is what happened to a change when it was applied to a document.
+type PatchStatus int
+
+const (
+	// PatchApplied means the change was made to the document.
+	PatchApplied PatchStatus = iota + 1
+
+	// PatchSkipped means the document already had the change.
+	PatchSkipped
+
+	// PatchConflicted means the document was changed differently, so the change was not made.
+	PatchConflicted
+)
+
+// String returns the name of a patch status.
+func (s PatchStatus) String() string {
+	switch s {
+	case PatchApplied:
+		return "applied"
+	case PatchSkipped:
+		return "skipped"
+	case PatchConflicted:
+		return "conflicted"
+	}
+	return "unknown"
+}
+
+// PatchOutcome is what happened to a single change.
+type PatchOutcome struct {
+	// Change is the change that was applied.
+	Change *model.Change `json:"change" yaml:"change"`
+
+	// Status is what happened to it.
+	Status PatchStatus `json:"status" yaml:"status"`
+
+	// Pointer is the JSON pointer of the object the change was made in, in the patched document.
+	Pointer string `json:"pointer" yaml:"pointer"`
+
+	// Reason explains why a change was skipped or conflicted.
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// PatchResult is the result of applying changes to a document.
+type PatchResult struct {
+	// Document is the patched document.
+	Document *v3high.Document `json:"-" yaml:"-"`
+
+	// Spec is the patched document, rendered as YAML.
+	Spec []byte `json:"-" yaml:"-"`
+
+	// Outcomes holds what happened to every change, in the same order as DocumentChanges.GetAllChanges.
+	Outcomes []*PatchOutcome `json:"outcomes,omitempty" yaml:"outcomes,omitempty"`
+}
+
+// Filter returns the outcomes with a status.
+func (p *PatchResult) Filter(status PatchStatus) []*PatchOutcome {
+	var outcomes []*PatchOutcome
+	for _, o := range p.Outcomes {
+		if o.Status == status {
+			outcomes = append(outcomes, o)
+		}
+	}
+	return outcomes
+}
+
+// PatchOptions are the optional settings of ApplyChanges.
+type PatchOptions struct {
+	// Original and Modified are the documents the changes were found between. They are only used when a document
+	// can't be found from the OriginalObject and NewObject of the changes, for example changes that have had their
+	// objects removed (like the changes in a Timeline), or changes that only hold scalar values.
+	Original *v3high.Document
+	Modified *v3high.Document
+}
+
+// ApplyChanges applies the changes made between two documents (the original and the modified document) to another
+// document, for example a fork of the original. A nil configuration uses the default, and options can be nil.
+//
+// The original and modified documents are found from the OriginalObject and NewObject of the changes, and from the
+// options if they can't be. Each object changed is compared in all three documents: if the target has the original
+// value the change is applied, if it already has the modified value the change is skipped, and anything else is a
+// conflict. Changes to an item in an array are applied to the whole array, unless both the changes and the target
+// changed items without adding or removing any.
+//
+// An error is returned if the target or the original and modified documents have no content, or the patched
+// document can't be built.
+func ApplyChanges(changes *model.DocumentChanges, target *v3high.Document, config *datamodel.DocumentConfiguration,
+	options *PatchOptions,
+) (*PatchResult, error) {
+	if config == nil {
+		config = datamodel.NewDocumentConfiguration()
+	}
+	if target == nil || target.GoLow() == nil {
+		return nil, errors.New("unable to apply changes: a target document is required")
+	}
+	t := revisionRoot(target.GoLow())
+	if t.node == nil {
+		return nil, errors.New("unable to apply changes: the target document has no content")
+	}
+
+	all := changes.GetAllChanges()
+	original, modified := changedDocuments(all)
+	if options != nil {
+		if original == nil && options.Original != nil && options.Original.GoLow() != nil {
+			original = revisionRoot(options.Original.GoLow()).node
+		}
+		if modified == nil && options.Modified != nil && options.Modified.GoLow() != nil {
+			modified = revisionRoot(options.Modified.GoLow()).node
+		}
+	}
+	if len(all) > 0 && (original == nil || modified == nil) {
+		return nil, errors.New("unable to apply changes: the original and modified documents can't be found " +
+			"from the changes, supply them with PatchOptions")
+	}
+	m := &merger{base: original, ours: modified, theirs: t.node, merged: cloneNode(t.node)}
+
+	outcomes := make(map[*model.Change]*PatchOutcome)
+	for _, e := range m.edits(changes, modified) {
+		status, reason := m.patch(e.path)
+		for _, c := range e.changes {
+			outcomes[c] = &PatchOutcome{Change: c, Status: status, Pointer: model.JSONPointer(e.path), Reason: reason}
+		}
+	}
+
+	result := new(PatchResult)
+	for _, c := range all {
+		if o := outcomes[c]; o != nil {
+			result.Outcomes = append(result.Outcomes, o)
+		}
+	}
+	var err error
+	if result.Spec, result.Document, err = buildDocument(m.merged, config); err != nil {
+		return nil, fmt.Errorf("unable to build patched document: %w", err)
+	}
+	return result, nil
+}
+
+// patch makes the change to an object in the target document, if the target has the original value.
+func (m *merger) patch(path []string) (PatchStatus, string) {
+	if len(path) == 0 {
+		return PatchConflicted, "the change can't be located"
+	}
+	o, n, t := lookupNode(m.base, path), lookupNode(m.ours, path), lookupNode(m.theirs, path)
+	if nodesEqual(t, n) {
+		return PatchSkipped, "the target already has the change"
+	}
+	if nodesEqual(t, o) {
+		m.apply(path, n)
+		return PatchApplied, ""
+	}
+	if merged, conflicts, ok := mergeItems(o, n, t); ok && len(conflicts) == 0 {
+		m.apply(path, merged)
+		return PatchApplied, ""
+	}
+	if t == nil {
+		return PatchConflicted, "the target does not have the object"
+	}
+	return PatchConflicted, "the target has changed the object"
+}
+
+// changedDocuments finds the root nodes of the original and modified documents from the objects held by changes.
+func changedDocuments(changes []*model.Change) (*yaml.Node, *yaml.Node) {
+	var original, modified *yaml.Node
+	for _, c := range changes {
+		if original == nil {
+			original = objectDocument(c.OriginalObject)
+		}
+		if modified == nil {
+			modified = objectDocument(c.NewObject)
+		}
+		if original != nil && modified != nil {
+			break
+		}
+	}
+	return original, modified
+}
+
+// objectDocument returns the root node of the document a low level object was built from.
+func objectDocument(obj any) *yaml.Node {
+	hs, ok := obj.(base.HasIndex)
+	if !ok {
+		return nil
+	}
+	if v := reflect.ValueOf(hs); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	idx := hs.GetIndex()
+	if idx == nil {
+		return nil
+	}
+	if r := idx.GetRolodex(); r != nil && r.GetRootIndex() != nil {
+		idx = r.GetRootIndex()
+	}
+	root := idx.GetRootNode()
+	if root != nil && root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	return root
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package what_changed
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const patchOriginal = `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      description: list pets
+      parameters:
+        - name: limit
+          in: query
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object`
+
+const patchModified = `openapi: 3.1.0
+info:
+  title: pet store
+  version: 1.1.0
+paths:
+  /pets:
+    get:
+      description: list all the pets
+      parameters:
+        - name: limit
+          in: query
+          description: how many
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      description: a pet
+    Toy:
+      type: string`
+
+// the fork changed the version and the description, and already describes the pet.
+const patchFork = `openapi: 3.1.0
+info:
+  title: pets
+  version: 1.0.0-fork
+paths:
+  /pets:
+    get:
+      description: list the pets we sell
+      parameters:
+        - name: limit
+          in: query
+      responses:
+        "200":
+          description: ok
+  /vendors:
+    get:
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      description: a pet`
+
+func outcomeFor(t *testing.T, result *PatchResult, pointer string) *PatchOutcome {
+	t.Helper()
+	for _, o := range result.Outcomes {
+		if o.Pointer == pointer {
+			return o
+		}
+	}
+	require.Failf(t, "no outcome", "no outcome for %s", pointer)
+	return nil
+}
+
+func TestApplyChanges(t *testing.T) {
+	original, modified := mergeDoc(t, patchOriginal), mergeDoc(t, patchModified)
+	changes := CompareOpenAPIDocuments(original.GoLow(), modified.GoLow())
+	require.NotNil(t, changes)
+
+	result, err := ApplyChanges(changes, mergeDoc(t, patchFork), nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Outcomes, len(changes.GetAllChanges()))
+
+	assert.Equal(t, PatchApplied, outcomeFor(t, result, "/info/title").Status)
+	assert.Equal(t, PatchApplied, outcomeFor(t, result, "/components/schemas/Toy").Status)
+	assert.Equal(t, PatchApplied, outcomeFor(t, result, "/paths/~1pets/get/parameters").Status)
+
+	skipped := outcomeFor(t, result, "/components/schemas/Pet/description")
+	assert.Equal(t, PatchSkipped, skipped.Status)
+	assert.Equal(t, "skipped", skipped.Status.String())
+
+	conflicted := outcomeFor(t, result, "/paths/~1pets/get/description")
+	assert.Equal(t, PatchConflicted, conflicted.Status)
+	assert.Equal(t, "the target has changed the object", conflicted.Reason)
+	assert.Equal(t, "list all the pets", conflicted.Change.New)
+	assert.Len(t, result.Filter(PatchConflicted), 2) // the version too.
+
+	doc := result.Document
+	require.NotNil(t, doc)
+	assert.Equal(t, "pet store", doc.Info.Title)
+	assert.Equal(t, "1.0.0-fork", doc.Info.Version)
+	get := doc.Paths.PathItems.GetOrZero("/pets").Get
+	assert.Equal(t, "list the pets we sell", get.Description)
+	assert.Equal(t, "how many", get.Parameters[0].Description)
+	assert.NotNil(t, doc.Paths.PathItems.GetOrZero("/vendors"))
+	assert.NotNil(t, doc.Components.Schemas.GetOrZero("Toy"))
+}
+
+func TestApplyChanges_Removed(t *testing.T) {
+	original, modified := mergeDoc(t, patchModified), mergeDoc(t, patchOriginal)
+	changes := CompareOpenAPIDocuments(original.GoLow(), modified.GoLow())
+
+	result, err := ApplyChanges(changes, mergeDoc(t, patchModified), nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, result.Filter(PatchConflicted))
+	assert.Nil(t, result.Document.Components.Schemas.GetOrZero("Toy"))
+	assert.Equal(t, "pets", result.Document.Info.Title)
+}
+
+func TestApplyChanges_Errors(t *testing.T) {
+	_, err := ApplyChanges(nil, nil, nil, nil)
+	assert.EqualError(t, err, "unable to apply changes: a target document is required")
+
+	// nothing to apply.
+	result, err := ApplyChanges(nil, mergeDoc(t, patchFork), nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, result.Outcomes)
+	assert.Equal(t, "unknown", PatchStatus(0).String())
+}
+
+func TestApplyChanges_Scalar(t *testing.T) {
+	original := mergeDoc(t, "openapi: 3.1.0\ninfo:\n  title: pets\n  version: 1.0.0")
+	modified := mergeDoc(t, "openapi: 3.1.0\ninfo:\n  title: pet store\n  version: 1.0.0")
+	changes := CompareOpenAPIDocuments(original.GoLow(), modified.GoLow())
+
+	result, err := ApplyChanges(changes, mergeDoc(t, "openapi: 3.1.0\ninfo:\n  title: pets\n  version: 2.0.0"), nil, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Outcomes, 1)
+	assert.Equal(t, PatchApplied, result.Outcomes[0].Status)
+	assert.Equal(t, "pet store", result.Document.Info.Title)
+}
+
+// changes without objects to find the documents from need the documents supplied as options.
+func TestApplyChanges_Options(t *testing.T) {
+	spec := "openapi: 3.1.0\ninfo:\n  title: pets\n  version: 1.0.0\nservers:\n  - url: %s\n"
+	original := mergeDoc(t, fmt.Sprintf(spec, "https://api.example.com"))
+	modified := mergeDoc(t, fmt.Sprintf(spec, "https://api.example.com/v2"))
+	changes := CompareOpenAPIDocuments(original.GoLow(), modified.GoLow())
+	require.Len(t, changes.GetAllChanges(), 2) // the server is removed and added, the changes hold the URLs.
+
+	_, err := ApplyChanges(changes, mergeDoc(t, fmt.Sprintf(spec, "https://api.example.com")), nil, nil)
+	assert.EqualError(t, err, "unable to apply changes: the original and modified documents can't be found "+
+		"from the changes, supply them with PatchOptions")
+
+	options := &PatchOptions{Original: original, Modified: modified}
+	result, err := ApplyChanges(changes, mergeDoc(t, fmt.Sprintf(spec, "https://api.example.com")), nil, options)
+	require.NoError(t, err)
+	assert.Len(t, result.Filter(PatchApplied), 2)
+	assert.Equal(t, "https://api.example.com/v2", result.Document.Servers[0].URL)
+
+	result, err = ApplyChanges(changes, mergeDoc(t, fmt.Sprintf(spec, "https://api.example.com/v2")), nil, options)
+	require.NoError(t, err)
+	assert.Len(t, result.Filter(PatchSkipped), 2)
+
+	// changes that have had their objects removed, like the changes in a timeline.
+	original, modified = mergeDoc(t, patchOriginal), mergeDoc(t, patchModified)
+	changes = CompareOpenAPIDocuments(original.GoLow(), modified.GoLow())
+	for _, c := range changes.GetAllChanges() {
+		c.OriginalObject, c.NewObject = nil, nil
+	}
+	options = &PatchOptions{Original: original, Modified: modified}
+	result, err = ApplyChanges(changes, mergeDoc(t, patchFork), nil, options)
+	require.NoError(t, err)
+	assert.Equal(t, PatchApplied, outcomeFor(t, result, "/components/schemas/Toy").Status)
+	assert.Len(t, result.Filter(PatchConflicted), 2)
+}
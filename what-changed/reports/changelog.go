@@ -0,0 +1,426 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2 "github.com/pb33f/libopenapi/datamodel/low/v2"
+	v3 "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/what-changed/model"
+)
+
+// Changelog groups every change found between two documents by path and operation, then by breaking and
+// non-breaking. Each change is described in plain language, ready to be rendered as Markdown, HTML or JSON Lines.
+type Changelog struct {
+	TotalChanges    int               `json:"totalChanges"`
+	BreakingChanges int               `json:"breakingChanges"`
+	Groups          []*ChangelogGroup `json:"groups,omitempty"`
+}
+
+// ChangelogGroup holds the changes made to a single operation, path, webhook, the components or the document.
+type ChangelogGroup struct {
+	Title       string            `json:"title"`
+	Path        string            `json:"path,omitempty"`
+	Method      string            `json:"method,omitempty"`
+	Breaking    []*ChangelogEntry `json:"breaking,omitempty"`
+	NonBreaking []*ChangelogEntry `json:"nonBreaking,omitempty"`
+
+	kind int
+}
+
+// ChangelogEntry describes a single change.
+type ChangelogEntry struct {
+	// Group is the title of the group the entry belongs to, for example GET /pets.
+	Group string `json:"group"`
+
+	// Path and Method locate the operation the change was made to (if any).
+	Path   string `json:"path,omitempty"`
+	Method string `json:"method,omitempty"`
+
+	// Pointer is the JSON pointer to the property that changed.
+	Pointer string `json:"pointer,omitempty"`
+
+	// Summary describes the change without the group, Description describes it in full.
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+
+	Property   string   `json:"property,omitempty"`
+	ChangeType string   `json:"change"`
+	Original   string   `json:"original,omitempty"`
+	New        string   `json:"new,omitempty"`
+	Breaking   bool     `json:"breaking"`
+	References []string `json:"references,omitempty"`
+
+	// Change is the change being described.
+	Change *model.Change `json:"-"`
+}
+
+// kinds of group, in the order they are rendered.
+const (
+	groupOperation = iota
+	groupPath
+	groupWebhook
+	groupComponents
+	groupDocument
+)
+
+const (
+	componentsTitle = "Components"
+	documentTitle   = "Document"
+)
+
+// maxValueLength is the longest a value can be before it's shortened in a description.
+const maxValueLength = 80
+
+var operationMethods = []string{
+	v3.GetLabel, v3.PutLabel, v3.PostLabel, v3.DeleteLabel, v3.OptionsLabel,
+	v3.HeadLabel, v3.PatchLabel, v3.TraceLabel, v3.QueryLabel,
+}
+
+// componentNames describes the kinds of component that can be changed, by their key in the specification.
+var componentNames = map[string]string{
+	v3.SchemasLabel:             "Schema",
+	v2.DefinitionsLabel:         "Schema",
+	v3.SecuritySchemesLabel:     "Security scheme",
+	v2.SecurityDefinitionsLabel: "Security scheme",
+	v3.ParametersLabel:          "Parameter",
+	v3.ResponsesLabel:           "Response",
+	v3.ExamplesLabel:            "Example",
+	v3.RequestBodiesLabel:       "Request body",
+	v3.HeadersLabel:             "Header",
+	v3.LinksLabel:               "Link",
+	v3.CallbacksLabel:           "Callback",
+	v3.PathItemsLabel:           "Path item",
+}
+
+// CreateChangelog will create a changelog from every change made between two documents. Changes are expected to
+// have a Path set, which CompareDocuments does.
+func CreateChangelog(changes *model.DocumentChanges) *Changelog {
+	cl := new(Changelog)
+	if changes == nil {
+		return cl
+	}
+	params := make(map[*model.Change]*model.ParameterChanges)
+	if changes.PathsChanges != nil {
+		for _, pi := range changes.PathsChanges.PathItemsChanges {
+			collectParameters(pi, params)
+		}
+	}
+	for _, pi := range changes.WebhookChanges {
+		collectParameters(pi, params)
+	}
+
+	groups := make(map[string]*ChangelogGroup)
+	for _, c := range changes.GetAllChanges() {
+		group, rest := changelogGroup(unescapePointer(c.Path))
+		if existing, ok := groups[group.Title]; ok {
+			group = existing
+		} else {
+			groups[group.Title] = group
+			cl.Groups = append(cl.Groups, group)
+		}
+		entry := createEntry(c, group, rest, params[c])
+		if entry.Breaking {
+			group.Breaking = append(group.Breaking, entry)
+			cl.BreakingChanges++
+		} else {
+			group.NonBreaking = append(group.NonBreaking, entry)
+		}
+		cl.TotalChanges++
+	}
+
+	sort.SliceStable(cl.Groups, func(i, j int) bool {
+		a, b := cl.Groups[i], cl.Groups[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return methodRank(a.Method) < methodRank(b.Method)
+	})
+	for _, g := range cl.Groups {
+		sortEntries(g.Breaking)
+		sortEntries(g.NonBreaking)
+	}
+	return cl
+}
+
+// collectParameters maps every change made to a parameter, to the parameter changes that hold it, so the parameter
+// can be named in descriptions.
+func collectParameters(pi *model.PathItemChanges, params map[*model.Change]*model.ParameterChanges) {
+	if pi == nil {
+		return
+	}
+	add := func(pcs []*model.ParameterChanges) {
+		for _, pc := range pcs {
+			for _, c := range pc.GetAllChanges() {
+				params[c] = pc
+			}
+		}
+	}
+	add(pi.ParameterChanges)
+	ops := []*model.OperationChanges{
+		pi.GetChanges, pi.PutChanges, pi.PostChanges, pi.DeleteChanges, pi.OptionsChanges,
+		pi.HeadChanges, pi.PatchChanges, pi.TraceChanges, pi.QueryChanges,
+	}
+	for _, op := range pi.AdditionalOperationChanges {
+		ops = append(ops, op)
+	}
+	for _, op := range ops {
+		if op == nil {
+			continue
+		}
+		add(op.ParameterChanges)
+		for _, cb := range op.CallbackChanges {
+			for _, expression := range cb.ExpressionChanges {
+				collectParameters(expression, params)
+			}
+		}
+	}
+}
+
+// changelogGroup works out the group a change belongs to from its path, the remaining segments are returned.
+func changelogGroup(segments []string) (*ChangelogGroup, []string) {
+	if len(segments) > 1 && segments[0] == v3.PathsLabel && strings.HasPrefix(segments[1], "/") {
+		path := segments[1]
+		if method, rest, ok := operationMethod(segments[2:]); ok {
+			return &ChangelogGroup{
+				Title:  fmt.Sprintf("%s %s", strings.ToUpper(method), path),
+				Path:   path,
+				Method: method,
+				kind:   groupOperation,
+			}, rest
+		}
+		return &ChangelogGroup{Title: path, Path: path, kind: groupPath}, segments[2:]
+	}
+	if len(segments) > 1 && segments[0] == v3.WebhooksLabel {
+		name := segments[1]
+		if method, rest, ok := operationMethod(segments[2:]); ok {
+			return &ChangelogGroup{
+				Title:  fmt.Sprintf("%s %s (webhook)", strings.ToUpper(method), name),
+				Path:   name,
+				Method: method,
+				kind:   groupWebhook,
+			}, rest
+		}
+		return &ChangelogGroup{Title: fmt.Sprintf("%s (webhook)", name), Path: name, kind: groupWebhook}, segments[2:]
+	}
+	if len(segments) > 0 {
+		switch segments[0] {
+		case v3.ComponentsLabel:
+			return &ChangelogGroup{Title: componentsTitle, kind: groupComponents}, segments[1:]
+		case v2.DefinitionsLabel, v2.SecurityDefinitionsLabel:
+			return &ChangelogGroup{Title: componentsTitle, kind: groupComponents}, segments
+		}
+	}
+	return &ChangelogGroup{Title: documentTitle, kind: groupDocument}, segments
+}
+
+func operationMethod(segments []string) (string, []string, bool) {
+	if len(segments) == 0 {
+		return "", nil, false
+	}
+	if segments[0] == v3.AdditionalOperationsLabel && len(segments) > 1 {
+		return segments[1], segments[2:], true
+	}
+	for _, m := range operationMethods {
+		if segments[0] == m {
+			return m, segments[1:], true
+		}
+	}
+	return "", nil, false
+}
+
+// createEntry describes a change, using the segments of its path that follow the group.
+func createEntry(c *model.Change, group *ChangelogGroup, rest []string, param *model.ParameterChanges) *ChangelogEntry {
+	subject, rest := changeSubject(group, rest, param)
+
+	// a trailing index (like an enum value) is described by its value, not its position.
+	if len(rest) > 0 {
+		if _, err := strconv.Atoi(rest[len(rest)-1]); err == nil {
+			rest = rest[:len(rest)-1]
+		}
+	}
+	field := strings.Join(rest, ".")
+	if subject == "" && field == "" {
+		field = c.Property
+	}
+
+	var summary string
+	switch {
+	case field == "":
+		summary = fmt.Sprintf("%s %s", subject, changeVerb(c, false))
+	case subject == "":
+		summary = fmt.Sprintf("%s %s", field, changeVerb(c, true))
+	default:
+		summary = fmt.Sprintf("%s: %s %s", subject, field, changeVerb(c, true))
+	}
+
+	var description string
+	switch group.kind {
+	case groupOperation, groupPath, groupWebhook:
+		if subject != "" {
+			description = fmt.Sprintf("%s on %s", subject, group.Title)
+		} else {
+			description = group.Title
+		}
+		if field == "" {
+			description = fmt.Sprintf("%s %s", description, changeVerb(c, false))
+		} else {
+			description = fmt.Sprintf("%s: %s %s", description, field, changeVerb(c, true))
+		}
+	default:
+		description = summary
+	}
+	if c.Breaking {
+		description += " (breaking)"
+	}
+
+	return &ChangelogEntry{
+		Group:       group.Title,
+		Path:        group.Path,
+		Method:      group.Method,
+		Pointer:     c.Path,
+		Summary:     summary,
+		Description: description,
+		Property:    c.Property,
+		ChangeType:  changeTypeName(c.ChangeType),
+		Original:    c.Original,
+		New:         c.New,
+		Breaking:    c.Breaking,
+		References:  c.References,
+		Change:      c,
+	}
+}
+
+// changeSubject names the object that was changed (a parameter, request body, response or component), the segments
+// that follow the object are returned.
+func changeSubject(group *ChangelogGroup, rest []string, param *model.ParameterChanges) (string, []string) {
+	if len(rest) < 2 {
+		return "", rest
+	}
+	if group.kind == groupComponents {
+		if name, ok := componentNames[rest[0]]; ok {
+			return fmt.Sprintf("%s `%s`", name, rest[1]), rest[2:]
+		}
+		return "", rest
+	}
+	var subject string
+	switch rest[0] {
+	case v3.ParametersLabel:
+		if param != nil && param.Name != "" {
+			subject = fmt.Sprintf("Parameter `%s`", param.Name)
+			if param.In != "" {
+				subject = fmt.Sprintf("%s in %s", subject, param.In)
+			}
+		} else {
+			subject = fmt.Sprintf("Parameter %s", rest[1])
+		}
+		rest = rest[2:]
+	case v3.RequestBodyLabel:
+		subject = "Request body"
+		rest = rest[1:]
+	case v3.ResponsesLabel:
+		if strings.HasPrefix(rest[1], "x-") {
+			return "", rest
+		}
+		subject = fmt.Sprintf("Response `%s`", rest[1])
+		rest = rest[2:]
+	default:
+		return "", rest
+	}
+	if len(rest) > 1 && rest[0] == v3.ContentLabel {
+		subject = fmt.Sprintf("%s (%s)", subject, rest[1])
+		rest = rest[2:]
+	}
+	return subject, rest
+}
+
+// changeVerb describes what happened, values are included for additions and removals if asked for.
+func changeVerb(c *model.Change, values bool) string {
+	switch c.ChangeType {
+	case model.Modified:
+		if c.Original == "" && c.New == "" {
+			return "changed"
+		}
+		return fmt.Sprintf("changed %s → %s", shortValue(c.Original), shortValue(c.New))
+	case model.PropertyAdded, model.ObjectAdded:
+		if values && c.New != "" {
+			return fmt.Sprintf("added %s", shortValue(c.New))
+		}
+		return "added"
+	case model.PropertyRemoved, model.ObjectRemoved:
+		if values && c.Original != "" {
+			return fmt.Sprintf("removed %s", shortValue(c.Original))
+		}
+		return "removed"
+	case model.ObjectRenamed:
+		return fmt.Sprintf("renamed from `%s`", shortValue(c.Original))
+	}
+	return "changed"
+}
+
+func changeTypeName(changeType int) string {
+	switch changeType {
+	case model.Modified:
+		return "modified"
+	case model.PropertyAdded:
+		return "property_added"
+	case model.ObjectAdded:
+		return "object_added"
+	case model.ObjectRemoved:
+		return "object_removed"
+	case model.PropertyRemoved:
+		return "property_removed"
+	case model.ObjectRenamed:
+		return "object_renamed"
+	}
+	return ""
+}
+
+func shortValue(v string) string {
+	if v == "" {
+		return "(empty)"
+	}
+	v = strings.Join(strings.Fields(v), " ")
+	if r := []rune(v); len(r) > maxValueLength {
+		return string(r[:maxValueLength-1]) + "…"
+	}
+	return v
+}
+
+func unescapePointer(pointer string) []string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return nil
+	}
+	segments := strings.Split(pointer, "/")
+	for i := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segments[i], "~1", "/"), "~0", "~")
+	}
+	return segments
+}
+
+func methodRank(method string) int {
+	for i, m := range operationMethods {
+		if m == method {
+			return i
+		}
+	}
+	return len(operationMethods)
+}
+
+func sortEntries(entries []*ChangelogEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Pointer != entries[j].Pointer {
+			return entries[i].Pointer < entries[j].Pointer
+		}
+		return entries[i].Summary < entries[j].Summary
+	})
+}
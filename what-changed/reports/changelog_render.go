@@ -0,0 +1,130 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+const (
+	breakingHeading    = "Breaking changes"
+	nonBreakingHeading = "Other changes"
+)
+
+// RenderMarkdown renders the changelog as Markdown, one section per group.
+func (c *Changelog) RenderMarkdown() []byte {
+	var b strings.Builder
+	b.WriteString("# Changelog\n\n")
+	b.WriteString(c.totals())
+	for _, g := range c.Groups {
+		fmt.Fprintf(&b, "\n## %s\n", g.Title)
+		writeMarkdownEntries(&b, breakingHeading, g.Breaking)
+		writeMarkdownEntries(&b, nonBreakingHeading, g.NonBreaking)
+	}
+	return []byte(b.String())
+}
+
+func writeMarkdownEntries(b *strings.Builder, heading string, entries []*ChangelogEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n### %s\n\n", heading)
+	for _, e := range entries {
+		fmt.Fprintf(b, "- %s\n", e.Summary)
+	}
+}
+
+var changelogHTML = template.Must(template.New("changelog").Funcs(template.FuncMap{
+	"code": func(s string) template.HTML {
+		// subjects quote names with backticks, which are rendered as code.
+		parts := strings.Split(s, "`")
+		var b strings.Builder
+		for i, p := range parts {
+			if i%2 == 1 && i < len(parts)-1 {
+				b.WriteString("<code>" + template.HTMLEscapeString(p) + "</code>")
+				continue
+			}
+			if i%2 == 1 {
+				b.WriteString("`")
+			}
+			b.WriteString(template.HTMLEscapeString(p))
+		}
+		return template.HTML(b.String())
+	},
+}).Parse(`<section class="changelog">
+<h1>Changelog</h1>
+<p>{{.Totals}}</p>
+{{- range .Groups}}
+<section class="changelog-group">
+<h2>{{.Title}}</h2>
+{{- if .Breaking}}
+<h3>` + breakingHeading + `</h3>
+<ul class="breaking">
+{{- range .Breaking}}
+<li>{{code .Summary}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .NonBreaking}}
+<h3>` + nonBreakingHeading + `</h3>
+<ul class="non-breaking">
+{{- range .NonBreaking}}
+<li>{{code .Summary}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</section>
+{{- end}}
+</section>
+`))
+
+// RenderHTML renders the changelog as an HTML fragment, which can be embedded into a page.
+func (c *Changelog) RenderHTML() ([]byte, error) {
+	var buf bytes.Buffer
+	err := changelogHTML.Execute(&buf, struct {
+		Totals string
+		Groups []*ChangelogGroup
+	}{strings.TrimSpace(c.totals()), c.Groups})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderJSONLines renders every entry in the changelog as a JSON object on its own line, breaking changes are
+// rendered first for each group.
+func (c *Changelog) RenderJSONLines() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	for _, g := range c.Groups {
+		for _, entries := range [][]*ChangelogEntry{g.Breaking, g.NonBreaking} {
+			for _, e := range entries {
+				if err := enc.Encode(e); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *Changelog) totals() string {
+	if c.TotalChanges == 0 {
+		return "No changes.\n"
+	}
+	return fmt.Sprintf("%d %s, %d breaking.\n", c.TotalChanges, plural(c.TotalChanges, "change", "changes"),
+		c.BreakingChanges)
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
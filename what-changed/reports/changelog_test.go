@@ -0,0 +1,194 @@
+// Copyright 2025 Princess B33f Heavy Industries / Dave Shanley
+// SPDX-License-Identifier: MIT
+
+package reports
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/what-changed/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createChangelog(t *testing.T, left, right string) *Changelog {
+	t.Helper()
+	lDoc, err := libopenapi.NewDocument([]byte(left))
+	require.NoError(t, err)
+	rDoc, err := libopenapi.NewDocument([]byte(right))
+	require.NoError(t, err)
+	changes, errs := libopenapi.CompareDocuments(lDoc, rDoc)
+	require.Empty(t, errs)
+	return CreateChangelog(changes)
+}
+
+const changelogLeft = `openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      summary: list pets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            maximum: 100
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      description: a pet`
+
+const changelogRight = `openapi: 3.1.0
+paths:
+  /pets:
+    get:
+      summary: list all the pets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            maximum: 50
+      responses:
+        "200":
+          description: ok
+        "404":
+          description: <not found>
+components:
+  schemas:
+    Pet:
+      type: object
+      description: a good pet`
+
+func TestCreateChangelog(t *testing.T) {
+	cl := createChangelog(t, changelogLeft, changelogRight)
+	assert.Equal(t, 4, cl.TotalChanges)
+	assert.Equal(t, 1, cl.BreakingChanges)
+	require.Len(t, cl.Groups, 2)
+
+	op := cl.Groups[0]
+	assert.Equal(t, "GET /pets", op.Title)
+	assert.Equal(t, "/pets", op.Path)
+	assert.Equal(t, "get", op.Method)
+	require.Len(t, op.Breaking, 1)
+	assert.Equal(t, "Parameter `limit` in query on GET /pets: schema.maximum changed 100 → 50 (breaking)",
+		op.Breaking[0].Description)
+	assert.Equal(t, "/paths/~1pets/get/parameters/0/schema/maximum", op.Breaking[0].Pointer)
+
+	require.Len(t, op.NonBreaking, 2)
+	assert.Equal(t, "Response `404` added", op.NonBreaking[0].Summary)
+	assert.Equal(t, "Response `404` on GET /pets added", op.NonBreaking[0].Description)
+	assert.Equal(t, "summary changed list pets → list all the pets", op.NonBreaking[1].Summary)
+
+	components := cl.Groups[1]
+	assert.Equal(t, "Components", components.Title)
+	require.Len(t, components.NonBreaking, 1)
+	assert.Equal(t, "Schema `Pet`: description changed a pet → a good pet", components.NonBreaking[0].Description)
+}
+
+func TestCreateChangelog_Renamed(t *testing.T) {
+	left := `openapi: 3.1.0
+paths:
+  /pets/{id}:
+    get:
+      responses:
+        "200":
+          description: ok`
+	right := `openapi: 3.1.0
+paths:
+  /pets/{petId}:
+    get:
+      responses:
+        "200":
+          description: ok`
+
+	cl := createChangelog(t, left, right)
+	require.Len(t, cl.Groups, 1)
+	require.Len(t, cl.Groups[0].NonBreaking, 1)
+	e := cl.Groups[0].NonBreaking[0]
+	assert.Equal(t, "/pets/{petId}", cl.Groups[0].Title)
+	assert.Equal(t, "/pets/{petId}: path renamed from `/pets/{id}`", e.Description)
+	assert.Equal(t, "object_renamed", e.ChangeType)
+}
+
+func TestCreateChangelog_Empty(t *testing.T) {
+	cl := CreateChangelog(nil)
+	assert.Equal(t, 0, cl.TotalChanges)
+	assert.Equal(t, "# Changelog\n\nNo changes.\n", string(cl.RenderMarkdown()))
+}
+
+func TestCreateChangelog_BurgerShop(t *testing.T) {
+	changes := createDiff()
+	cl := CreateChangelog(changes)
+	assert.Equal(t, changes.TotalChanges(), cl.TotalChanges)
+	assert.Equal(t, model.CountBreakingChanges(changes.GetAllChanges()), cl.BreakingChanges)
+	assert.Equal(t, "POST /burgers", cl.Groups[0].Title)
+	assert.Equal(t, "Document", cl.Groups[len(cl.Groups)-1].Title)
+}
+
+func TestChangelog_RenderMarkdown(t *testing.T) {
+	out := string(createChangelog(t, changelogLeft, changelogRight).RenderMarkdown())
+	assert.Equal(t, `# Changelog
+
+4 changes, 1 breaking.
+
+## GET /pets
+
+### Breaking changes
+
+- Parameter `+"`limit`"+` in query: schema.maximum changed 100 → 50
+
+### Other changes
+
+- Response `+"`404`"+` added
+- summary changed list pets → list all the pets
+
+## Components
+
+### Other changes
+
+- Schema `+"`Pet`"+`: description changed a pet → a good pet
+`, out)
+}
+
+func TestChangelog_RenderHTML(t *testing.T) {
+	cl := createChangelog(t, changelogLeft, changelogRight)
+	cl.Groups[1].NonBreaking[0].Summary = "Schema `<Pet>`: description changed <b> → `x"
+	out, err := cl.RenderHTML()
+	require.NoError(t, err)
+	html := string(out)
+	assert.Contains(t, html, "<h2>GET /pets</h2>")
+	assert.Contains(t, html, `<ul class="breaking">`)
+	assert.Contains(t, html, "<li>Parameter <code>limit</code> in query: schema.maximum changed 100 → 50</li>")
+	assert.Contains(t, html, "<li>Schema <code>&lt;Pet&gt;</code>: description changed &lt;b&gt; → `x</li>")
+}
+
+func TestChangelog_RenderJSONLines(t *testing.T) {
+	cl := createChangelog(t, changelogLeft, changelogRight)
+	out, err := cl.RenderJSONLines()
+	require.NoError(t, err)
+
+	var entries []map[string]any
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		var e map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	require.Len(t, entries, 4)
+	assert.Equal(t, "GET /pets", entries[0]["group"])
+	assert.Equal(t, "modified", entries[0]["change"])
+	assert.Equal(t, true, entries[0]["breaking"])
+	assert.Equal(t, "100", entries[0]["original"])
+	assert.Equal(t, "50", entries[0]["new"])
+	assert.Equal(t, 4, strings.Count(string(out), "\n"))
+}
@@ -28,9 +28,25 @@ func CompareOpenAPIDocuments(original, updated *v3.Document) *model.DocumentChan
 	return model.CompareDocuments(original, updated)
 }
 
+// CompareOpenAPIDocumentsWithOptions compares OpenAPI 3+ documents like CompareOpenAPIDocuments, using the supplied
+// options (for example a breaking change ruleset).
+func CompareOpenAPIDocumentsWithOptions(original, updated *v3.Document,
+	options *model.CompareOptions,
+) *model.DocumentChanges {
+	return model.CompareDocumentsWithOptions(original, updated, options)
+}
+
 // CompareSwaggerDocuments will compare left (original) and a right (updated) Swagger documents and extract every change
 // made across the entire specification. The report outlines every property changes, everything that was added,
 // or removed and which of those changes were breaking.
 func CompareSwaggerDocuments(original, updated *v2.Swagger) *model.DocumentChanges {
 	return model.CompareDocuments(original, updated)
 }
+
+// CompareSwaggerDocumentsWithOptions compares Swagger documents like CompareSwaggerDocuments, using the supplied
+// options (for example a breaking change ruleset).
+func CompareSwaggerDocumentsWithOptions(original, updated *v2.Swagger,
+	options *model.CompareOptions,
+) *model.DocumentChanges {
+	return model.CompareDocumentsWithOptions(original, updated, options)
+}